@@ -21,8 +21,12 @@ func (s *ServerGrpc) ValidateRequest(ctx context.Context,
 	}
 	claims := token.Claims.(jwt.MapClaims)
 	uid := claims["uid"].(string)
-	err = validations.RbacValidator(uid, inputRequest.ProjectId,
-		inputRequest.RequiredRoles, inputRequest.Invitation, s.ApplicationService)
+	err = validations.ScopeValidator(validations.GetTokenScope(claims), inputRequest.ProjectId)
+	if err != nil {
+		return &protos.ValidationResponse{Error: err.Error(), IsValid: false}, err
+	}
+	err = validations.PermissionValidator(uid, inputRequest.ProjectId,
+		inputRequest.RequiredRoles, inputRequest.Permission, inputRequest.Invitation, s.ApplicationService)
 	if err != nil {
 		return &protos.ValidationResponse{Error: err.Error(), IsValid: false}, err
 	}
@@ -0,0 +1,283 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/validations"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CreateAPIToken creates a new API token for the logged in user or for a service account it manages
+func CreateAPIToken(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// API tokens can't be used to issue new tokens, otherwise a leaked token could outlive its revocation
+		if c.GetString("tokenID") != "" {
+			c.AbortWithStatusJSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		var tokenRequest entities.APITokenInput
+		err := c.BindJSON(&tokenRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+		tokenRequest.Name = utils.SanitizeString(tokenRequest.Name)
+		if tokenRequest.Name == "" || (tokenRequest.ExpiresInDays != nil && *tokenRequest.ExpiresInDays <= 0) {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		user, err := getManagedUser(c, service, tokenRequest.UserID)
+		if err != nil {
+			c.AbortWithStatusJSON(utils.ErrorStatusCodes[err], presenter.CreateErrorResponse(err))
+			return
+		}
+		if err := validateTokenScope(c, service, user, tokenRequest.ProjectIDs); err != nil {
+			c.AbortWithStatusJSON(utils.ErrorStatusCodes[err], presenter.CreateErrorResponse(err))
+			return
+		}
+
+		token, apiToken, err := service.CreateAPIToken(user, &tokenRequest, c.MustGet("uid").(string))
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+		c.Set(middleware.AuditResourceIDKey, apiToken.ID)
+
+		c.JSON(200, gin.H{
+			"accessToken": token,
+			"token":       apiToken,
+			"type":        "Bearer",
+		})
+	}
+}
+
+// GetAPITokens returns the API tokens of the logged in user or of a service account it manages
+func GetAPITokens(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, err := getManagedUser(c, service, c.Query("userID"))
+		if err != nil {
+			c.AbortWithStatusJSON(utils.ErrorStatusCodes[err], presenter.CreateErrorResponse(err))
+			return
+		}
+
+		tokens, err := service.GetAPITokens(user.ID)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, gin.H{"data": tokens})
+	}
+}
+
+// RevokeAPIToken revokes an API token of the logged in user or of a service account it manages
+func RevokeAPIToken(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var revokeRequest entities.RevokeAPITokenInput
+		err := c.BindJSON(&revokeRequest)
+		if err != nil || revokeRequest.TokenID == "" {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		c.Set(middleware.AuditResourceIDKey, revokeRequest.TokenID)
+		apiToken, err := service.GetAPIToken(revokeRequest.TokenID)
+		if err == mongo.ErrNoDocuments {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrAPITokenNotFound], presenter.CreateErrorResponse(utils.ErrAPITokenNotFound))
+			return
+		} else if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		if _, err := getManagedUser(c, service, apiToken.UserID); err != nil {
+			c.AbortWithStatusJSON(utils.ErrorStatusCodes[err], presenter.CreateErrorResponse(err))
+			return
+		}
+
+		err = service.RevokeAPIToken(apiToken.ID)
+		if err == mongo.ErrNoDocuments {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrAPITokenNotFound], presenter.CreateErrorResponse(utils.ErrAPITokenNotFound))
+			return
+		} else if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, gin.H{"message": "api token revoked successfully"})
+	}
+}
+
+// CreateServiceAccount creates a non-human user which is added as a member of the project with the given role,
+// service accounts can't login and only authenticate with the API tokens created for them
+func CreateServiceAccount(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var accountRequest entities.ServiceAccountInput
+		err := c.BindJSON(&accountRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		accountRequest.Username = utils.SanitizeString(accountRequest.Username)
+		if accountRequest.Username == "" || accountRequest.ProjectID == "" {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+		if accountRequest.Role != entities.RoleEditor && accountRequest.Role != entities.RoleViewer {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
+			return
+		}
+
+		err = validateProjectRole(c, accountRequest.ProjectID,
+			validations.MutationRbacRules["manageServiceAccount"], string(entities.AcceptedInvitation), service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		account := entities.User{
+			ID:             uuid.Must(uuid.NewRandom()).String(),
+			Username:       accountRequest.Username,
+			Name:           accountRequest.Name,
+			Role:           entities.RoleUser,
+			ServiceAccount: true,
+			Audit: entities.Audit{
+				CreatedAt: time.Now().Unix(),
+				UpdatedAt: time.Now().Unix(),
+				CreatedBy: entities.UserDetailResponse{
+					UserID:   c.MustGet("uid").(string),
+					Username: c.MustGet("username").(string),
+				},
+			},
+		}
+
+		c.Set(middleware.AuditResourceIDKey, account.ID)
+		accountResponse, err := service.CreateUser(&account)
+		if err == utils.ErrUserExists {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUserExists], presenter.CreateErrorResponse(utils.ErrUserExists))
+			return
+		}
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		err = service.AddMember(accountRequest.ProjectID, &entities.Member{
+			UserID:     account.ID,
+			Role:       accountRequest.Role,
+			Invitation: entities.AcceptedInvitation,
+			JoinedAt:   time.Now().Unix(),
+		})
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, accountResponse)
+	}
+}
+
+// getManagedUser returns the user whose API tokens are managed by the request, users manage their own tokens
+// while the tokens of service accounts can also be managed by admins and the owners of their projects
+func getManagedUser(c *gin.Context, service services.ApplicationService, userID string) (*entities.User, utils.AppError) {
+	uid := c.MustGet("uid").(string)
+	if userID == "" {
+		userID = uid
+	}
+
+	user, err := service.GetUser(userID)
+	if err == mongo.ErrNoDocuments {
+		return nil, utils.ErrUserNotFound
+	} else if err != nil {
+		log.Error(err)
+		return nil, utils.ErrServerError
+	}
+
+	if userID == uid {
+		return user, nil
+	}
+	if !user.ServiceAccount {
+		return nil, utils.ErrUnauthorized
+	}
+	if entities.Role(c.MustGet("role").(string)) == entities.RoleAdmin {
+		return user, nil
+	}
+
+	projects, err := service.GetProjectsByUserID(user.ID, false)
+	if err != nil {
+		log.Error(err)
+		return nil, utils.ErrServerError
+	}
+	for _, project := range projects {
+		err = validateProjectRole(c, project.ID,
+			validations.MutationRbacRules["manageServiceAccount"], string(entities.AcceptedInvitation), service)
+		if err == nil {
+			return user, nil
+		}
+	}
+	return nil, utils.ErrUnauthorized
+}
+
+// validateTokenScope validates that the logged in user manages every project the token of a service account gives
+// access to, the tokens without scope give access to every project of the account so all of them must be managed
+func validateTokenScope(c *gin.Context, service services.ApplicationService, user *entities.User, projectIDs []string) utils.AppError {
+	if user.ID == c.MustGet("uid").(string) || entities.Role(c.MustGet("role").(string)) == entities.RoleAdmin {
+		return nil
+	}
+
+	if len(projectIDs) == 0 {
+		projects, err := service.GetProjectsByUserID(user.ID, false)
+		if err != nil {
+			log.Error(err)
+			return utils.ErrServerError
+		}
+		for _, project := range projects {
+			projectIDs = append(projectIDs, project.ID)
+		}
+	}
+	for _, projectID := range projectIDs {
+		err := validateProjectRole(c, projectID,
+			validations.MutationRbacRules["manageServiceAccount"], string(entities.AcceptedInvitation), service)
+		if err != nil {
+			log.Warn(err)
+			return utils.ErrUnauthorized
+		}
+	}
+	return nil
+}
+
+// validateProjectRole validates the role of the logged in user in the project, requests
+// authenticated with a scoped API token are also restricted to the projects of the scope
+func validateProjectRole(c *gin.Context, projectID string, requiredRoles []string, invitation string,
+	service services.ApplicationService) error {
+	c.Set(middleware.AuditProjectIDKey, projectID)
+	scope, _ := c.Get("scope")
+	projectScope, _ := scope.([]string)
+	if err := validations.ScopeValidator(projectScope, projectID); err != nil {
+		return err
+	}
+	return validations.RbacValidator(c.MustGet("uid").(string), projectID, requiredRoles, invitation, service)
+}
@@ -0,0 +1,125 @@
+package rest_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/handlers/rest"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/mocks"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	testOwnerID          = "owner"
+	testServiceAccountID = "service-account"
+)
+
+// ownerOf mocks the owner as owning only the given projects
+func ownerOf(service *mocks.MockedApplicationService, projectIDs ...string) {
+	owned := map[string]bool{}
+	for _, projectID := range projectIDs {
+		owned[projectID] = true
+	}
+	service.On("GetProjects", mock.MatchedBy(func(filter bson.D) bool {
+		projectID, _ := filter[0].Value.(string)
+		return owned[projectID]
+	})).Return([]*entities.Project{{}}, nil)
+	service.On("GetProjects", mock.Anything).Return(nil, nil)
+}
+
+func newAPITokenContext(body entities.APITokenInput, scope []string) (*gin.Context, *httptest.ResponseRecorder) {
+	gin.SetMode(gin.TestMode)
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	payload, _ := json.Marshal(body)
+	c.Request = httptest.NewRequest(http.MethodPost, "/create_api_token", bytes.NewReader(payload))
+	c.Set("uid", testOwnerID)
+	c.Set("username", testOwnerID)
+	c.Set("role", string(entities.RoleUser))
+	c.Set("scope", scope)
+	return c, w
+}
+
+// TestCreateAPITokenOfServiceAccount is used to test that the tokens of a service account only give access to the
+// projects managed by the user creating them
+func TestCreateAPITokenOfServiceAccount(t *testing.T) {
+	tests := []struct {
+		name           string
+		ownedProjects  []string
+		projectIDs     []string
+		expectedStatus int
+	}{
+		{
+			name:           "unscoped token when owning every project of the account",
+			ownedProjects:  []string{"project-1", "project-2"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "unscoped token when owning one project of the account",
+			ownedProjects:  []string{"project-1"},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "token scoped to the owned project",
+			ownedProjects:  []string{"project-1"},
+			projectIDs:     []string{"project-1"},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "token scoped to a project owned by another user",
+			ownedProjects:  []string{"project-1"},
+			projectIDs:     []string{"project-1", "project-2"},
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			service := new(mocks.MockedApplicationService)
+			serviceAccount := &entities.User{ID: testServiceAccountID, Username: "ci", ServiceAccount: true}
+			service.On("GetUser", testServiceAccountID).Return(serviceAccount, nil)
+			service.On("GetUser", testOwnerID).Return(&entities.User{ID: testOwnerID}, nil)
+			service.On("GetProjectsByUserID", testServiceAccountID, false).
+				Return([]*entities.Project{{ID: "project-1"}, {ID: "project-2"}}, nil)
+			ownerOf(service, tc.ownedProjects...)
+			service.On("CreateAPIToken", serviceAccount, mock.Anything, testOwnerID).
+				Return("token", &entities.APIToken{ID: "token-id"}, nil)
+			c, w := newAPITokenContext(entities.APITokenInput{
+				Name:       "ci",
+				UserID:     testServiceAccountID,
+				ProjectIDs: tc.projectIDs,
+			}, nil)
+
+			// when
+			rest.CreateAPIToken(service)(c)
+
+			// then
+			assert.Equal(t, tc.expectedStatus, w.Code)
+			if tc.expectedStatus != http.StatusOK {
+				service.AssertNotCalled(t, "CreateAPIToken", mock.Anything, mock.Anything, mock.Anything)
+			}
+		})
+	}
+}
+
+// TestCreateAPITokenWithAPIToken is used to test that API tokens can't be used to issue new tokens
+func TestCreateAPITokenWithAPIToken(t *testing.T) {
+	// given
+	service := new(mocks.MockedApplicationService)
+	c, w := newAPITokenContext(entities.APITokenInput{Name: "ci"}, nil)
+	c.Set("tokenID", "token-id")
+
+	// when
+	rest.CreateAPIToken(service)(c)
+
+	// then
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	service.AssertNotCalled(t, "CreateAPIToken", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,221 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/validations"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// CreateCustomRole creates a new role for the project granting the given permissions
+func CreateCustomRole(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var roleRequest entities.CustomRoleInput
+		err := c.BindJSON(&roleRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		err = validateProjectRole(c, roleRequest.ProjectID,
+			validations.MutationRbacRules["manageCustomRole"], string(entities.AcceptedInvitation), service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		roleRequest.Name = utils.SanitizeString(roleRequest.Name)
+		if roleRequest.Name == "" || entities.IsBuiltInRole(entities.MemberRole(roleRequest.Name)) {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
+			return
+		}
+		permissions, err := validations.ValidatePermissions(roleRequest.Permissions)
+		if err != nil {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidPermission], presenter.CreateErrorResponse(utils.ErrInvalidPermission))
+			return
+		}
+
+		_, err = service.GetCustomRoleByName(roleRequest.ProjectID, roleRequest.Name)
+		if err == nil {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrCustomRoleExists], presenter.CreateErrorResponse(utils.ErrCustomRoleExists))
+			return
+		} else if err != mongo.ErrNoDocuments {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		updatedBy := getUserDetails(c)
+		role := &entities.CustomRole{
+			ID:          uuid.Must(uuid.NewRandom()).String(),
+			ProjectID:   roleRequest.ProjectID,
+			Name:        roleRequest.Name,
+			Description: roleRequest.Description,
+			Permissions: permissions,
+			Audit: entities.Audit{
+				CreatedAt: time.Now().Unix(),
+				CreatedBy: updatedBy,
+				UpdatedAt: time.Now().Unix(),
+				UpdatedBy: updatedBy,
+			},
+		}
+		c.Set(middleware.AuditResourceIDKey, role.ID)
+		err = service.CreateCustomRole(role)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, gin.H{"data": role})
+	}
+}
+
+// ListCustomRoles returns the custom roles of the project
+func ListCustomRoles(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID := c.Param("project_id")
+
+		err := validateProjectRole(c, projectID,
+			validations.MutationRbacRules["getProject"], string(entities.AcceptedInvitation), service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		roles, err := service.GetCustomRoles(projectID)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, gin.H{"data": roles})
+	}
+}
+
+// UpdateCustomRole updates the description and the permissions of a custom role, the name
+// of a role can't be updated as it is the role assigned to the members of the project
+func UpdateCustomRole(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var roleRequest entities.CustomRoleInput
+		err := c.BindJSON(&roleRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		c.Set(middleware.AuditResourceIDKey, roleRequest.RoleID)
+		err = validateProjectRole(c, roleRequest.ProjectID,
+			validations.MutationRbacRules["manageCustomRole"], string(entities.AcceptedInvitation), service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		permissions, err := validations.ValidatePermissions(roleRequest.Permissions)
+		if err != nil {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidPermission], presenter.CreateErrorResponse(utils.ErrInvalidPermission))
+			return
+		}
+
+		role, err := service.GetCustomRole(roleRequest.ProjectID, roleRequest.RoleID)
+		if err == mongo.ErrNoDocuments {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrCustomRoleNotFound], presenter.CreateErrorResponse(utils.ErrCustomRoleNotFound))
+			return
+		} else if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		role.Description = roleRequest.Description
+		role.Permissions = permissions
+		role.UpdatedAt = time.Now().Unix()
+		role.UpdatedBy = getUserDetails(c)
+		err = service.UpdateCustomRole(role)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, gin.H{"data": role})
+	}
+}
+
+// DeleteCustomRole deletes a custom role which is not assigned to any member of the project
+func DeleteCustomRole(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var roleRequest entities.CustomRoleInput
+		err := c.BindJSON(&roleRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		c.Set(middleware.AuditResourceIDKey, roleRequest.RoleID)
+		err = validateProjectRole(c, roleRequest.ProjectID,
+			validations.MutationRbacRules["manageCustomRole"], string(entities.AcceptedInvitation), service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		role, err := service.GetCustomRole(roleRequest.ProjectID, roleRequest.RoleID)
+		if err == mongo.ErrNoDocuments {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrCustomRoleNotFound], presenter.CreateErrorResponse(utils.ErrCustomRoleNotFound))
+			return
+		} else if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		members, err := service.GetProjectMembers(role.ProjectID, string(entities.All))
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+		for _, member := range members {
+			if string(member.Role) == role.Name {
+				c.JSON(utils.ErrorStatusCodes[utils.ErrCustomRoleInUse], presenter.CreateErrorResponse(utils.ErrCustomRoleInUse))
+				return
+			}
+		}
+
+		err = service.DeleteCustomRole(role.ProjectID, role.ID)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(200, gin.H{"message": "custom role deleted successfully"})
+	}
+}
+
+// getUserDetails returns the details of the logged in user
+func getUserDetails(c *gin.Context) entities.UserDetailResponse {
+	return entities.UserDetailResponse{
+		UserID:   c.MustGet("uid").(string),
+		Username: c.MustGet("username").(string),
+	}
+}
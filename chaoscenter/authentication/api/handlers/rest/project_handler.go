@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
@@ -57,7 +58,7 @@ func GetProject(service services.ApplicationService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		projectID := c.Param("project_id")
 
-		err := validations.RbacValidator(c.MustGet("uid").(string), projectID,
+		err := validateProjectRole(c, projectID,
 			validations.MutationRbacRules["getProject"], string(entities.AcceptedInvitation), service)
 		if err != nil {
 			log.Warn(err)
@@ -215,6 +216,8 @@ func CreateProject(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 		pID := uuid.Must(uuid.NewRandom()).String()
+		c.Set(middleware.AuditProjectIDKey, pID)
+		c.Set(middleware.AuditResourceIDKey, pID)
 
 		// Adding user as project owner in project's member list
 		newMember := &entities.Member{
@@ -272,7 +275,8 @@ func SendInvitation(service services.ApplicationService) gin.HandlerFunc {
 			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
 			return
 		}
-		err = validations.RbacValidator(c.MustGet("uid").(string), member.ProjectID,
+		c.Set(middleware.AuditResourceIDKey, member.UserID)
+		err = validateProjectRole(c, member.ProjectID,
 			validations.MutationRbacRules["sendInvitation"], string(entities.AcceptedInvitation),
 			service)
 		if err != nil {
@@ -281,11 +285,22 @@ func SendInvitation(service services.ApplicationService) gin.HandlerFunc {
 				presenter.CreateErrorResponse(utils.ErrUnauthorized))
 			return
 		}
-		// Validating member role
-		if member.Role == nil || (*member.Role != entities.RoleEditor && *member.Role != entities.RoleViewer) {
+		// Validating member role, members can be invited with one of the custom roles of the project
+		if member.Role == nil || *member.Role == entities.RoleOwner {
 			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
 			return
 		}
+		if *member.Role != entities.RoleEditor && *member.Role != entities.RoleViewer {
+			_, err = service.GetCustomRoleByName(member.ProjectID, string(*member.Role))
+			if err == mongo.ErrNoDocuments {
+				c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
+				return
+			} else if err != nil {
+				log.Error(err)
+				c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+				return
+			}
+		}
 
 		user, err := service.GetUser(member.UserID)
 
@@ -359,7 +374,8 @@ func AcceptInvitation(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
-		err = validations.RbacValidator(c.MustGet("uid").(string), member.ProjectID,
+		c.Set(middleware.AuditResourceIDKey, member.UserID)
+		err = validateProjectRole(c, member.ProjectID,
 			validations.MutationRbacRules["acceptInvitation"],
 			string(entities.PendingInvitation),
 			service)
@@ -394,7 +410,8 @@ func DeclineInvitation(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
-		err = validations.RbacValidator(c.MustGet("uid").(string), member.ProjectID,
+		c.Set(middleware.AuditResourceIDKey, member.UserID)
+		err = validateProjectRole(c, member.ProjectID,
 			validations.MutationRbacRules["declineInvitation"],
 			string(entities.PendingInvitation),
 			service)
@@ -429,7 +446,8 @@ func LeaveProject(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
-		err = validations.RbacValidator(c.MustGet("uid").(string), member.ProjectID,
+		c.Set(middleware.AuditResourceIDKey, member.UserID)
+		err = validateProjectRole(c, member.ProjectID,
 			validations.MutationRbacRules["leaveProject"],
 			string(entities.AcceptedInvitation),
 			service)
@@ -468,7 +486,8 @@ func RemoveInvitation(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
-		err = validations.RbacValidator(c.MustGet("uid").(string), member.ProjectID,
+		c.Set(middleware.AuditResourceIDKey, member.UserID)
+		err = validateProjectRole(c, member.ProjectID,
 			validations.MutationRbacRules["removeInvitation"],
 			string(entities.AcceptedInvitation),
 			service)
@@ -521,7 +540,8 @@ func UpdateProjectName(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
-		err = validations.RbacValidator(c.MustGet("uid").(string),
+		c.Set(middleware.AuditResourceIDKey, userRequest.ProjectID)
+		err = validateProjectRole(c,
 			userRequest.ProjectID,
 			validations.MutationRbacRules["updateProjectName"],
 			string(entities.AcceptedInvitation),
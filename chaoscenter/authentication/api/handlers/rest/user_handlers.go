@@ -3,6 +3,7 @@ package rest
 import (
 	"time"
 
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
@@ -47,6 +48,7 @@ func CreateUser(service services.ApplicationService) gin.HandlerFunc {
 		// Assigning UID to user
 		uID := uuid.Must(uuid.NewRandom()).String()
 		userRequest.ID = uID
+		c.Set(middleware.AuditResourceIDKey, uID)
 
 		// Generating password hash
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(userRequest.Password), utils.PasswordEncryptionCost)
@@ -95,6 +97,7 @@ func UpdateUser(service services.ApplicationService) gin.HandlerFunc {
 
 		uid := c.MustGet("uid").(string)
 		userRequest.ID = uid
+		c.Set(middleware.AuditResourceIDKey, uid)
 
 		// Checking if password is updated
 		if userRequest.Password != "" {
@@ -178,6 +181,8 @@ func LoginUser(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 		userRequest.Username = utils.SanitizeString(userRequest.Username)
+		// the user isn't authenticated yet, the audit event is attributed to the user logging in
+		c.Set("username", userRequest.Username)
 		if userRequest.Username == "" || userRequest.Password == "" {
 			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
 			return
@@ -191,12 +196,21 @@ func LoginUser(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
+		c.Set("uid", user.ID)
+		c.Set(middleware.AuditResourceIDKey, user.ID)
+
 		// Checking if user is deactivated
 		if user.DeactivatedAt != nil {
 			c.JSON(utils.ErrorStatusCodes[utils.ErrUserDeactivated], presenter.CreateErrorResponse(utils.ErrUserDeactivated))
 			return
 		}
 
+		// Service accounts have no password and only authenticate with API tokens
+		if user.ServiceAccount {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidCredentials], presenter.CreateErrorResponse(utils.ErrInvalidCredentials))
+			return
+		}
+
 		// Validating password
 		err = service.CheckPasswordHash(user.Password, userRequest.Password)
 		if err != nil {
@@ -300,6 +314,7 @@ func UpdatePassword(service services.ApplicationService) gin.HandlerFunc {
 		}
 		username := c.MustGet("username").(string)
 		userPasswordRequest.Username = username
+		c.Set(middleware.AuditResourceIDKey, c.MustGet("uid").(string))
 		if utils.StrictPasswordPolicy {
 			err := utils.ValidateStrictPassword(userPasswordRequest.NewPassword)
 			if err != nil {
@@ -361,6 +376,13 @@ func ResetPassword(service services.ApplicationService) gin.HandlerFunc {
 			c.AbortWithStatusJSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
 			return
 		}
+		user, err := service.FindUserByUsername(userPasswordRequest.Username)
+		if err != nil {
+			log.Info(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUserNotFound], presenter.CreateErrorResponse(utils.ErrUserNotFound))
+			return
+		}
+		c.Set(middleware.AuditResourceIDKey, user.ID)
 		err = service.UpdatePassword(&userPasswordRequest, false)
 		if err != nil {
 			log.Error(err)
@@ -407,6 +429,14 @@ func UpdateUserState(service services.ApplicationService) gin.HandlerFunc {
 			return
 		}
 
+		user, err := service.FindUserByUsername(userRequest.Username)
+		if err != nil {
+			log.Info(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUserNotFound], presenter.CreateErrorResponse(utils.ErrUserNotFound))
+			return
+		}
+		c.Set(middleware.AuditResourceIDKey, user.ID)
+
 		// Transaction to update state in user and project collection
 		err = service.UpdateStateTransaction(userRequest)
 		if err != nil {
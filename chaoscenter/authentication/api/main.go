@@ -1,23 +1,32 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
+	"os"
+	"os/signal"
 	"runtime"
+	"syscall"
 	"time"
 
 	grpcHandler "github.com/litmuschaos/litmus/chaoscenter/authentication/api/handlers/grpc"
 	grpcPresenter "github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter/protos"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/routes"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/api_token"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/audit"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/custom_role"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/misc"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/project"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/session"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/tracing"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/user"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 
 	"github.com/gin-contrib/cors"
@@ -57,6 +66,12 @@ func main() {
 
 	flag.Parse()
 
+	shutdownTracer, err := tracing.InitTracer(context.Background(), utils.OtelExporterOtlpEndpoint)
+	if err != nil {
+		log.Fatalf("failed to initialize tracing: %v", err)
+	}
+	go flushTracerOnSignal(shutdownTracer)
+
 	client, err := utils.MongoConnection()
 	if err != nil {
 		log.Fatal("database connection error $s", err)
@@ -90,6 +105,16 @@ func main() {
 		log.Errorf("failed to create index  %s", err)
 	}
 
+	// Creating API Token Collection
+	if err = utils.CreateCollection(utils.APITokenCollection, db); err != nil {
+		log.Errorf("failed to create collection  %s", err)
+	}
+
+	// Creating Custom Role Collection
+	if err = utils.CreateCollection(utils.CustomRoleCollection, db); err != nil {
+		log.Errorf("failed to create collection  %s", err)
+	}
+
 	userCollection := db.Collection(utils.UserCollection)
 	userRepo := user.NewRepo(userCollection)
 
@@ -99,9 +124,19 @@ func main() {
 	revokedTokenCollection := db.Collection(utils.RevokedTokenCollection)
 	sessionRepo := session.NewRepo(revokedTokenCollection)
 
+	apiTokenCollection := db.Collection(utils.APITokenCollection)
+	apiTokenRepo := api_token.NewRepo(apiTokenCollection)
+
+	customRoleCollection := db.Collection(utils.CustomRoleCollection)
+	customRoleRepo := custom_role.NewRepo(customRoleCollection)
+
+	// audit events are stored along with the audit events of the GraphQL server
+	auditEventCollection := client.Database(utils.AuditDBName).Collection(utils.AuditEventCollection)
+	auditRepo := audit.NewRepo(auditEventCollection)
+
 	miscRepo := misc.NewRepo(db, client)
 
-	applicationService := services.NewService(userRepo, projectRepo, miscRepo, sessionRepo, db)
+	applicationService := services.NewService(userRepo, projectRepo, miscRepo, sessionRepo, apiTokenRepo, customRoleRepo, auditRepo, db)
 
 	validatedAdminSetup(applicationService)
 
@@ -109,6 +144,20 @@ func main() {
 	runRestServer(applicationService)
 }
 
+// flushTracerOnSignal exports the buffered spans before the authentication server exits on SIGINT or SIGTERM
+func flushTracerOnSignal(shutdownTracer func(context.Context) error) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	<-sigCh
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracer(ctx); err != nil {
+		log.Errorf("failed to shutdown the tracer: %v", err)
+	}
+	os.Exit(0)
+}
+
 func validatedAdminSetup(service services.ApplicationService) {
 	// Assigning UID to admin
 	uID := uuid.Must(uuid.NewRandom()).String()
@@ -177,7 +226,7 @@ func runGrpcServer(applicationService services.ApplicationService) {
 			" to %s", err)
 	}
 	grpcApplicationServer := grpcHandler.ServerGrpc{ApplicationService: applicationService}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()))
 	grpcPresenter.RegisterAuthRpcServiceServer(grpcServer, &grpcApplicationServer)
 	log.Infof("Listening and serving gRPC on %s", utils.GrpcPort)
 	err = grpcServer.Serve(lis)
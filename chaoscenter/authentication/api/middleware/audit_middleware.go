@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
+	log "github.com/sirupsen/logrus"
+)
+
+// Keys of the gin context the handlers identify the audited resource with
+const (
+	AuditProjectIDKey  = "auditProjectID"
+	AuditResourceIDKey = "auditResourceID"
+)
+
+// errorBodyWriter keeps the body of the error responses to record the error of failed operations
+type errorBodyWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *errorBodyWriter) Write(data []byte) (int, error) {
+	if w.Status() >= http.StatusBadRequest {
+		w.body.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// AuditMiddleware is a Gin Middleware that records an audit event with the outcome of the operation once it is handled,
+// the actor is the authenticated user, or the user set in the context by the handler for unauthenticated operations
+func AuditMiddleware(service services.ApplicationService, action string, resourceType string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		writer := &errorBodyWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+
+		c.Next()
+
+		event := &entities.AuditEvent{
+			ProjectID:    c.GetString(AuditProjectIDKey),
+			Actor:        entities.AuditActor{UserID: c.GetString("uid"), Username: c.GetString("username")},
+			Action:       action,
+			ResourceType: resourceType,
+			ResourceID:   c.GetString(AuditResourceIDKey),
+			Outcome:      entities.AuditOutcomeSuccess,
+		}
+		if writer.Status() >= http.StatusBadRequest {
+			event.Outcome = entities.AuditOutcomeFailure
+			var response struct {
+				Error string `json:"error"`
+			}
+			if err := json.Unmarshal(writer.body.Bytes(), &response); err != nil || response.Error == "" {
+				response.Error = http.StatusText(writer.Status())
+			}
+			event.Error = response.Error
+		}
+
+		// the operation has already been performed, failing to audit it doesn't fail the request
+		if err := service.RecordAuditEvent(event); err != nil {
+			log.WithField("action", action).Error("failed to record audit event: ", err)
+		}
+	}
+}
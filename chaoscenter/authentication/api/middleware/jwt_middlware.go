@@ -6,9 +6,27 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/validations"
 	log "github.com/sirupsen/logrus"
 )
 
+// scopedTokenRoutes are the routes operating on a single project, the only ones allowed to the API tokens restricted
+// to some projects, the project is validated against the scope here when it is in the path and by the handlers when
+// it is in the body
+var scopedTokenRoutes = map[string]bool{
+	"/get_project/:project_id":                true,
+	"/get_project_members/:project_id/:state": true,
+	"/get_project_role/:project_id":           true,
+	"/list_custom_roles/:project_id":          true,
+	"/invite_users/:project_id":               true,
+	"/send_invitation":                        true,
+	"/remove_invitation":                      true,
+	"/update_project_name":                    true,
+	"/create_custom_role":                     true,
+	"/update_custom_role":                     true,
+	"/delete_custom_role":                     true,
+}
+
 // JwtMiddleware is a Gin Middleware that authorises requests
 func JwtMiddleware(service services.ApplicationService) gin.HandlerFunc {
 	return func(c *gin.Context) {
@@ -30,6 +48,17 @@ func JwtMiddleware(service services.ApplicationService) gin.HandlerFunc {
 			c.Set("username", claims["username"])
 			c.Set("uid", claims["uid"])
 			c.Set("role", claims["role"])
+			c.Set("tokenID", claims[utils.TokenIDClaim])
+			scope := validations.GetTokenScope(claims)
+			c.Set("scope", scope)
+			if len(scope) > 0 && !scopedTokenRoutes[c.FullPath()] {
+				c.AbortWithStatusJSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+				return
+			}
+			if projectID := c.Param("project_id"); projectID != "" && validations.ScopeValidator(scope, projectID) != nil {
+				c.AbortWithStatusJSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
+				return
+			}
 			c.Next()
 		} else {
 			c.AbortWithStatusJSON(utils.ErrorStatusCodes[utils.ErrUnauthorized], presenter.CreateErrorResponse(utils.ErrUnauthorized))
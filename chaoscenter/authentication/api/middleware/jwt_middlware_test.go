@@ -0,0 +1,77 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/mocks"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestJwtMiddlewareTokenScope is used to test that the API tokens restricted to some projects are rejected by the
+// routes of the other projects and by the routes not operating on a single project
+func TestJwtMiddlewareTokenScope(t *testing.T) {
+	tests := []struct {
+		name           string
+		scope          []interface{}
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "unscoped token on a route of the user",
+			path:           "/users",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "scoped token on a route of the user",
+			scope:          []interface{}{"project-1"},
+			path:           "/users",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "scoped token on a project of the scope",
+			scope:          []interface{}{"project-1"},
+			path:           "/get_project/project-1",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "scoped token on a project outside of the scope",
+			scope:          []interface{}{"project-1"},
+			path:           "/get_project/project-2",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			gin.SetMode(gin.TestMode)
+			claims := jwt.MapClaims{"uid": "uid", "username": "user", "role": "user", utils.TokenIDClaim: "token-id"}
+			if tc.scope != nil {
+				claims[utils.ProjectScopeClaim] = tc.scope
+			}
+			service := new(mocks.MockedApplicationService)
+			service.On("ValidateToken", "token").Return(&jwt.Token{Valid: true, Claims: claims}, nil)
+
+			router := gin.New()
+			router.Use(middleware.JwtMiddleware(service))
+			ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+			router.GET("/users", ok)
+			router.GET("/get_project/:project_id", ok)
+
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			req.Header.Set("Authorization", "Bearer token")
+
+			// when
+			router.ServeHTTP(w, req)
+
+			// then
+			assert.Equal(t, tc.expectedStatus, w.Code)
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MockedApplicationService is the mocked services.ApplicationService used by the tests of the handlers
+type MockedApplicationService struct {
+	mock.Mock
+}
+
+func (m *MockedApplicationService) LoginUser(user *entities.User) (*entities.User, error) {
+	args := m.Called(user)
+	return getUser(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) GetUser(uid string) (*entities.User, error) {
+	args := m.Called(uid)
+	return getUser(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) GetUsers() (*[]entities.User, error) {
+	args := m.Called()
+	return getUsers(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) FindUsersByUID(uid []string) (*[]entities.User, error) {
+	args := m.Called(uid)
+	return getUsers(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) FindUserByUsername(username string) (*entities.User, error) {
+	args := m.Called(username)
+	return getUser(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) CheckPasswordHash(hash, password string) error {
+	args := m.Called(hash, password)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) UpdatePassword(userPassword *entities.UserPassword, isAdminBeingReset bool) error {
+	args := m.Called(userPassword, isAdminBeingReset)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) CreateUser(user *entities.User) (*entities.User, error) {
+	args := m.Called(user)
+	return getUser(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) UpdateUser(user *entities.UserDetails) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) IsAdministrator(user *entities.User) error {
+	args := m.Called(user)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) UpdateUserState(username string, isDeactivate bool, deactivateTime string) error {
+	args := m.Called(username, isDeactivate, deactivateTime)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) InviteUsers(invitedUsers []string) (*[]entities.User, error) {
+	args := m.Called(invitedUsers)
+	return getUsers(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) GetProjectByProjectID(projectID string) (*entities.Project, error) {
+	args := m.Called(projectID)
+	project, _ := args.Get(0).(*entities.Project)
+	return project, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetProjects(query bson.D) ([]*entities.Project, error) {
+	args := m.Called(query)
+	return getProjects(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) GetProjectsByUserID(uid string, isOwner bool) ([]*entities.Project, error) {
+	args := m.Called(uid, isOwner)
+	return getProjects(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) GetProjectStats() ([]*entities.ProjectStats, error) {
+	args := m.Called()
+	stats, _ := args.Get(0).([]*entities.ProjectStats)
+	return stats, args.Error(1)
+}
+
+func (m *MockedApplicationService) CreateProject(project *entities.Project) error {
+	args := m.Called(project)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) AddMember(projectID string, member *entities.Member) error {
+	args := m.Called(projectID, member)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) RemoveInvitation(projectID string, userID string, invitation entities.Invitation) error {
+	args := m.Called(projectID, userID, invitation)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) UpdateInvite(projectID string, userID string, invitation entities.Invitation, role *entities.MemberRole) error {
+	args := m.Called(projectID, userID, invitation, role)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) UpdateProjectName(projectID string, projectName string) error {
+	args := m.Called(projectID, projectName)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) GetAggregateProjects(pipeline mongo.Pipeline, opts *options.AggregateOptions) (*mongo.Cursor, error) {
+	args := m.Called(pipeline, opts)
+	cursor, _ := args.Get(0).(*mongo.Cursor)
+	return cursor, args.Error(1)
+}
+
+func (m *MockedApplicationService) UpdateProjectState(userID string, deactivateTime string) error {
+	args := m.Called(userID, deactivateTime)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) GetOwnerProjectIDs(ctx context.Context, userID string) ([]*entities.Project, error) {
+	args := m.Called(ctx, userID)
+	return getProjects(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) GetProjectRole(projectID string, userID string) (*entities.MemberRole, error) {
+	args := m.Called(projectID, userID)
+	role, _ := args.Get(0).(*entities.MemberRole)
+	return role, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetProjectMembers(projectID string, state string) ([]*entities.Member, error) {
+	args := m.Called(projectID, state)
+	members, _ := args.Get(0).([]*entities.Member)
+	return members, args.Error(1)
+}
+
+func (m *MockedApplicationService) ListInvitations(userID string, invitationState entities.Invitation) ([]*entities.Project, error) {
+	args := m.Called(userID, invitationState)
+	return getProjects(args.Get(0)), args.Error(1)
+}
+
+func (m *MockedApplicationService) UpdateStateTransaction(userRequest entities.UpdateUserState) error {
+	args := m.Called(userRequest)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) ListCollection() ([]string, error) {
+	args := m.Called()
+	collections, _ := args.Get(0).([]string)
+	return collections, args.Error(1)
+}
+
+func (m *MockedApplicationService) ListDataBase() ([]string, error) {
+	args := m.Called()
+	databases, _ := args.Get(0).([]string)
+	return databases, args.Error(1)
+}
+
+func (m *MockedApplicationService) RevokeToken(tokenString string) error {
+	args := m.Called(tokenString)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) ValidateToken(encodedToken string) (*jwt.Token, error) {
+	args := m.Called(encodedToken)
+	token, _ := args.Get(0).(*jwt.Token)
+	return token, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetSignedJWT(user *entities.User) (string, error) {
+	args := m.Called(user)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockedApplicationService) CreateAPIToken(user *entities.User, input *entities.APITokenInput, createdBy string) (string, *entities.APIToken, error) {
+	args := m.Called(user, input, createdBy)
+	apiToken, _ := args.Get(1).(*entities.APIToken)
+	return args.String(0), apiToken, args.Error(2)
+}
+
+func (m *MockedApplicationService) GetAPIToken(tokenID string) (*entities.APIToken, error) {
+	args := m.Called(tokenID)
+	apiToken, _ := args.Get(0).(*entities.APIToken)
+	return apiToken, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetAPITokens(userID string) ([]*entities.APIToken, error) {
+	args := m.Called(userID)
+	apiTokens, _ := args.Get(0).([]*entities.APIToken)
+	return apiTokens, args.Error(1)
+}
+
+func (m *MockedApplicationService) RevokeAPIToken(tokenID string) error {
+	args := m.Called(tokenID)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) CreateCustomRole(role *entities.CustomRole) error {
+	args := m.Called(role)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) GetCustomRole(projectID string, roleID string) (*entities.CustomRole, error) {
+	args := m.Called(projectID, roleID)
+	role, _ := args.Get(0).(*entities.CustomRole)
+	return role, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetCustomRoleByName(projectID string, name string) (*entities.CustomRole, error) {
+	args := m.Called(projectID, name)
+	role, _ := args.Get(0).(*entities.CustomRole)
+	return role, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetCustomRoles(projectID string) ([]*entities.CustomRole, error) {
+	args := m.Called(projectID)
+	roles, _ := args.Get(0).([]*entities.CustomRole)
+	return roles, args.Error(1)
+}
+
+func (m *MockedApplicationService) GetCustomRoleNames(projectID string, permission string) ([]string, error) {
+	args := m.Called(projectID, permission)
+	names, _ := args.Get(0).([]string)
+	return names, args.Error(1)
+}
+
+func (m *MockedApplicationService) UpdateCustomRole(role *entities.CustomRole) error {
+	args := m.Called(role)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) DeleteCustomRole(projectID string, roleID string) error {
+	args := m.Called(projectID, roleID)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) RecordAuditEvent(event *entities.AuditEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func getUser(value interface{}) *entities.User {
+	user, _ := value.(*entities.User)
+	return user
+}
+
+func getUsers(value interface{}) *[]entities.User {
+	users, _ := value.(*[]entities.User)
+	return users
+}
+
+func getProjects(value interface{}) []*entities.Project {
+	projects, _ := value.([]*entities.Project)
+	return projects
+}
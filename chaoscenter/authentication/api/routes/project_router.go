@@ -3,6 +3,7 @@ package routes
 import (
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/handlers/rest"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
 
 	"github.com/gin-gonic/gin"
@@ -19,11 +20,15 @@ func ProjectRouter(router *gin.Engine, service services.ApplicationService) {
 	router.GET("/list_projects", rest.GetProjectsByUserID(service))
 	router.GET("/get_projects_stats", rest.GetProjectStats(service))
 	router.GET("/list_invitations_with_filters/:invitation_state", rest.ListInvitations(service))
-	router.POST("/create_project", rest.CreateProject(service))
-	router.POST("/send_invitation", rest.SendInvitation(service))
-	router.POST("/accept_invitation", rest.AcceptInvitation(service))
-	router.POST("/decline_invitation", rest.DeclineInvitation(service))
-	router.POST("/remove_invitation", rest.RemoveInvitation(service))
-	router.POST("/leave_project", rest.LeaveProject(service))
-	router.POST("/update_project_name", rest.UpdateProjectName(service))
+	router.POST("/create_project", middleware.AuditMiddleware(service, "CreateProject", entities.AuditResourceProject), rest.CreateProject(service))
+	router.POST("/send_invitation", middleware.AuditMiddleware(service, "SendInvitation", entities.AuditResourceInvitation), rest.SendInvitation(service))
+	router.POST("/accept_invitation", middleware.AuditMiddleware(service, "AcceptInvitation", entities.AuditResourceInvitation), rest.AcceptInvitation(service))
+	router.POST("/decline_invitation", middleware.AuditMiddleware(service, "DeclineInvitation", entities.AuditResourceInvitation), rest.DeclineInvitation(service))
+	router.POST("/remove_invitation", middleware.AuditMiddleware(service, "RemoveInvitation", entities.AuditResourceInvitation), rest.RemoveInvitation(service))
+	router.POST("/leave_project", middleware.AuditMiddleware(service, "LeaveProject", entities.AuditResourceInvitation), rest.LeaveProject(service))
+	router.POST("/update_project_name", middleware.AuditMiddleware(service, "UpdateProjectName", entities.AuditResourceProject), rest.UpdateProjectName(service))
+	router.GET("/list_custom_roles/:project_id", rest.ListCustomRoles(service))
+	router.POST("/create_custom_role", middleware.AuditMiddleware(service, "CreateCustomRole", entities.AuditResourceCustomRole), rest.CreateCustomRole(service))
+	router.POST("/update_custom_role", middleware.AuditMiddleware(service, "UpdateCustomRole", entities.AuditResourceCustomRole), rest.UpdateCustomRole(service))
+	router.POST("/delete_custom_role", middleware.AuditMiddleware(service, "DeleteCustomRole", entities.AuditResourceCustomRole), rest.DeleteCustomRole(service))
 }
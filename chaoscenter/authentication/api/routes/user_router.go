@@ -3,6 +3,7 @@ package routes
 import (
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/handlers/rest"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
 
 	"github.com/gin-gonic/gin"
@@ -10,15 +11,19 @@ import (
 
 // UserRouter creates all the required routes for user authentications purposes.
 func UserRouter(router *gin.Engine, service services.ApplicationService) {
-	router.POST("/login", rest.LoginUser(service))
+	router.POST("/login", middleware.AuditMiddleware(service, "Login", entities.AuditResourceUser), rest.LoginUser(service))
 	router.POST("/logout", rest.LogoutUser(service))
 	router.Use(middleware.JwtMiddleware(service))
-	router.POST("/update/password", rest.UpdatePassword(service))
-	router.POST("/reset/password", rest.ResetPassword(service))
-	router.POST("/create_user", rest.CreateUser(service))
-	router.POST("/update/details", rest.UpdateUser(service))
+	router.POST("/update/password", middleware.AuditMiddleware(service, "UpdatePassword", entities.AuditResourceUser), rest.UpdatePassword(service))
+	router.POST("/reset/password", middleware.AuditMiddleware(service, "ResetPassword", entities.AuditResourceUser), rest.ResetPassword(service))
+	router.POST("/create_user", middleware.AuditMiddleware(service, "CreateUser", entities.AuditResourceUser), rest.CreateUser(service))
+	router.POST("/update/details", middleware.AuditMiddleware(service, "UpdateUser", entities.AuditResourceUser), rest.UpdateUser(service))
 	router.GET("/get_user/:uid", rest.GetUser(service))
 	router.GET("/users", rest.FetchUsers(service))
 	router.GET("/invite_users/:project_id", rest.InviteUsers(service))
-	router.POST("/update/state", rest.UpdateUserState(service))
+	router.POST("/update/state", middleware.AuditMiddleware(service, "UpdateUserState", entities.AuditResourceUser), rest.UpdateUserState(service))
+	router.POST("/create_service_account", middleware.AuditMiddleware(service, "CreateServiceAccount", entities.AuditResourceServiceAccount), rest.CreateServiceAccount(service))
+	router.POST("/create_api_token", middleware.AuditMiddleware(service, "CreateAPIToken", entities.AuditResourceAPIToken), rest.CreateAPIToken(service))
+	router.GET("/api_tokens", rest.GetAPITokens(service))
+	router.POST("/revoke_api_token", middleware.AuditMiddleware(service, "RevokeAPIToken", entities.AuditResourceAPIToken), rest.RevokeAPIToken(service))
 }
@@ -0,0 +1,98 @@
+package api_token
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Repository holds the mongo database implementation of the Service
+type Repository interface {
+	CreateAPIToken(token *entities.APIToken) error
+	GetAPIToken(tokenID string) (*entities.APIToken, error)
+	GetAPITokensByUserID(userID string) ([]*entities.APIToken, error)
+	RevokeAPIToken(tokenID string, revokedAt int64) error
+	UpdateLastUsed(tokenID string, lastUsedAt int64, interval int64) error
+}
+
+// repository is the implementation of the Repository interface
+type repository struct {
+	Collection *mongo.Collection
+}
+
+// CreateAPIToken stores the metadata of a new API token
+func (r repository) CreateAPIToken(token *entities.APIToken) error {
+	_, err := r.Collection.InsertOne(context.Background(), token)
+	return err
+}
+
+// GetAPIToken fetches the API token that matches the passed tokenID
+func (r repository) GetAPIToken(tokenID string) (*entities.APIToken, error) {
+	var result = entities.APIToken{}
+	err := r.Collection.FindOne(context.Background(), bson.D{
+		{"_id", tokenID},
+	}).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetAPITokensByUserID fetches all the API tokens of the user, latest first
+func (r repository) GetAPITokensByUserID(userID string) ([]*entities.APIToken, error) {
+	opts := options.Find().SetSort(bson.D{{"created_at", -1}})
+	cursor, err := r.Collection.Find(context.Background(), bson.D{
+		{"user_id", userID},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens = []*entities.APIToken{}
+	if err = cursor.All(context.Background(), &tokens); err != nil {
+		return nil, err
+	}
+	return tokens, nil
+}
+
+// RevokeAPIToken marks the API token as revoked, revoked tokens are kept to list when they were last used
+func (r repository) RevokeAPIToken(tokenID string, revokedAt int64) error {
+	result, err := r.Collection.UpdateOne(context.Background(), bson.D{
+		{"_id", tokenID},
+		{"revoked_at", bson.D{{"$exists", false}}},
+	}, bson.D{
+		{"$set", bson.D{{"revoked_at", revokedAt}}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// UpdateLastUsed updates the last usage of the API token, the write is skipped
+// if the token was already marked as used within the interval
+func (r repository) UpdateLastUsed(tokenID string, lastUsedAt int64, interval int64) error {
+	_, err := r.Collection.UpdateOne(context.Background(), bson.D{
+		{"_id", tokenID},
+		{"$or", bson.A{
+			bson.D{{"last_used_at", bson.D{{"$exists", false}}}},
+			bson.D{{"last_used_at", bson.D{{"$lt", lastUsedAt - interval}}}},
+		}},
+	}, bson.D{
+		{"$set", bson.D{{"last_used_at", lastUsedAt}}},
+	})
+	return err
+}
+
+// NewRepo creates a new instance of this repository
+func NewRepo(collection *mongo.Collection) Repository {
+	return &repository{
+		Collection: collection,
+	}
+}
@@ -0,0 +1,32 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Repository holds the mongo database implementation of the Service,
+// audit events are append only so they can't be updated or deleted
+type Repository interface {
+	InsertAuditEvent(event *entities.AuditEvent) error
+}
+
+// repository is the implementation of the Repository interface
+type repository struct {
+	Collection *mongo.Collection
+}
+
+// InsertAuditEvent stores a new audit event
+func (r repository) InsertAuditEvent(event *entities.AuditEvent) error {
+	_, err := r.Collection.InsertOne(context.Background(), event)
+	return err
+}
+
+// NewRepo creates a new instance of this repository
+func NewRepo(collection *mongo.Collection) Repository {
+	return &repository{
+		Collection: collection,
+	}
+}
@@ -0,0 +1,145 @@
+package custom_role
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Repository holds the mongo database implementation of the Service
+type Repository interface {
+	CreateCustomRole(role *entities.CustomRole) error
+	GetCustomRole(projectID string, roleID string) (*entities.CustomRole, error)
+	GetCustomRoleByName(projectID string, name string) (*entities.CustomRole, error)
+	GetCustomRoles(projectID string) ([]*entities.CustomRole, error)
+	GetCustomRoleNames(projectID string, permission string) ([]string, error)
+	UpdateCustomRole(role *entities.CustomRole) error
+	DeleteCustomRole(projectID string, roleID string) error
+}
+
+// repository is the implementation of the Repository interface
+type repository struct {
+	Collection *mongo.Collection
+}
+
+// CreateCustomRole creates a new custom role for a project
+func (r repository) CreateCustomRole(role *entities.CustomRole) error {
+	_, err := r.Collection.InsertOne(context.Background(), role)
+	return err
+}
+
+// GetCustomRole fetches the custom role of the project that matches the passed roleID
+func (r repository) GetCustomRole(projectID string, roleID string) (*entities.CustomRole, error) {
+	return r.findCustomRole(bson.D{
+		{"_id", roleID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+}
+
+// GetCustomRoleByName fetches the custom role of the project that matches the passed name
+func (r repository) GetCustomRoleByName(projectID string, name string) (*entities.CustomRole, error) {
+	return r.findCustomRole(bson.D{
+		{"name", name},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+}
+
+func (r repository) findCustomRole(query bson.D) (*entities.CustomRole, error) {
+	var result = entities.CustomRole{}
+	err := r.Collection.FindOne(context.Background(), query).Decode(&result)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetCustomRoles fetches all the custom roles of the project
+func (r repository) GetCustomRoles(projectID string) ([]*entities.CustomRole, error) {
+	cursor, err := r.Collection.Find(context.Background(), bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var roles = []*entities.CustomRole{}
+	if err = cursor.All(context.Background(), &roles); err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+// GetCustomRoleNames fetches the names of the custom roles of the project granting the
+// permission, the names of all the custom roles are returned when no permission is passed
+func (r repository) GetCustomRoleNames(projectID string, permission string) ([]string, error) {
+	query := bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	if permission != "" {
+		query = append(query, bson.E{Key: "permissions", Value: permission})
+	}
+
+	roles, err := r.Collection.Distinct(context.Background(), "name", query)
+	if err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, role := range roles {
+		if name, ok := role.(string); ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// UpdateCustomRole updates the description and the permissions of the custom role
+func (r repository) UpdateCustomRole(role *entities.CustomRole) error {
+	result, err := r.Collection.UpdateOne(context.Background(), bson.D{
+		{"_id", role.ID},
+		{"project_id", role.ProjectID},
+		{"is_removed", false},
+	}, bson.D{
+		{"$set", bson.D{
+			{"description", role.Description},
+			{"permissions", role.Permissions},
+			{"updated_at", role.UpdatedAt},
+			{"updated_by", role.UpdatedBy},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteCustomRole deletes the custom role of the project
+func (r repository) DeleteCustomRole(projectID string, roleID string) error {
+	result, err := r.Collection.DeleteOne(context.Background(), bson.D{
+		{"_id", roleID},
+		{"project_id", projectID},
+	})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// NewRepo creates a new instance of this repository
+func NewRepo(collection *mongo.Collection) Repository {
+	return &repository{
+		Collection: collection,
+	}
+}
@@ -0,0 +1,36 @@
+package entities
+
+// APIToken contains the metadata of a personal access token, the token itself is never stored
+type APIToken struct {
+	ID         string   `bson:"_id" json:"tokenID"`
+	Name       string   `bson:"name" json:"name"`
+	UserID     string   `bson:"user_id" json:"userID"`
+	ProjectIDs []string `bson:"project_ids,omitempty" json:"projectIDs,omitempty"`
+	CreatedAt  int64    `bson:"created_at" json:"createdAt"`
+	CreatedBy  string   `bson:"created_by" json:"createdBy"`
+	ExpiresAt  *int64   `bson:"expires_at,omitempty" json:"expiresAt,omitempty"`
+	LastUsedAt *int64   `bson:"last_used_at,omitempty" json:"lastUsedAt,omitempty"`
+	RevokedAt  *int64   `bson:"revoked_at,omitempty" json:"revokedAt,omitempty"`
+}
+
+// APITokenInput defines structure to create an API token, the token is restricted to the
+// given projects when projectIDs are set and never expires when expiresInDays is not set
+type APITokenInput struct {
+	Name          string   `json:"name"`
+	UserID        string   `json:"userID"`
+	ProjectIDs    []string `json:"projectIDs"`
+	ExpiresInDays *int     `json:"expiresInDays"`
+}
+
+// RevokeAPITokenInput defines structure to revoke an API token
+type RevokeAPITokenInput struct {
+	TokenID string `json:"tokenID"`
+}
+
+// ServiceAccountInput defines structure to create a service account bound to a project role
+type ServiceAccountInput struct {
+	Username  string     `json:"username"`
+	Name      string     `json:"name"`
+	ProjectID string     `json:"projectID"`
+	Role      MemberRole `json:"role"`
+}
@@ -0,0 +1,38 @@
+package entities
+
+// Outcome of an audited operation
+const (
+	AuditOutcomeSuccess = "SUCCESS"
+	AuditOutcomeFailure = "FAILURE"
+)
+
+// Types of the resources the audited operations are performed on
+const (
+	AuditResourceUser           = "User"
+	AuditResourceProject        = "Project"
+	AuditResourceInvitation     = "Invitation"
+	AuditResourceCustomRole     = "CustomRole"
+	AuditResourceAPIToken       = "APIToken"
+	AuditResourceServiceAccount = "ServiceAccount"
+)
+
+// AuditEvent is an operation performed by a user, audit events are append only and
+// are stored along with the audit events of the GraphQL server
+type AuditEvent struct {
+	EventID      string     `bson:"event_id"`
+	ProjectID    string     `bson:"project_id,omitempty"`
+	Timestamp    int64      `bson:"timestamp"`
+	Actor        AuditActor `bson:"actor"`
+	Action       string     `bson:"action"`
+	ResourceType string     `bson:"resource_type"`
+	ResourceID   string     `bson:"resource_id,omitempty"`
+	Outcome      string     `bson:"outcome"`
+	Error        string     `bson:"error,omitempty"`
+	Source       string     `bson:"source"`
+}
+
+// AuditActor is the user who performed an audited operation
+type AuditActor struct {
+	UserID   string `bson:"user_id"`
+	Username string `bson:"username"`
+}
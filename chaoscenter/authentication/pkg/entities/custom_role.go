@@ -0,0 +1,26 @@
+package entities
+
+// CustomRole is a project role defined by the owners of the project as a set of permissions,
+// its members are granted the permitted operations in addition to the access of a project member
+type CustomRole struct {
+	Audit       `bson:",inline"`
+	ID          string   `bson:"_id" json:"roleID"`
+	ProjectID   string   `bson:"project_id" json:"projectID"`
+	Name        string   `bson:"name" json:"name"`
+	Description string   `bson:"description" json:"description"`
+	Permissions []string `bson:"permissions" json:"permissions"`
+}
+
+// CustomRoleInput defines structure for custom role related requests
+type CustomRoleInput struct {
+	ProjectID   string   `json:"projectID"`
+	RoleID      string   `json:"roleID"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+// IsBuiltInRole checks if the role is one of the roles every project has
+func IsBuiltInRole(role MemberRole) bool {
+	return role == RoleOwner || role == RoleEditor || role == RoleViewer
+}
@@ -25,6 +25,8 @@ type User struct {
 	Name          string `bson:"name,omitempty" json:"name,omitempty"`
 	Role          Role   `bson:"role,omitempty" json:"role"`
 	DeactivatedAt *int64 `bson:"deactivated_at,omitempty" json:"deactivatedAt,omitempty"`
+	// ServiceAccount marks non-human users which can only authenticate with API tokens
+	ServiceAccount bool `bson:"service_account,omitempty" json:"serviceAccount,omitempty"`
 }
 
 // UserDetails is used to update user's personal details
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type apiTokenService interface {
+	CreateAPIToken(user *entities.User, input *entities.APITokenInput, createdBy string) (string, *entities.APIToken, error)
+	GetAPIToken(tokenID string) (*entities.APIToken, error)
+	GetAPITokens(userID string) ([]*entities.APIToken, error)
+	RevokeAPIToken(tokenID string) error
+}
+
+// CreateAPIToken generates a signed API token for the user and stores its metadata, the token
+// is only returned here and can't be retrieved again
+func (a applicationService) CreateAPIToken(user *entities.User, input *entities.APITokenInput, createdBy string) (string, *entities.APIToken, error) {
+	apiToken := &entities.APIToken{
+		ID:         uuid.Must(uuid.NewRandom()).String(),
+		Name:       input.Name,
+		UserID:     user.ID,
+		ProjectIDs: input.ProjectIDs,
+		CreatedAt:  time.Now().Unix(),
+		CreatedBy:  createdBy,
+	}
+
+	token := jwt.New(jwt.SigningMethodHS512)
+	claims := token.Claims.(jwt.MapClaims)
+	claims["uid"] = user.ID
+	claims["role"] = user.Role
+	claims["username"] = user.Username
+	claims[utils.TokenIDClaim] = apiToken.ID
+	if len(input.ProjectIDs) > 0 {
+		claims[utils.ProjectScopeClaim] = input.ProjectIDs
+	}
+	if input.ExpiresInDays != nil {
+		expiresAt := time.Now().AddDate(0, 0, *input.ExpiresInDays).Unix()
+		apiToken.ExpiresAt = &expiresAt
+		claims["exp"] = expiresAt
+	}
+
+	tokenString, err := token.SignedString([]byte(utils.JwtSecret))
+	if err != nil {
+		log.Info(err)
+		return "", nil, err
+	}
+
+	if err = a.apiTokenRepository.CreateAPIToken(apiToken); err != nil {
+		return "", nil, err
+	}
+	return tokenString, apiToken, nil
+}
+
+// GetAPIToken fetches the API token that matches the passed tokenID
+func (a applicationService) GetAPIToken(tokenID string) (*entities.APIToken, error) {
+	return a.apiTokenRepository.GetAPIToken(tokenID)
+}
+
+// GetAPITokens fetches all the API tokens of the user
+func (a applicationService) GetAPITokens(userID string) ([]*entities.APIToken, error) {
+	return a.apiTokenRepository.GetAPITokensByUserID(userID)
+}
+
+// RevokeAPIToken revokes the API token that matches the passed tokenID
+func (a applicationService) RevokeAPIToken(tokenID string) error {
+	return a.apiTokenRepository.RevokeAPIToken(tokenID, time.Now().Unix())
+}
+
+// validateAPIToken checks that the API token the claims were issued for has not been revoked and records its usage
+func (a applicationService) validateAPIToken(tokenID string) error {
+	apiToken, err := a.apiTokenRepository.GetAPIToken(tokenID)
+	if err == mongo.ErrNoDocuments {
+		return fmt.Errorf("token revoked")
+	} else if err != nil {
+		return err
+	}
+	if apiToken.RevokedAt != nil {
+		return fmt.Errorf("token revoked")
+	}
+
+	if err = a.apiTokenRepository.UpdateLastUsed(tokenID, time.Now().Unix(), utils.APITokenLastUsedInterval); err != nil {
+		log.Warnf("failed to update the last usage of api token %s, error: %v", tokenID, err)
+	}
+	return nil
+}
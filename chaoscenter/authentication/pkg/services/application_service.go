@@ -1,6 +1,9 @@
 package services
 
 import (
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/api_token"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/audit"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/custom_role"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/misc"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/project"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/session"
@@ -15,23 +18,32 @@ type ApplicationService interface {
 	transactionService
 	miscService
 	sessionService
+	apiTokenService
+	customRoleService
+	auditService
 }
 
 type applicationService struct {
-	userRepository    user.Repository
-	projectRepository project.Repository
-	miscRepository    misc.Repository
-	sessionRepository session.Repository
-	db                *mongo.Database
+	userRepository       user.Repository
+	projectRepository    project.Repository
+	miscRepository       misc.Repository
+	sessionRepository    session.Repository
+	apiTokenRepository   api_token.Repository
+	customRoleRepository custom_role.Repository
+	auditRepository      audit.Repository
+	db                   *mongo.Database
 }
 
 // NewService creates a new instance of this service
-func NewService(userRepo user.Repository, projectRepo project.Repository, miscRepo misc.Repository, sessionRepo session.Repository, db *mongo.Database) ApplicationService {
+func NewService(userRepo user.Repository, projectRepo project.Repository, miscRepo misc.Repository, sessionRepo session.Repository, apiTokenRepo api_token.Repository, customRoleRepo custom_role.Repository, auditRepo audit.Repository, db *mongo.Database) ApplicationService {
 	return &applicationService{
-		userRepository:    userRepo,
-		projectRepository: projectRepo,
-		sessionRepository: sessionRepo,
-		db:                db,
-		miscRepository:    miscRepo,
+		userRepository:       userRepo,
+		projectRepository:    projectRepo,
+		sessionRepository:    sessionRepo,
+		apiTokenRepository:   apiTokenRepo,
+		customRoleRepository: customRoleRepo,
+		auditRepository:      auditRepo,
+		db:                   db,
+		miscRepository:       miscRepo,
 	}
 }
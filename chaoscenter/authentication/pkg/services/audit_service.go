@@ -0,0 +1,21 @@
+package services
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+)
+
+type auditService interface {
+	RecordAuditEvent(event *entities.AuditEvent) error
+}
+
+// RecordAuditEvent stores the audit event of an operation performed through the authentication service
+func (a applicationService) RecordAuditEvent(event *entities.AuditEvent) error {
+	event.EventID = uuid.Must(uuid.NewRandom()).String()
+	event.Timestamp = time.Now().UnixMilli()
+	event.Source = utils.AuditSource
+	return a.auditRepository.InsertAuditEvent(event)
+}
@@ -0,0 +1,50 @@
+package services
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+)
+
+type customRoleService interface {
+	CreateCustomRole(role *entities.CustomRole) error
+	GetCustomRole(projectID string, roleID string) (*entities.CustomRole, error)
+	GetCustomRoleByName(projectID string, name string) (*entities.CustomRole, error)
+	GetCustomRoles(projectID string) ([]*entities.CustomRole, error)
+	GetCustomRoleNames(projectID string, permission string) ([]string, error)
+	UpdateCustomRole(role *entities.CustomRole) error
+	DeleteCustomRole(projectID string, roleID string) error
+}
+
+// CreateCustomRole creates a new custom role for a project
+func (a applicationService) CreateCustomRole(role *entities.CustomRole) error {
+	return a.customRoleRepository.CreateCustomRole(role)
+}
+
+// GetCustomRole fetches the custom role of the project that matches the passed roleID
+func (a applicationService) GetCustomRole(projectID string, roleID string) (*entities.CustomRole, error) {
+	return a.customRoleRepository.GetCustomRole(projectID, roleID)
+}
+
+// GetCustomRoleByName fetches the custom role of the project that matches the passed name
+func (a applicationService) GetCustomRoleByName(projectID string, name string) (*entities.CustomRole, error) {
+	return a.customRoleRepository.GetCustomRoleByName(projectID, name)
+}
+
+// GetCustomRoles fetches all the custom roles of the project
+func (a applicationService) GetCustomRoles(projectID string) ([]*entities.CustomRole, error) {
+	return a.customRoleRepository.GetCustomRoles(projectID)
+}
+
+// GetCustomRoleNames fetches the names of the custom roles of the project granting the permission
+func (a applicationService) GetCustomRoleNames(projectID string, permission string) ([]string, error) {
+	return a.customRoleRepository.GetCustomRoleNames(projectID, permission)
+}
+
+// UpdateCustomRole updates the description and the permissions of the custom role
+func (a applicationService) UpdateCustomRole(role *entities.CustomRole) error {
+	return a.customRoleRepository.UpdateCustomRole(role)
+}
+
+// DeleteCustomRole deletes the custom role of the project
+func (a applicationService) DeleteCustomRole(projectID string, roleID string) error {
+	return a.customRoleRepository.DeleteCustomRole(projectID, roleID)
+}
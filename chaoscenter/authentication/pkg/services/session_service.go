@@ -41,6 +41,12 @@ func (a applicationService) ValidateToken(encodedToken string) (*jwt.Token, erro
 	if a.isTokenRevoked(parsedToken.Raw) {
 		return &jwt.Token{Valid: false}, fmt.Errorf("token revoked")
 	}
+	// API tokens are revoked through their metadata instead of the revoked tokens
+	if tokenID, ok := parsedToken.Claims.(jwt.MapClaims)[utils.TokenIDClaim].(string); ok {
+		if err := a.validateAPIToken(tokenID); err != nil {
+			return &jwt.Token{Valid: false}, err
+		}
+	}
 	return parsedToken, err
 }
 
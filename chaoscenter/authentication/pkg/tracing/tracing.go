@@ -0,0 +1,55 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ServiceName is the name of the authentication server in the exported spans unless OTEL_SERVICE_NAME is set
+	ServiceName         = "litmus-authentication-server"
+	instrumentationName = "github.com/litmuschaos/litmus/chaoscenter/authentication"
+)
+
+// InitTracer registers the global tracer provider and propagator of the authentication server and returns the
+// function flushing the spans on shutdown, the spans are only exported when an OTLP endpoint is configured, the
+// trace context of the callers is propagated either way
+func InitTracer(ctx context.Context, endpoint string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	// the exporter reads the endpoint, headers and TLS settings from the standard OTEL_EXPORTER_OTLP_* variables
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceNameKey.String(ServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the authentication server
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
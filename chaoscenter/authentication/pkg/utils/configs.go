@@ -21,14 +21,23 @@ var (
 	DexClientID                  = os.Getenv("DEX_OAUTH_CLIENT_ID")
 	DexClientSecret              = os.Getenv("DEX_OAUTH_CLIENT_SECRET")
 	DexOIDCIssuer                = os.Getenv("OIDC_ISSUER")
+	OtelExporterOtlpEndpoint     = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
 	DBName                       = "auth"
 	Port                         = ":3000"
 	GrpcPort                     = ":3030"
 	UserCollection               = "users"
 	ProjectCollection            = "project"
 	RevokedTokenCollection       = "revoked-token"
+	APITokenCollection           = "api-token"
+	CustomRoleCollection         = "custom-role"
+	AuditDBName                  = "litmus"
+	AuditEventCollection         = "auditEvents"
+	AuditSource                  = "authentication"
 	UsernameField                = "username"
 	ExpiresAtField               = "expires_at"
+	TokenIDClaim                 = "token_id"
+	ProjectScopeClaim            = "project_ids"
+	APITokenLastUsedInterval     = int64(60)
 	PasswordEncryptionCost       = 15
 	DefaultLitmusGqlGrpcEndpoint = "localhost"
 	DefaultLitmusGqlGrpcPort     = ":8000"
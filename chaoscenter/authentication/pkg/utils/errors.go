@@ -21,6 +21,11 @@ var (
 	ErrEmptyProjectName              AppError = errors.New("invalid project name")
 	ErrInvalidRole                   AppError = errors.New("invalid role")
 	ErrInvalidEmail                  AppError = errors.New("invalid email")
+	ErrAPITokenNotFound              AppError = errors.New("api token does not exist")
+	ErrInvalidPermission             AppError = errors.New("invalid permission")
+	ErrCustomRoleExists              AppError = errors.New("custom_role_exists")
+	ErrCustomRoleNotFound            AppError = errors.New("custom role does not exist")
+	ErrCustomRoleInUse               AppError = errors.New("custom role is in use")
 )
 
 // ErrorStatusCodes holds the http status codes for every AppError
@@ -39,6 +44,11 @@ var ErrorStatusCodes = map[AppError]int{
 	ErrEmptyProjectName:              400,
 	ErrInvalidRole:                   400,
 	ErrInvalidEmail:                  400,
+	ErrAPITokenNotFound:              400,
+	ErrInvalidPermission:             400,
+	ErrCustomRoleExists:              400,
+	ErrCustomRoleNotFound:            400,
+	ErrCustomRoleInUse:               400,
 }
 
 // ErrorDescriptions holds detailed error description for every AppError
@@ -53,4 +63,9 @@ var ErrorDescriptions = map[AppError]string{
 	ErrInvalidRole:                   "Role is invalid",
 	ErrProjectNotFound:               "This project does not exist",
 	ErrInvalidEmail:                  "Email address is invalid",
+	ErrAPITokenNotFound:              "This API token does not exist or has already been revoked",
+	ErrInvalidPermission:             "Permissions are invalid, a custom role requires at least one of the project permissions",
+	ErrCustomRoleExists:              "This role name is already assigned to another role of the project",
+	ErrCustomRoleNotFound:            "This custom role does not exist",
+	ErrCustomRoleInUse:               "This custom role is assigned to members of the project",
 }
@@ -0,0 +1,114 @@
+package validations
+
+import (
+	"strings"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+)
+
+// ProjectPermissions are the operations of the GraphQL server which can be granted to a custom role,
+// they must be kept in sync with the RoleQuery constants of the server. Project membership is managed
+// by the authentication service itself and can't be delegated to custom roles
+var ProjectPermissions = []string{
+	"userInfrastructureReg",
+	"CreateChaosWorkFlow",
+	"ReRunChaosWorkFlow",
+	"DeleteChaosWorkflow",
+	"TerminateChaosWorkflow",
+	"SyncWorkflow",
+	"AddChaosHub",
+	"SyncChaosHub",
+	"UpdateChaosWorkflow",
+	"DeleteInfrastructures",
+	"UpdateChaosHub",
+	"DeleteChaosHub",
+	"EnableGitOps",
+	"DisableGitOps",
+	"UpdateGitOps",
+	"CreateDataSource",
+	"CreateDashBoard",
+	"UpdateDataSource",
+	"UpdateDashboard",
+	"DeleteDashboard",
+	"DeleteDataSource",
+	"ListWorkflowRuns",
+	"GetWorkflowRun",
+	"ListInfrastructures",
+	"GetInfrastructure ",
+	"GetManifest",
+	"GetInfraDetails",
+	"ListHeatmapData",
+	"ListWorkflowStats",
+	"ListCharts",
+	"GetChaosFault",
+	"GetWorkflowRunStats",
+	"ListChaosHubs",
+	"ListPortalDashboardData",
+	"ListWorkflow",
+	"SaveChaosHub",
+	"CreateWorkflowTemplate",
+	"DeleteWorkflowTemplate",
+	"CreateImageRegistry",
+	"UpdateImageRegistry",
+	"DeleteImageRegistry",
+	"GetYAMLData",
+	"PredefinedWorkflowOperations",
+	"ListPredefinedWorkflows",
+	"GetPredefinedExperimentYaml",
+	"GetExperimentDetails",
+	"ListDataSource",
+	"ListDashboard",
+	"GetGitOpsDetails",
+	"GetGitOpsDriftReport",
+	"ResolveGitOpsDrift",
+	"ListWorkflowManifests",
+	"GetWorkflowManifestByID",
+	"ListImageRegistry",
+	"GetImageRegistry",
+	"CreateEnvironment",
+	"UpdateEnvironment",
+	"DeleteEnvironment",
+	"GetEnvironment",
+	"ListEnvironments",
+	"ListAuditEvents",
+	"CreateBlackoutWindow",
+	"UpdateBlackoutWindow",
+	"DeleteBlackoutWindow",
+	"ListBlackoutWindows",
+	"CreateNotificationRule",
+	"UpdateNotificationRule",
+	"DeleteNotificationRule",
+	"ListNotificationRules",
+	"ListNotificationDeliveries",
+	"RetryNotificationDelivery",
+}
+
+// ValidatePermissions validates the permissions of a custom role and returns them as defined in ProjectPermissions
+func ValidatePermissions(permissions []string) ([]string, error) {
+	if len(permissions) == 0 {
+		return nil, utils.ErrInvalidPermission
+	}
+
+	var (
+		validated []string
+		seen      = map[string]bool{}
+	)
+	for _, permission := range permissions {
+		var found bool
+		for _, projectPermission := range ProjectPermissions {
+			// some of the operations are defined with surrounding spaces in the server
+			if strings.TrimSpace(projectPermission) == strings.TrimSpace(permission) {
+				found = true
+				if !seen[projectPermission] {
+					seen[projectPermission] = true
+					validated = append(validated, projectPermission)
+				}
+				break
+			}
+		}
+		if !found {
+			return nil, utils.ErrInvalidPermission
+		}
+	}
+	return validated, nil
+}
@@ -3,15 +3,26 @@ package validations
 import (
 	"errors"
 
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
 
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+// RbacValidator validates that the user is a member of the project with one of the required roles, members
+// with a custom role have the access of a viewer to the operations of the authentication service
 func RbacValidator(uid string, projectID string,
 	requiredRoles []string, invitation string,
 	service services.ApplicationService) error {
+	return PermissionValidator(uid, projectID, requiredRoles, "", invitation, service)
+}
+
+// PermissionValidator validates that the user is a member of the project with one of the required
+// roles or with a custom role granting the permission
+func PermissionValidator(uid string, projectID string,
+	requiredRoles []string, permission string, invitation string,
+	service services.ApplicationService) error {
 
 	user, err := service.GetUser(uid)
 	if err != nil {
@@ -23,6 +34,12 @@ func RbacValidator(uid string, projectID string,
 		return errors.New("auth gRPC - Deactivated User")
 	}
 
+	roles, err := getPermittedRoles(projectID, requiredRoles, permission, service)
+	if err != nil {
+		log.Errorf("authgRPC Error: querying for custom roles -  %s", err)
+		return err
+	}
+
 	// Check for project permission validity
 	filter := bson.D{
 		{"_id", projectID},
@@ -30,7 +47,7 @@ func RbacValidator(uid string, projectID string,
 			{"$elemMatch", bson.D{
 				{"user_id", uid},
 				{"role", bson.D{
-					{"$in", requiredRoles},
+					{"$in", roles},
 				}},
 				{"invitation", invitation},
 			}},
@@ -49,3 +66,25 @@ func RbacValidator(uid string, projectID string,
 
 	return nil
 }
+
+// getPermittedRoles returns the required roles along with the custom roles of the project granting the permission
+func getPermittedRoles(projectID string, requiredRoles []string, permission string,
+	service services.ApplicationService) ([]string, error) {
+	if permission == "" {
+		isViewerPermitted := false
+		for _, role := range requiredRoles {
+			if role == string(entities.RoleViewer) {
+				isViewerPermitted = true
+			}
+		}
+		if !isViewerPermitted {
+			return requiredRoles, nil
+		}
+	}
+
+	customRoles, err := service.GetCustomRoleNames(projectID, permission)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]string{}, requiredRoles...), customRoles...), nil
+}
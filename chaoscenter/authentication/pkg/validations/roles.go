@@ -7,8 +7,10 @@ var MutationRbacRules = map[string][]string{
 	"acceptInvitation": {string(entities.RoleViewer), string(entities.RoleEditor)},
 	"declineInvitation": {string(entities.RoleViewer),
 		string(entities.RoleEditor)},
-	"removeInvitation":  {string(entities.RoleOwner)},
-	"leaveProject":      {string(entities.RoleViewer), string(entities.RoleEditor)},
-	"updateProjectName": {string(entities.RoleOwner)},
-	"getProject":        {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleEditor)},
+	"removeInvitation":     {string(entities.RoleOwner)},
+	"leaveProject":         {string(entities.RoleViewer), string(entities.RoleEditor)},
+	"updateProjectName":    {string(entities.RoleOwner)},
+	"getProject":           {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleEditor)},
+	"manageServiceAccount": {string(entities.RoleOwner)},
+	"manageCustomRole":     {string(entities.RoleOwner)},
 }
@@ -0,0 +1,38 @@
+package validations
+
+import (
+	"errors"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+)
+
+// GetTokenScope returns the projects an API token is restricted to, tokens
+// without a scope have access to every project of their user
+func GetTokenScope(claims jwt.MapClaims) []string {
+	projectIDs, ok := claims[utils.ProjectScopeClaim].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	var scope []string
+	for _, projectID := range projectIDs {
+		if id, ok := projectID.(string); ok {
+			scope = append(scope, id)
+		}
+	}
+	return scope
+}
+
+// ScopeValidator validates that the projectID is within the scope of the token
+func ScopeValidator(scope []string, projectID string) error {
+	if len(scope) == 0 {
+		return nil
+	}
+	for _, id := range scope {
+		if id == projectID {
+			return nil
+		}
+	}
+	return errors.New("auth gRPC - project is outside the token scope")
+}
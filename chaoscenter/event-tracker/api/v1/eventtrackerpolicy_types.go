@@ -30,24 +30,71 @@ type EventTrackerPolicySpec struct {
 
 	ConditionType string      `json:"condition_type,omitempty"`
 	Conditions    []Condition `json:"conditions,omitempty"`
+	// Expression is a CEL expression evaluated against the updated resource as newObject and the previous one as
+	// oldObject, the policy passes when both the expression and the conditions pass
+	Expression string `json:"expression,omitempty"`
+	// Resources are the kinds of resources audited by the policy, the deployments, statefulsets and daemonsets
+	// are audited when no resource is listed
+	Resources []TrackedResource `json:"resources,omitempty"`
+
+	// Cooldown is the minimum duration between two triggers of the same experiment by the policy, e.g. 10m
+	Cooldown string `json:"cooldown,omitempty"`
+	// DebounceWindow holds the trigger back until no update passed the conditions for the given duration, the
+	// updates passing the conditions meanwhile are coalesced into a single trigger
+	DebounceWindow string `json:"debounce_window,omitempty"`
+	// MaxTriggersPerHour limits the number of experiments triggered by the policy in the last hour
+	MaxTriggersPerHour int `json:"max_triggers_per_hour,omitempty"`
+	// StatusHistoryLimit is the number of statuses kept in the policy, 100 by default
+	StatusHistoryLimit int `json:"status_history_limit,omitempty"`
+	// SkipIfRunning skips the trigger when a run of the experiment is in progress on the infra, in the gitops trigger
+	// mode the runs which haven't been updated for an hour are considered stuck and don't skip the trigger
+	SkipIfRunning bool `json:"skip_if_running,omitempty"`
+	// TriggerMode is how the experiment is triggered, gitops notifies the server which runs the experiment only when
+	// gitops is enabled for the project, direct runs the experiment regardless of the gitops settings and stores the
+	// resource event on the experiment run, gitops by default
+	TriggerMode string `json:"trigger_mode,omitempty"`
 }
 
+// TrackedResource identifies a kind of resources watched by the event-tracker, any built-in resource or CRD
+// annotated with litmuschaos.io/gitops and litmuschaos.io/workflow can be tracked
+type TrackedResource struct {
+	Group    string `json:"group,omitempty"`
+	Version  string `json:"version"`
+	Resource string `json:"resource"`
+}
+
+// Condition compares the value found at the JMESPath key of the updated resource, or nests a group of conditions
+// composed by its condition type when the conditions are set
 type Condition struct {
 	Key      string  `json:"key,omitempty"`
 	Value    *string `json:"value,omitempty"`
 	Operator string  `json:"operator,omitempty"`
+	// Values are the values compared by the In and NotIn operators
+	Values []string `json:"values,omitempty"`
+	// ValueType is the type the values are compared as, one of string, number, semver, duration and quantity,
+	// numbers are compared as numbers and anything else as strings when empty
+	ValueType string `json:"value_type,omitempty"`
+
+	ConditionType string      `json:"condition_type,omitempty"`
+	Conditions    []Condition `json:"conditions,omitempty"`
 }
 
 // EventTrackerPolicyStatus defines the observed state of EventTrackerPolicy
 type EventTrackerPolicyStatus struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
-	TimeStamp    string `json:"time_stamp,omitempty"`
-	Resource     string `json:"resource,omitempty"`
-	ResourceName string `json:"resource_name,omitempty"`
-	Result       string `json:"result,omitempty"`
-	WorkflowID   string `json:"workflow_id,omitempty"`
-	IsTriggered  string `json:"is_triggered,omitempty"`
+	TimeStamp         string `json:"time_stamp,omitempty"`
+	Resource          string `json:"resource,omitempty"`
+	ResourceName      string `json:"resource_name,omitempty"`
+	ResourceNamespace string `json:"resource_namespace,omitempty"`
+	Result            string `json:"result,omitempty"`
+	WorkflowID        string `json:"workflow_id,omitempty"`
+	IsTriggered       string `json:"is_triggered,omitempty"`
+	TriggeredAt       string `json:"triggered_at,omitempty"`
+	// Diff lists the changes of the resource which passed the conditions
+	Diff string `json:"diff,omitempty"`
+	// Reason explains why the trigger was skipped
+	Reason string `json:"reason,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -58,8 +105,17 @@ type EventTrackerPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
 
-	Spec     EventTrackerPolicySpec     `json:"spec,omitempty"`
-	Statuses []EventTrackerPolicyStatus `json:"statuses,omitempty"`
+	Spec       EventTrackerPolicySpec     `json:"spec,omitempty"`
+	Statuses   []EventTrackerPolicyStatus `json:"statuses,omitempty"`
+	Validation *PolicyValidation          `json:"validation,omitempty"`
+}
+
+// PolicyValidation reports whether the conditions, the expression and the resources of the policy are valid, the
+// invalid policies are not audited
+type PolicyValidation struct {
+	Valid              bool     `json:"valid"`
+	Errors             []string `json:"errors,omitempty"`
+	ObservedGeneration int64    `json:"observed_generation,omitempty"`
 }
 
 //+kubebuilder:object:root=true
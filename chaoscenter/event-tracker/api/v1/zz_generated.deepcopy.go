@@ -33,6 +33,18 @@ func (in *Condition) DeepCopyInto(out *Condition) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Condition.
@@ -56,6 +68,11 @@ func (in *EventTrackerPolicy) DeepCopyInto(out *EventTrackerPolicy) {
 		*out = make([]EventTrackerPolicyStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.Validation != nil {
+		in, out := &in.Validation, &out.Validation
+		*out = new(PolicyValidation)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventTrackerPolicy.
@@ -118,6 +135,11 @@ func (in *EventTrackerPolicySpec) DeepCopyInto(out *EventTrackerPolicySpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]TrackedResource, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventTrackerPolicySpec.
@@ -144,3 +166,38 @@ func (in *EventTrackerPolicyStatus) DeepCopy() *EventTrackerPolicyStatus {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PolicyValidation) DeepCopyInto(out *PolicyValidation) {
+	*out = *in
+	if in.Errors != nil {
+		in, out := &in.Errors, &out.Errors
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PolicyValidation.
+func (in *PolicyValidation) DeepCopy() *PolicyValidation {
+	if in == nil {
+		return nil
+	}
+	out := new(PolicyValidation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrackedResource) DeepCopyInto(out *TrackedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrackedResource.
+func (in *TrackedResource) DeepCopy() *TrackedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(TrackedResource)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/json"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/event-tracker/pkg/utils"
 	"github.com/sirupsen/logrus"
@@ -37,13 +38,22 @@ import (
 // EventTrackerPolicyReconciler reconciles a EventTrackerPolicy object
 type EventTrackerPolicyReconciler struct {
 	client.Client
-	Scheme *runtime.Scheme
+	Scheme  *runtime.Scheme
+	Tracker *utils.ResourceTracker
 }
 
 type apiResponse struct {
 	Data struct {
-		GitopsNotifer string `json:"gitopsNotifer"`
+		GitopsNotifier           string `json:"gitopsNotifier"`
+		TriggerExperimentOnEvent *struct {
+			NotifyID *string `json:"notifyID"`
+			Skipped  bool    `json:"skipped"`
+			Reason   *string `json:"reason"`
+		} `json:"triggerExperimentOnEvent"`
 	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
 }
 
 //+kubebuilder:rbac:groups=eventtracker.litmuschaos.io,resources=eventtrackerpolicies,verbs=get;list;watch;create;update;patch;delete
@@ -74,10 +84,44 @@ func (r *EventTrackerPolicyReconciler) Reconcile(ctx context.Context, req ctrl.R
 		return ctrl.Result{}, err
 	}
 
+	// report the validation errors of the conditions and of the resources which can't be tracked, the invalid
+	// policies are skipped by the auditor, start watching the resources newly listed in the policy
+	validationErrors := utils.ValidatePolicy(etp.Spec)
+	validationErrors = append(validationErrors, r.Tracker.Track(utils.PolicyResources(etp)...)...)
+	etp.Validation = &eventtrackerv1.PolicyValidation{
+		Valid:              len(validationErrors) == 0,
+		Errors:             validationErrors,
+		ObservedGeneration: etp.GetGeneration(),
+	}
+	if len(validationErrors) > 0 {
+		logrus.Errorf("Invalid event-tracker policy %s: %s", req.NamespacedName, strings.Join(validationErrors, ", "))
+	}
+
+	var requeueAfter time.Duration
 	for index, status := range etp.Statuses {
-		if status.Result == utils.ConditionPassed && strings.ToLower(status.IsTriggered) == "false" {
+		if status.Result == utils.ConditionPassed && strings.ToLower(status.IsTriggered) == utils.TriggerPending {
+			// hold the trigger back until the debounce window elapses, or skip it when throttled
+			wait, reason := utils.ThrottleTrigger(etp, index, time.Now())
+			if wait > 0 {
+				if requeueAfter == 0 || wait < requeueAfter {
+					requeueAfter = wait
+				}
+				continue
+			}
+			if reason != "" {
+				logrus.Print("Skipping trigger of WorkflowID: " + status.WorkflowID + ", reason: " + reason)
+				etp.Statuses[index].IsTriggered = utils.TriggerSkipped
+				etp.Statuses[index].Reason = reason
+				continue
+			}
+
 			logrus.Print("ResourceName: " + status.ResourceName + ", WorkflowID: " + status.WorkflowID)
-			response, err := utils.SendRequest(status.WorkflowID)
+			var response string
+			if etp.Spec.TriggerMode == utils.TriggerModeDirect {
+				response, err = utils.SendEventTrigger(etp.GetName(), status, etp.Spec.SkipIfRunning)
+			} else {
+				response, err = utils.SendRequest(status.WorkflowID, etp.Spec.SkipIfRunning)
+			}
 			if err != nil {
 				return ctrl.Result{}, err
 			}
@@ -90,10 +134,35 @@ func (r *EventTrackerPolicyReconciler) Reconcile(ctx context.Context, req ctrl.R
 				return ctrl.Result{}, err
 			}
 
-			if res.Data.GitopsNotifer == "Gitops Disabled" {
-				etp.Statuses[index].IsTriggered = "false"
-			} else {
-				etp.Statuses[index].IsTriggered = "true"
+			// the experiment is run by the server regardless of the gitops settings in the direct trigger mode
+			if etp.Spec.TriggerMode == utils.TriggerModeDirect {
+				switch trigger := res.Data.TriggerExperimentOnEvent; {
+				case len(res.Errors) > 0:
+					etp.Statuses[index].IsTriggered = utils.TriggerSkipped
+					etp.Statuses[index].Reason = res.Errors[0].Message
+				case trigger != nil && trigger.Skipped:
+					etp.Statuses[index].IsTriggered = utils.TriggerSkipped
+					if trigger.Reason != nil {
+						etp.Statuses[index].Reason = *trigger.Reason
+					}
+				default:
+					etp.Statuses[index].IsTriggered = utils.TriggerSent
+					etp.Statuses[index].TriggeredAt = time.Now().Format(utils.StatusTimeFormat)
+				}
+				continue
+			}
+
+			switch res.Data.GitopsNotifier {
+			case "Gitops Disabled":
+				// the trigger isn't retried, it would be sent again on every reconcile until gitops is enabled
+				etp.Statuses[index].IsTriggered = utils.TriggerSkipped
+				etp.Statuses[index].Reason = utils.ReasonGitopsDisabled
+			case utils.ExperimentRunInProgress:
+				etp.Statuses[index].IsTriggered = utils.TriggerSkipped
+				etp.Statuses[index].Reason = utils.ReasonRunInProgress
+			default:
+				etp.Statuses[index].IsTriggered = utils.TriggerSent
+				etp.Statuses[index].TriggeredAt = time.Now().Format(utils.StatusTimeFormat)
 			}
 		}
 	}
@@ -105,7 +174,7 @@ func (r *EventTrackerPolicyReconciler) Reconcile(ctx context.Context, req ctrl.R
 
 	defer mutex.Unlock()
 
-	return ctrl.Result{}, nil
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // SetupWithManager sets up the controller with the Manager.
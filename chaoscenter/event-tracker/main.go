@@ -25,13 +25,16 @@ import (
 	"os"
 	rt "runtime"
 	"strings"
-	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/litmuschaos/litmus/chaoscenter/event-tracker/pkg/k8s"
 	"github.com/litmuschaos/litmus/chaoscenter/event-tracker/pkg/utils"
 	"github.com/sirupsen/logrus"
-	"k8s.io/client-go/informers"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
@@ -53,6 +56,7 @@ import (
 var (
 	scheme   = runtime.NewScheme()
 	setupLog = ctrl.Log.WithName("setup")
+	tracker  *utils.ResourceTracker
 )
 
 type Config struct {
@@ -83,25 +87,36 @@ func init() {
 	utilruntime.Must(eventtrackerv1.AddToScheme(scheme))
 	//+kubebuilder:scaffold:scheme
 
-	clientset, err := k8s.K8sClient()
+	restConfig, err := k8s.GetKubeConfig()
 	if err != nil {
-		logrus.Error(err)
+		logrus.Fatal(err)
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		logrus.Fatal(err)
 	}
 
 	var (
 		agent_scope = os.Getenv("AGENT_SCOPE")
-		factory     informers.SharedInformerFactory
+		namespace   = metav1.NamespaceAll
 	)
 
-	if agent_scope == "cluster" {
-		factory = informers.NewSharedInformerFactory(clientset, 30*time.Second)
-	} else if agent_scope == "namespace" {
-		factory = informers.NewSharedInformerFactoryWithOptions(clientset, 30*time.Second, informers.WithNamespace(os.Getenv("AGENT_NAMESPACE")))
+	if agent_scope == "namespace" {
+		namespace = os.Getenv("AGENT_NAMESPACE")
 	}
 
-	go utils.RunDeploymentInformer(factory)
-	go utils.RunDSInformer(factory)
-	go utils.RunStsInformer(factory)
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		logrus.Fatal(err)
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	// the resources listed in the policies are tracked as the policies get reconciled
+	tracker = utils.NewResourceTracker(dynamicClient, mapper, namespace, make(chan struct{}))
+	if errs := tracker.Track(utils.DefaultTrackedResources...); len(errs) > 0 {
+		logrus.Errorf("Failed to track the default resources: %s", strings.Join(errs, ", "))
+	}
 
 }
 
@@ -165,8 +180,9 @@ func main() {
 	}
 
 	if err = (&controllers.EventTrackerPolicyReconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Tracker: tracker,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "EventTrackerPolicy")
 		os.Exit(1)
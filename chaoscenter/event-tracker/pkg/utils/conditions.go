@@ -0,0 +1,417 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver/v4"
+	"github.com/google/cel-go/cel"
+	"github.com/jmespath/go-jmespath"
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	ConditionTypeAnd = "and"
+	ConditionTypeOr  = "or"
+)
+
+const (
+	OperatorChange             = "Change"
+	OperatorEqualTo            = "EqualTo"
+	OperatorNotEqualTo         = "NotEqualTo"
+	OperatorLessThan           = "LessThan"
+	OperatorGreaterThan        = "GreaterThan"
+	OperatorLessThanEqualTo    = "LessThanEqualTo"
+	OperatorGreaterThanEqualTo = "GreaterThanEqualTo"
+	OperatorIn                 = "In"
+	OperatorNotIn              = "NotIn"
+	OperatorMatches            = "Matches"
+)
+
+const (
+	ValueTypeString   = "string"
+	ValueTypeNumber   = "number"
+	ValueTypeSemver   = "semver"
+	ValueTypeDuration = "duration"
+	ValueTypeQuantity = "quantity"
+)
+
+const (
+	newObjectVariable = "newObject"
+	oldObjectVariable = "oldObject"
+)
+
+// conditionResult is the outcome of a condition, the conditions whose key didn't change are neither passed nor failed
+type conditionResult int
+
+const (
+	conditionUnchanged conditionResult = iota
+	conditionPassed
+	conditionFailed
+)
+
+// ValidatePolicy returns the errors found in the conditions, the expression, the throttling settings and the trigger
+// mode of the given policy spec
+func ValidatePolicy(spec litmuschaosv1.EventTrackerPolicySpec) []string {
+	if len(spec.Conditions) == 0 && spec.Expression == "" {
+		return []string{"spec: either conditions or an expression is required"}
+	}
+
+	errs := validateGroup("spec", spec.ConditionType, spec.Conditions)
+	errs = append(errs, validateThrottling(spec)...)
+	errs = append(errs, validateTriggerMode(spec)...)
+	if spec.Expression != "" {
+		if _, err := compileExpression(spec.Expression); err != nil {
+			errs = append(errs, "spec.expression: "+err.Error())
+		}
+	}
+
+	return errs
+}
+
+func validateGroup(path string, conditionType string, conditions []litmuschaosv1.Condition) []string {
+	var errs []string
+	if conditionType != "" && conditionType != ConditionTypeAnd && conditionType != ConditionTypeOr {
+		errs = append(errs, fmt.Sprintf("%s.condition_type: unsupported condition type %s", path, conditionType))
+	}
+
+	for i, condition := range conditions {
+		conditionPath := fmt.Sprintf("%s.conditions[%d]", path, i)
+		if len(condition.Conditions) > 0 {
+			errs = append(errs, validateGroup(conditionPath, condition.ConditionType, condition.Conditions)...)
+			continue
+		}
+
+		if err := validateCondition(condition); err != nil {
+			errs = append(errs, conditionPath+": "+err.Error())
+		}
+	}
+
+	return errs
+}
+
+func validateCondition(condition litmuschaosv1.Condition) error {
+	if condition.Key == "" {
+		return errors.New("key is required")
+	}
+	if _, err := jmespath.Compile(condition.Key); err != nil {
+		return fmt.Errorf("invalid key %s: %v", condition.Key, err)
+	}
+
+	switch condition.Operator {
+	case OperatorChange:
+		return nil
+	case OperatorMatches:
+		if condition.Value == nil {
+			return errors.New("value is required")
+		}
+		_, err := regexp.Compile(*condition.Value)
+		return err
+	case OperatorIn, OperatorNotIn:
+		if len(condition.Values) == 0 {
+			return errors.New("values are required")
+		}
+		for _, value := range condition.Values {
+			if err := parseValue(condition.ValueType, value); err != nil {
+				return err
+			}
+		}
+		return nil
+	case OperatorEqualTo, OperatorNotEqualTo, OperatorLessThan, OperatorGreaterThan,
+		OperatorLessThanEqualTo, OperatorGreaterThanEqualTo:
+		if condition.Value == nil {
+			return errors.New("value is required")
+		}
+		return parseValue(condition.ValueType, *condition.Value)
+	default:
+		return fmt.Errorf("unsupported operator %s", condition.Operator)
+	}
+}
+
+// parseValue returns an error if the given value of the condition can't be compared as the given value type
+func parseValue(valueType string, value string) error {
+	var err error
+	switch valueType {
+	case "", ValueTypeString:
+	case ValueTypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case ValueTypeSemver:
+		_, err = semver.ParseTolerant(value)
+	case ValueTypeDuration:
+		_, err = time.ParseDuration(value)
+	case ValueTypeQuantity:
+		_, err = resource.ParseQuantity(value)
+	default:
+		return fmt.Errorf("unsupported value type %s", valueType)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid %s value %s: %v", valueType, value, err)
+	}
+
+	return nil
+}
+
+// conditionChecker returns true if the update of the resource passes the conditions and the expression of the policy
+func conditionChecker(etp litmuschaosv1.EventTrackerPolicy, newData map[string]interface{}, oldData map[string]interface{}) bool {
+	if len(etp.Spec.Conditions) > 0 &&
+		evaluateGroup(etp.Spec.ConditionType, etp.Spec.Conditions, newData, oldData) != conditionPassed {
+		return false
+	}
+
+	if etp.Spec.Expression != "" {
+		result, err := evaluateExpression(etp.Spec.Expression, newData, oldData)
+		if err != nil {
+			logrus.Error(err)
+			return false
+		}
+		return result
+	}
+
+	return len(etp.Spec.Conditions) > 0
+}
+
+// evaluateGroup composes the results of the conditions, an and group fails as soon as one of its conditions fails
+// and passes if another one passed, an or group passes as soon as one of its conditions passes
+func evaluateGroup(conditionType string, conditions []litmuschaosv1.Condition, newData interface{}, oldData interface{}) conditionResult {
+	var anyPassed, anyFailed bool
+	for _, condition := range conditions {
+		switch evaluateCondition(condition, newData, oldData) {
+		case conditionPassed:
+			if conditionType == ConditionTypeOr {
+				return conditionPassed
+			}
+			anyPassed = true
+		case conditionFailed:
+			if conditionType != ConditionTypeOr {
+				return conditionFailed
+			}
+			anyFailed = true
+		}
+	}
+
+	switch {
+	case anyPassed:
+		return conditionPassed
+	case anyFailed:
+		return conditionFailed
+	default:
+		return conditionUnchanged
+	}
+}
+
+func evaluateCondition(condition litmuschaosv1.Condition, newData interface{}, oldData interface{}) conditionResult {
+	if len(condition.Conditions) > 0 {
+		return evaluateGroup(condition.ConditionType, condition.Conditions, newData, oldData)
+	}
+
+	newDataResult, err := jmespath.Search(condition.Key, newData)
+	if err != nil {
+		logrus.Error(err)
+		return conditionFailed
+	}
+
+	oldDataResult, err := jmespath.Search(condition.Key, oldData)
+	if err != nil {
+		logrus.Error(err)
+		return conditionFailed
+	}
+
+	if reflect.DeepEqual(newDataResult, oldDataResult) {
+		return conditionUnchanged
+	}
+
+	if condition.Operator == OperatorChange {
+		return conditionPassed
+	}
+
+	result, err := compareCondition(condition, newDataResult)
+	if err != nil {
+		logrus.Errorf("failed to evaluate the condition on %s: %v", condition.Key, err)
+		return conditionFailed
+	}
+	if result {
+		return conditionPassed
+	}
+
+	return conditionFailed
+}
+
+func compareCondition(condition litmuschaosv1.Condition, actual interface{}) (bool, error) {
+	switch condition.Operator {
+	case OperatorIn, OperatorNotIn:
+		found := false
+		for _, value := range condition.Values {
+			cmp, err := compareValues(condition.ValueType, actual, value)
+			if err != nil {
+				return false, err
+			}
+			if cmp == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (condition.Operator == OperatorIn), nil
+	}
+
+	if condition.Value == nil {
+		return false, errors.New("value is required")
+	}
+
+	if condition.Operator == OperatorMatches {
+		return regexp.MatchString(*condition.Value, fmt.Sprintf("%v", actual))
+	}
+
+	cmp, err := compareValues(condition.ValueType, actual, *condition.Value)
+	if err != nil {
+		return false, err
+	}
+
+	switch condition.Operator {
+	case OperatorEqualTo:
+		return cmp == 0, nil
+	case OperatorNotEqualTo:
+		return cmp != 0, nil
+	case OperatorLessThan:
+		return cmp < 0, nil
+	case OperatorGreaterThan:
+		return cmp > 0, nil
+	case OperatorLessThanEqualTo:
+		return cmp <= 0, nil
+	case OperatorGreaterThanEqualTo:
+		return cmp >= 0, nil
+	default:
+		return false, fmt.Errorf("unsupported operator %s", condition.Operator)
+	}
+}
+
+// compareValues compares the value found in the resource with the value of the condition as the given value type,
+// it returns -1, 0 or 1 if the actual value is respectively lower than, equal to or greater than the expected one
+func compareValues(valueType string, actual interface{}, expected string) (int, error) {
+	str := fmt.Sprintf("%v", actual)
+	switch valueType {
+	case "":
+		if isNumber(actual) {
+			if expectedNumber, err := strconv.ParseFloat(expected, 64); err == nil {
+				actualNumber, err := strconv.ParseFloat(str, 64)
+				if err != nil {
+					return 0, err
+				}
+				return compareNumbers(actualNumber, expectedNumber), nil
+			}
+		}
+		return strings.Compare(str, expected), nil
+	case ValueTypeString:
+		return strings.Compare(str, expected), nil
+	case ValueTypeNumber:
+		actualNumber, err := strconv.ParseFloat(str, 64)
+		if err != nil {
+			return 0, err
+		}
+		expectedNumber, err := strconv.ParseFloat(expected, 64)
+		if err != nil {
+			return 0, err
+		}
+		return compareNumbers(actualNumber, expectedNumber), nil
+	case ValueTypeSemver:
+		actualVersion, err := semver.ParseTolerant(str)
+		if err != nil {
+			return 0, err
+		}
+		expectedVersion, err := semver.ParseTolerant(expected)
+		if err != nil {
+			return 0, err
+		}
+		return actualVersion.Compare(expectedVersion), nil
+	case ValueTypeDuration:
+		actualDuration, err := time.ParseDuration(str)
+		if err != nil {
+			return 0, err
+		}
+		expectedDuration, err := time.ParseDuration(expected)
+		if err != nil {
+			return 0, err
+		}
+		return compareNumbers(float64(actualDuration), float64(expectedDuration)), nil
+	case ValueTypeQuantity:
+		actualQuantity, err := resource.ParseQuantity(str)
+		if err != nil {
+			return 0, err
+		}
+		expectedQuantity, err := resource.ParseQuantity(expected)
+		if err != nil {
+			return 0, err
+		}
+		return actualQuantity.Cmp(expectedQuantity), nil
+	default:
+		return 0, fmt.Errorf("unsupported value type %s", valueType)
+	}
+}
+
+func isNumber(value interface{}) bool {
+	switch value.(type) {
+	case int, int32, int64, float32, float64:
+		return true
+	}
+
+	return false
+}
+
+func compareNumbers(a float64, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func compileExpression(expression string) (cel.Program, error) {
+	env, err := cel.NewEnv(
+		cel.Variable(newObjectVariable, cel.DynType),
+		cel.Variable(oldObjectVariable, cel.DynType),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("expression must evaluate to a bool, got %v", ast.OutputType())
+	}
+
+	return env.Program(ast)
+}
+
+func evaluateExpression(expression string, newData map[string]interface{}, oldData map[string]interface{}) (bool, error) {
+	program, err := compileExpression(expression)
+	if err != nil {
+		return false, err
+	}
+
+	out, _, err := program.Eval(map[string]interface{}{
+		newObjectVariable: newData,
+		oldObjectVariable: oldData,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression evaluated to %v instead of a bool", out.Value())
+	}
+
+	return result, nil
+}
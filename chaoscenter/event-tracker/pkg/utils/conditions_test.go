@@ -0,0 +1,438 @@
+package utils
+
+import (
+	"testing"
+
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+func stringPointer(value string) *string {
+	return &value
+}
+
+// TestCompareValues is used to test the comparison of the values of the resources as the value types of the conditions
+func TestCompareValues(t *testing.T) {
+	tests := []struct {
+		name          string
+		valueType     string
+		actual        interface{}
+		expected      string
+		result        int
+		expectedError bool
+	}{
+		{
+			name:      "numbers aren't compared lexicographically",
+			valueType: ValueTypeNumber,
+			actual:    "10",
+			expected:  "9",
+			result:    1,
+		},
+		{
+			name:      "decimal numbers",
+			valueType: ValueTypeNumber,
+			actual:    float64(2.5),
+			expected:  "2.50",
+			result:    0,
+		},
+		{
+			name:     "untyped number of the resource",
+			actual:   float64(10),
+			expected: "9",
+			result:   1,
+		},
+		{
+			name:     "untyped string of the resource",
+			actual:   "10",
+			expected: "9",
+			result:   -1,
+		},
+		{
+			name:      "strings",
+			valueType: ValueTypeString,
+			actual:    "nginx:1.21",
+			expected:  "nginx:1.21",
+			result:    0,
+		},
+		{
+			name:      "semantic versions",
+			valueType: ValueTypeSemver,
+			actual:    "1.10.0",
+			expected:  "1.9.2",
+			result:    1,
+		},
+		{
+			name:      "semantic versions with a v prefix",
+			valueType: ValueTypeSemver,
+			actual:    "v2.0",
+			expected:  "2.0.0",
+			result:    0,
+		},
+		{
+			name:      "durations",
+			valueType: ValueTypeDuration,
+			actual:    "90s",
+			expected:  "2m",
+			result:    -1,
+		},
+		{
+			name:      "quantities",
+			valueType: ValueTypeQuantity,
+			actual:    "1Gi",
+			expected:  "1000Mi",
+			result:    1,
+		},
+		{
+			name:      "quantities in millis",
+			valueType: ValueTypeQuantity,
+			actual:    "500m",
+			expected:  "0.5",
+			result:    0,
+		},
+		{
+			name:          "invalid number",
+			valueType:     ValueTypeNumber,
+			actual:        "ten",
+			expected:      "9",
+			expectedError: true,
+		},
+		{
+			name:          "invalid semantic version",
+			valueType:     ValueTypeSemver,
+			actual:        "latest",
+			expected:      "1.0.0",
+			expectedError: true,
+		},
+		{
+			name:          "invalid duration",
+			valueType:     ValueTypeDuration,
+			actual:        "90",
+			expected:      "2m",
+			expectedError: true,
+		},
+		{
+			name:          "invalid quantity",
+			valueType:     ValueTypeQuantity,
+			actual:        "one",
+			expected:      "1",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported value type",
+			valueType:     "date",
+			actual:        "2021-01-01",
+			expected:      "2021-01-01",
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			result, err := compareValues(tc.valueType, tc.actual, tc.expected)
+
+			// then
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.result, result)
+		})
+	}
+}
+
+// TestCompareCondition is used to test the operators of the conditions against the value found in the resource
+func TestCompareCondition(t *testing.T) {
+	tests := []struct {
+		name          string
+		condition     litmuschaosv1.Condition
+		actual        interface{}
+		expected      bool
+		expectedError bool
+	}{
+		{
+			name:      "greater than as numbers",
+			condition: litmuschaosv1.Condition{Operator: OperatorGreaterThan, Value: stringPointer("9"), ValueType: ValueTypeNumber},
+			actual:    "10",
+			expected:  true,
+		},
+		{
+			name:      "less than or equal to as durations",
+			condition: litmuschaosv1.Condition{Operator: OperatorLessThanEqualTo, Value: stringPointer("1m"), ValueType: ValueTypeDuration},
+			actual:    "60s",
+			expected:  true,
+		},
+		{
+			name:      "not equal to",
+			condition: litmuschaosv1.Condition{Operator: OperatorNotEqualTo, Value: stringPointer("3")},
+			actual:    float64(3),
+			expected:  false,
+		},
+		{
+			name:      "in",
+			condition: litmuschaosv1.Condition{Operator: OperatorIn, Values: []string{"1", "2", "3"}, ValueType: ValueTypeNumber},
+			actual:    float64(2),
+			expected:  true,
+		},
+		{
+			name:      "not in",
+			condition: litmuschaosv1.Condition{Operator: OperatorNotIn, Values: []string{"1.0.0", "1.1.0"}, ValueType: ValueTypeSemver},
+			actual:    "v1.1.0",
+			expected:  false,
+		},
+		{
+			name:      "matches",
+			condition: litmuschaosv1.Condition{Operator: OperatorMatches, Value: stringPointer(`^nginx:1\.2[0-9]$`)},
+			actual:    "nginx:1.21",
+			expected:  true,
+		},
+		{
+			name:      "doesn't match",
+			condition: litmuschaosv1.Condition{Operator: OperatorMatches, Value: stringPointer(`^nginx:1\.2[0-9]$`)},
+			actual:    "nginx:1.19",
+			expected:  false,
+		},
+		{
+			name:          "value missing",
+			condition:     litmuschaosv1.Condition{Operator: OperatorEqualTo},
+			actual:        "value",
+			expectedError: true,
+		},
+		{
+			name:          "value of the resource of another type",
+			condition:     litmuschaosv1.Condition{Operator: OperatorIn, Values: []string{"1"}, ValueType: ValueTypeNumber},
+			actual:        "one",
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			result, err := compareCondition(tc.condition, tc.actual)
+
+			// then
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+// TestEvaluateGroup is used to test the composition of the results of nested and/or condition groups
+func TestEvaluateGroup(t *testing.T) {
+	oldData := map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": float64(2), "image": "nginx:1.20", "paused": false},
+	}
+	newData := map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": float64(10), "image": "nginx:1.21", "paused": false},
+	}
+	replicasAbove9 := litmuschaosv1.Condition{Key: "spec.replicas", Operator: OperatorGreaterThan, Value: stringPointer("9"), ValueType: ValueTypeNumber}
+	replicasBelow5 := litmuschaosv1.Condition{Key: "spec.replicas", Operator: OperatorLessThan, Value: stringPointer("5"), ValueType: ValueTypeNumber}
+	imageChanged := litmuschaosv1.Condition{Key: "spec.image", Operator: OperatorChange}
+	pausedChanged := litmuschaosv1.Condition{Key: "spec.paused", Operator: OperatorChange}
+
+	tests := []struct {
+		name          string
+		conditionType string
+		conditions    []litmuschaosv1.Condition
+		expected      conditionResult
+	}{
+		{
+			name:       "and group passes when every condition passes",
+			conditions: []litmuschaosv1.Condition{replicasAbove9, imageChanged},
+			expected:   conditionPassed,
+		},
+		{
+			name:          "and group fails when a condition fails",
+			conditionType: ConditionTypeAnd,
+			conditions:    []litmuschaosv1.Condition{imageChanged, replicasBelow5},
+			expected:      conditionFailed,
+		},
+		{
+			name:          "and group ignores the unchanged keys",
+			conditionType: ConditionTypeAnd,
+			conditions:    []litmuschaosv1.Condition{pausedChanged, imageChanged},
+			expected:      conditionPassed,
+		},
+		{
+			name:          "or group passes when a condition passes",
+			conditionType: ConditionTypeOr,
+			conditions:    []litmuschaosv1.Condition{replicasBelow5, imageChanged},
+			expected:      conditionPassed,
+		},
+		{
+			name:          "or group fails when every changed condition fails",
+			conditionType: ConditionTypeOr,
+			conditions:    []litmuschaosv1.Condition{replicasBelow5, pausedChanged},
+			expected:      conditionFailed,
+		},
+		{
+			name:       "group of unchanged keys",
+			conditions: []litmuschaosv1.Condition{pausedChanged},
+			expected:   conditionUnchanged,
+		},
+		{
+			name:          "and group of nested or groups",
+			conditionType: ConditionTypeAnd,
+			conditions: []litmuschaosv1.Condition{
+				{ConditionType: ConditionTypeOr, Conditions: []litmuschaosv1.Condition{replicasBelow5, replicasAbove9}},
+				{ConditionType: ConditionTypeOr, Conditions: []litmuschaosv1.Condition{pausedChanged, imageChanged}},
+			},
+			expected: conditionPassed,
+		},
+		{
+			name:          "or group of nested and groups",
+			conditionType: ConditionTypeOr,
+			conditions: []litmuschaosv1.Condition{
+				{ConditionType: ConditionTypeAnd, Conditions: []litmuschaosv1.Condition{imageChanged, replicasBelow5}},
+				{ConditionType: ConditionTypeAnd, Conditions: []litmuschaosv1.Condition{replicasAbove9, replicasBelow5}},
+			},
+			expected: conditionFailed,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			result := evaluateGroup(tc.conditionType, tc.conditions, newData, oldData)
+
+			// then
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+// TestConditionChecker is used to test the evaluation of the conditions and the CEL expression of a policy
+func TestConditionChecker(t *testing.T) {
+	oldData := map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(2)}}
+	newData := map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(10)}}
+	replicasChanged := litmuschaosv1.Condition{Key: "spec.replicas", Operator: OperatorChange}
+
+	tests := []struct {
+		name     string
+		spec     litmuschaosv1.EventTrackerPolicySpec
+		expected bool
+	}{
+		{
+			name:     "conditions only",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{Conditions: []litmuschaosv1.Condition{replicasChanged}},
+			expected: true,
+		},
+		{
+			name:     "expression only",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{Expression: "newObject.spec.replicas > oldObject.spec.replicas * 2.0"},
+			expected: true,
+		},
+		{
+			name: "conditions passing and expression failing",
+			spec: litmuschaosv1.EventTrackerPolicySpec{
+				Conditions: []litmuschaosv1.Condition{replicasChanged},
+				Expression: "newObject.spec.replicas < oldObject.spec.replicas",
+			},
+			expected: false,
+		},
+		{
+			name:     "invalid expression",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{Expression: "newObject.spec.replicas >"},
+			expected: false,
+		},
+		{
+			name:     "expression of a missing field",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{Expression: "newObject.status.ready"},
+			expected: false,
+		},
+		{
+			name:     "neither conditions nor expression",
+			expected: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			etp := litmuschaosv1.EventTrackerPolicy{Spec: tc.spec}
+
+			// when
+			result := conditionChecker(etp, newData, oldData)
+
+			// then
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+// TestValidatePolicy is used to test the validation of the conditions and the expression of a policy
+func TestValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           litmuschaosv1.EventTrackerPolicySpec
+		expectedErrors []string
+	}{
+		{
+			name: "valid conditions and expression",
+			spec: litmuschaosv1.EventTrackerPolicySpec{
+				ConditionType: ConditionTypeOr,
+				Conditions: []litmuschaosv1.Condition{
+					{Key: "spec.replicas", Operator: OperatorGreaterThan, Value: stringPointer("9"), ValueType: ValueTypeNumber},
+					{Key: "spec.template.spec.containers[0].image", Operator: OperatorMatches, Value: stringPointer("^nginx:")},
+					{ConditionType: ConditionTypeAnd, Conditions: []litmuschaosv1.Condition{
+						{Key: "metadata.labels.version", Operator: OperatorIn, Values: []string{"1.0.0", "v1.1"}, ValueType: ValueTypeSemver},
+					}},
+				},
+				Expression: "newObject.spec.replicas != oldObject.spec.replicas",
+			},
+		},
+		{
+			name:           "neither conditions nor expression",
+			expectedErrors: []string{"spec: either conditions or an expression is required"},
+		},
+		{
+			name: "invalid conditions",
+			spec: litmuschaosv1.EventTrackerPolicySpec{
+				ConditionType: "xor",
+				Conditions: []litmuschaosv1.Condition{
+					{Operator: OperatorChange},
+					{Key: "spec.replicas", Operator: "Between", Value: stringPointer("9")},
+					{Key: "spec.replicas", Operator: OperatorGreaterThan, Value: stringPointer("ten"), ValueType: ValueTypeNumber},
+					{ConditionType: ConditionTypeAnd, Conditions: []litmuschaosv1.Condition{
+						{Key: "spec.image", Operator: OperatorMatches, Value: stringPointer("(")},
+						{Key: "spec.replicas", Operator: OperatorIn, ValueType: ValueTypeNumber},
+					}},
+				},
+			},
+			expectedErrors: []string{
+				"spec.condition_type: unsupported condition type xor",
+				"spec.conditions[0]: key is required",
+				"spec.conditions[1]: unsupported operator Between",
+				"spec.conditions[2]: invalid number value ten",
+				"spec.conditions[3].conditions[0]:",
+				"spec.conditions[3].conditions[1]: values are required",
+			},
+		},
+		{
+			name:           "invalid expression",
+			spec:           litmuschaosv1.EventTrackerPolicySpec{Expression: "newObject.spec.replicas >"},
+			expectedErrors: []string{"spec.expression:"},
+		},
+		{
+			name:           "expression not evaluating to a bool",
+			spec:           litmuschaosv1.EventTrackerPolicySpec{Expression: "'replicas'"},
+			expectedErrors: []string{"spec.expression: expression must evaluate to a bool"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			errs := ValidatePolicy(tc.spec)
+
+			// then
+			assert.Len(t, errs, len(tc.expectedErrors))
+			for i := range errs {
+				if i < len(tc.expectedErrors) {
+					assert.Contains(t, errs[i], tc.expectedErrors[i])
+				}
+			}
+		})
+	}
+}
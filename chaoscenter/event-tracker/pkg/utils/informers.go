@@ -3,127 +3,156 @@ package utils
 import (
 	"fmt"
 	"reflect"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
-	v1 "k8s.io/api/apps/v1"
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/runtime"
-	"k8s.io/client-go/informers"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/tools/cache"
 )
 
-// RunDeploymentInformer K8s informer watching for all the deployment changes
-func RunDeploymentInformer(factory informers.SharedInformerFactory) {
-	deploymentInformer := factory.Apps().V1().Deployments().Informer()
+const (
+	GitOpsAnnotation   = "litmuschaos.io/gitops"
+	WorkflowAnnotation = "litmuschaos.io/workflow"
 
-	stopper := make(chan struct{})
-	defer close(stopper)
+	resyncPeriod = 30 * time.Second
+)
 
-	defer runtime.HandleCrash()
+// DefaultTrackedResources are the resources audited by the policies which don't list any resource
+var DefaultTrackedResources = []schema.GroupVersionResource{
+	{Group: "apps", Version: "v1", Resource: "deployments"},
+	{Group: "apps", Version: "v1", Resource: "statefulsets"},
+	{Group: "apps", Version: "v1", Resource: "daemonsets"},
+}
 
-	deploymentInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		// When a resource gets updated
-		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
-			depNewObj := newObj.(*v1.Deployment)
-			depOldObj := oldObj.(*v1.Deployment)
-
-			var worflowid = depNewObj.GetAnnotations()["litmuschaos.io/workflow"]
-
-			if depNewObj.GetResourceVersion() != depOldObj.GetResourceVersion() &&
-				!reflect.DeepEqual(depNewObj, depOldObj) &&
-				depNewObj.GetAnnotations()["litmuschaos.io/gitops"] == "true" &&
-				worflowid != "" {
-				logrus.Infof("GitOps Notification for workflowID: %s, ResourceType: %s, ResourceName: %s, ResourceNamespace: %s", worflowid, "Deployment", depNewObj.Name, depNewObj.Namespace)
-				err := PolicyAuditor("Deployment", depNewObj, depOldObj, worflowid)
-				if err != nil {
-					logrus.Error(err)
-					return
-				}
-			}
-		},
-	})
-
-	deploymentInformer.Run(stopper)
-	if !cache.WaitForCacheSync(stopper, deploymentInformer.HasSynced) {
-		runtime.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
-		return
+// PolicyResources returns the resources audited by the given event-tracker policy
+func PolicyResources(etp litmuschaosv1.EventTrackerPolicy) []schema.GroupVersionResource {
+	if len(etp.Spec.Resources) == 0 {
+		return DefaultTrackedResources
+	}
+
+	var resources []schema.GroupVersionResource
+	for _, resource := range etp.Spec.Resources {
+		resources = append(resources, schema.GroupVersionResource{
+			Group:    resource.Group,
+			Version:  resource.Version,
+			Resource: resource.Resource,
+		})
 	}
-}
 
-// RunStsInformer K8s informer watching for all the Statefullset changes
-func RunStsInformer(factory informers.SharedInformerFactory) {
-	stsInformer := factory.Apps().V1().StatefulSets().Informer()
+	return resources
+}
 
-	stopper := make(chan struct{})
-	defer close(stopper)
+// ResourceMapper resolves the resources from the discovery of the cluster, Reset refreshes the cached discovery
+type ResourceMapper interface {
+	meta.RESTMapper
+	Reset()
+}
 
-	defer runtime.HandleCrash()
+// ResourceTracker watches the resources listed in the event-tracker policies through a dynamic shared informer factory
+type ResourceTracker struct {
+	factory dynamicinformer.DynamicSharedInformerFactory
+	mapper  ResourceMapper
+	stopCh  <-chan struct{}
+	mutex   sync.Mutex
+	tracked map[schema.GroupVersionResource]bool
+	// audit evaluates the policies auditing the updated resource
+	audit func(gvr schema.GroupVersionResource, newObj *unstructured.Unstructured, oldObj *unstructured.Unstructured, workflowID string) error
+}
 
-	stsInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		// When a resource gets updated
-		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
-			stsNewObj := newObj.(*v1.StatefulSet)
-			stsOldObj := oldObj.(*v1.StatefulSet)
-
-			var worflowid = stsNewObj.GetAnnotations()["litmuschaos.io/workflow"]
-
-			if stsNewObj.GetResourceVersion() != stsOldObj.GetResourceVersion() &&
-				!reflect.DeepEqual(stsNewObj, stsOldObj) &&
-				stsNewObj.GetAnnotations()["litmuschaos.io/gitops"] == "true" &&
-				worflowid != "" {
-				logrus.Infof("GitOps Notification for workflowID: %s, ResourceType: %s, ResourceName: %s, ResourceNamespace: %s", worflowid, "Deployment", stsNewObj.Name, stsNewObj.Namespace)
-				err := PolicyAuditor("Deployment", stsNewObj, stsOldObj, worflowid)
-				if err != nil {
-					logrus.Error(err)
-					return
-				}
-			}
-
-		},
-	})
-
-	stsInformer.Run(stopper)
-	if !cache.WaitForCacheSync(stopper, stsInformer.HasSynced) {
-		runtime.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
-		return
+// NewResourceTracker returns a ResourceTracker watching the resources of the given namespace, all the namespaces
+// are watched when the namespace is empty. The resources are resolved with the mapper before being watched
+func NewResourceTracker(client dynamic.Interface, mapper ResourceMapper, namespace string, stopCh <-chan struct{}) *ResourceTracker {
+	return &ResourceTracker{
+		factory: dynamicinformer.NewFilteredDynamicSharedInformerFactory(client, resyncPeriod, namespace, nil),
+		mapper:  mapper,
+		stopCh:  stopCh,
+		tracked: make(map[schema.GroupVersionResource]bool),
+		audit:   PolicyAuditor,
 	}
 }
 
-// RunDSInformer K8s informer watching for all the daemonset changes
-func RunDSInformer(factory informers.SharedInformerFactory) {
-	dsInformer := factory.Apps().V1().DaemonSets().Informer()
+// Track starts the informers of the given resources, the resources which are already watched are skipped. The
+// resources which aren't served by the cluster are not watched and returned as errors
+func (t *ResourceTracker) Track(resources ...schema.GroupVersionResource) []string {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	var errs []string
+	started := false
+	for _, gvr := range resources {
+		if t.tracked[gvr] {
+			continue
+		}
+		if err := t.resolve(gvr); err != nil {
+			errs = append(errs, fmt.Sprintf("spec.resources: %s can't be tracked: %v", gvr.String(), err))
+			continue
+		}
+
+		informer := t.factory.ForResource(gvr).Informer()
+		informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			// When a resource gets updated
+			UpdateFunc: func(oldObj interface{}, newObj interface{}) {
+				t.onUpdate(gvr, oldObj, newObj)
+			},
+		})
+
+		t.tracked[gvr] = true
+		started = true
+		logrus.Infof("Tracking resource: %s", gvr.String())
+	}
+
+	// only the informers which are not running yet get started
+	if started {
+		t.factory.Start(t.stopCh)
+	}
 
-	stopper := make(chan struct{})
-	defer close(stopper)
+	return errs
+}
+
+// resolve checks that the resource is served by the cluster, the discovery is refreshed once as the resource may be
+// defined by a CRD installed after the discovery was cached
+func (t *ResourceTracker) resolve(gvr schema.GroupVersionResource) error {
+	if gvr.Version == "" || gvr.Resource == "" {
+		return fmt.Errorf("the version and the resource are required")
+	}
+	_, err := t.mapper.KindFor(gvr)
+	if meta.IsNoMatchError(err) {
+		t.mapper.Reset()
+		_, err = t.mapper.KindFor(gvr)
+	}
+	return err
+}
 
+func (t *ResourceTracker) onUpdate(gvr schema.GroupVersionResource, oldObj interface{}, newObj interface{}) {
 	defer runtime.HandleCrash()
 
-	dsInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		// When a resource gets updated
-		UpdateFunc: func(oldObj interface{}, newObj interface{}) {
-			dsNewObj := newObj.(*v1.DaemonSet)
-			dsOldObj := newObj.(*v1.DaemonSet)
-
-			var worflowid = dsNewObj.GetAnnotations()["litmuschaos.io/workflow"]
-
-			if dsNewObj.GetResourceVersion() != dsOldObj.GetResourceVersion() &&
-				!reflect.DeepEqual(dsNewObj, dsOldObj) &&
-				dsNewObj.GetAnnotations()["litmuschaos.io/gitops"] == "true" &&
-				worflowid != "" {
-				logrus.Infof("GitOps Notification for workflowID: %s, ResourceType: %s, ResourceName: %s, ResourceNamespace: %s", worflowid, "Deployment", dsNewObj.Name, dsNewObj.Namespace)
-				err := PolicyAuditor("Deployment", dsNewObj, dsOldObj, worflowid)
-				if err != nil {
-					logrus.Error(err)
-					return
-				}
-			}
-
-		},
-	})
-
-	dsInformer.Run(stopper)
-	if !cache.WaitForCacheSync(stopper, dsInformer.HasSynced) {
-		runtime.HandleError(fmt.Errorf("Timed out waiting for caches to sync"))
+	newUnstructured, ok := newObj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	oldUnstructured, ok := oldObj.(*unstructured.Unstructured)
+	if !ok {
 		return
 	}
+
+	workflowID := newUnstructured.GetAnnotations()[WorkflowAnnotation]
+
+	if newUnstructured.GetResourceVersion() != oldUnstructured.GetResourceVersion() &&
+		!reflect.DeepEqual(newUnstructured, oldUnstructured) &&
+		newUnstructured.GetAnnotations()[GitOpsAnnotation] == "true" &&
+		workflowID != "" {
+		logrus.Infof("GitOps Notification for workflowID: %s, ResourceType: %s, ResourceName: %s, ResourceNamespace: %s", workflowID, newUnstructured.GetKind(), newUnstructured.GetName(), newUnstructured.GetNamespace())
+		err := t.audit(gvr, newUnstructured, oldUnstructured, workflowID)
+		if err != nil {
+			logrus.Error(err)
+		}
+	}
 }
@@ -0,0 +1,259 @@
+package utils
+
+import (
+	"testing"
+
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+var (
+	deploymentsResource = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	rolloutsResource    = schema.GroupVersionResource{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}
+)
+
+// resourceMapper serves the deployments, and the rollouts once their CRD is installed and the discovery is reset
+type resourceMapper struct {
+	*meta.DefaultRESTMapper
+	rolloutsInstalled bool
+	resets            int
+}
+
+// newResourceMapper returns a mapper whose discovery was cached before the rollouts were installed
+func newResourceMapper(rolloutsInstalled bool) *resourceMapper {
+	m := &resourceMapper{}
+	m.Reset()
+	m.resets = 0
+	m.rolloutsInstalled = rolloutsInstalled
+	return m
+}
+
+func (m *resourceMapper) Reset() {
+	m.resets++
+	m.DefaultRESTMapper = meta.NewDefaultRESTMapper(nil)
+	m.Add(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"}, meta.RESTScopeNamespace)
+	if m.rolloutsInstalled {
+		m.Add(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Rollout"}, meta.RESTScopeNamespace)
+	}
+}
+
+// TestPolicyResources is used to test the resources audited by the policies
+func TestPolicyResources(t *testing.T) {
+	tests := []struct {
+		name              string
+		resources         []litmuschaosv1.TrackedResource
+		expectedResources []schema.GroupVersionResource
+	}{
+		{
+			name:              "policy without resources",
+			expectedResources: DefaultTrackedResources,
+		},
+		{
+			name: "policy listing resources",
+			resources: []litmuschaosv1.TrackedResource{
+				{Version: "v1", Resource: "configmaps"},
+				{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"},
+			},
+			expectedResources: []schema.GroupVersionResource{
+				{Version: "v1", Resource: "configmaps"},
+				rolloutsResource,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			etp := litmuschaosv1.EventTrackerPolicy{Spec: litmuschaosv1.EventTrackerPolicySpec{Resources: tc.resources}}
+
+			// when
+			resources := PolicyResources(etp)
+
+			// then
+			assert.Equal(t, tc.expectedResources, resources)
+		})
+	}
+}
+
+// TestTracksResource is used to test the matching of the updated resources with the resources of the policies
+func TestTracksResource(t *testing.T) {
+	tests := []struct {
+		name      string
+		resources []litmuschaosv1.TrackedResource
+		gvr       schema.GroupVersionResource
+		expected  bool
+	}{
+		{
+			name:     "default resource of a policy without resources",
+			gvr:      deploymentsResource,
+			expected: true,
+		},
+		{
+			name:      "resource listed in the policy",
+			resources: []litmuschaosv1.TrackedResource{{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}},
+			gvr:       rolloutsResource,
+			expected:  true,
+		},
+		{
+			name:      "default resource not listed in the policy",
+			resources: []litmuschaosv1.TrackedResource{{Group: "argoproj.io", Version: "v1alpha1", Resource: "rollouts"}},
+			gvr:       deploymentsResource,
+		},
+		{
+			name:      "resource of another version",
+			resources: []litmuschaosv1.TrackedResource{{Group: "argoproj.io", Version: "v1", Resource: "rollouts"}},
+			gvr:       rolloutsResource,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			etp := litmuschaosv1.EventTrackerPolicy{Spec: litmuschaosv1.EventTrackerPolicySpec{Resources: tc.resources}}
+
+			// when
+			tracked := tracksResource(etp, tc.gvr)
+
+			// then
+			assert.Equal(t, tc.expected, tracked)
+		})
+	}
+}
+
+// TestResourceTrackerTrack is used to test that only the resources served by the cluster are tracked, the others
+// being reported
+func TestResourceTrackerTrack(t *testing.T) {
+	tests := []struct {
+		name              string
+		rolloutsInstalled bool
+		resource          schema.GroupVersionResource
+		expectedTracked   bool
+		expectedResets    int
+	}{
+		{
+			name:            "resource served by the cluster",
+			resource:        deploymentsResource,
+			expectedTracked: true,
+		},
+		{
+			name:              "resource of a CRD installed after the discovery was cached",
+			rolloutsInstalled: true,
+			resource:          rolloutsResource,
+			expectedTracked:   true,
+			expectedResets:    1,
+		},
+		{
+			name:           "resource not served by the cluster",
+			resource:       rolloutsResource,
+			expectedResets: 1,
+		},
+		{
+			name:     "resource without version",
+			resource: schema.GroupVersionResource{Group: "apps", Resource: "deployments"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			mapper := newResourceMapper(tc.rolloutsInstalled)
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+				deploymentsResource: "DeploymentList",
+				rolloutsResource:    "RolloutList",
+			})
+			stopCh := make(chan struct{})
+			defer close(stopCh)
+			tracker := NewResourceTracker(client, mapper, "", stopCh)
+
+			// when
+			errs := tracker.Track(tc.resource)
+
+			// then
+			assert.Equal(t, tc.expectedTracked, tracker.tracked[tc.resource])
+			if tc.expectedTracked {
+				assert.Empty(t, errs)
+			} else {
+				assert.Len(t, errs, 1)
+			}
+			assert.Equal(t, tc.expectedResets, mapper.resets)
+		})
+	}
+}
+
+// TestResourceTrackerOnUpdate is used to test that only the updates of the resources annotated for gitops are audited
+func TestResourceTrackerOnUpdate(t *testing.T) {
+	tests := []struct {
+		name            string
+		annotations     map[string]interface{}
+		resourceVersion string
+		expectedAudit   bool
+	}{
+		{
+			name: "updated resource annotated for gitops",
+			annotations: map[string]interface{}{
+				GitOpsAnnotation:   "true",
+				WorkflowAnnotation: "workflow-id",
+			},
+			resourceVersion: "2",
+			expectedAudit:   true,
+		},
+		{
+			name: "resync of a resource annotated for gitops",
+			annotations: map[string]interface{}{
+				GitOpsAnnotation:   "true",
+				WorkflowAnnotation: "workflow-id",
+			},
+			resourceVersion: "1",
+		},
+		{
+			name:            "resource without workflow",
+			annotations:     map[string]interface{}{GitOpsAnnotation: "true"},
+			resourceVersion: "2",
+		},
+		{
+			name: "resource with gitops disabled",
+			annotations: map[string]interface{}{
+				GitOpsAnnotation:   "false",
+				WorkflowAnnotation: "workflow-id",
+			},
+			resourceVersion: "2",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			var audited []string
+			tracker := &ResourceTracker{
+				audit: func(gvr schema.GroupVersionResource, newObj *unstructured.Unstructured, oldObj *unstructured.Unstructured, workflowID string) error {
+					audited = append(audited, workflowID)
+					return nil
+				},
+			}
+			oldObj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":            "nginx",
+					"resourceVersion": "1",
+					"annotations":     tc.annotations,
+				},
+				"spec": map[string]interface{}{"replicas": int64(1)},
+			}}
+			newObj := oldObj.DeepCopy()
+			newObj.SetResourceVersion(tc.resourceVersion)
+			if tc.resourceVersion != "1" {
+				newObj.Object["spec"] = map[string]interface{}{"replicas": int64(2)}
+			}
+
+			// when
+			tracker.onUpdate(deploymentsResource, oldObj, newObj)
+
+			// then
+			if tc.expectedAudit {
+				assert.Equal(t, []string{"workflow-id"}, audited)
+			} else {
+				assert.Empty(t, audited)
+			}
+		})
+	}
+}
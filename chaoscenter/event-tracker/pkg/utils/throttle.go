@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+)
+
+const (
+	TriggerPending   = "false"
+	TriggerSent      = "true"
+	TriggerSkipped   = "skipped"
+	StatusTimeFormat = time.RFC850
+
+	// ExperimentRunInProgress is the gitops notification response when a run of the experiment is in progress, it is
+	// a copy of the constant of the graphql server, which is a separate module, and must be kept in sync with it
+	ExperimentRunInProgress = "Experiment Run In Progress"
+
+	defaultStatusHistoryLimit = 100
+)
+
+const (
+	ReasonCooldown       = "cooldown"
+	ReasonRateLimited    = "max triggers per hour reached"
+	ReasonRunInProgress  = "run in progress"
+	ReasonGitopsDisabled = "gitops disabled"
+)
+
+func validateThrottling(spec litmuschaosv1.EventTrackerPolicySpec) []string {
+	var errs []string
+	if spec.Cooldown != "" {
+		if d, err := time.ParseDuration(spec.Cooldown); err != nil || d < 0 {
+			errs = append(errs, fmt.Sprintf("spec.cooldown: invalid duration %s", spec.Cooldown))
+		}
+	}
+	if spec.DebounceWindow != "" {
+		if d, err := time.ParseDuration(spec.DebounceWindow); err != nil || d < 0 {
+			errs = append(errs, fmt.Sprintf("spec.debounce_window: invalid duration %s", spec.DebounceWindow))
+		}
+	}
+	if spec.MaxTriggersPerHour < 0 {
+		errs = append(errs, "spec.max_triggers_per_hour: must not be negative")
+	}
+	if spec.StatusHistoryLimit < 0 {
+		errs = append(errs, "spec.status_history_limit: must not be negative")
+	}
+
+	return errs
+}
+
+// RecordPassedCondition records that an update of a resource passed the conditions of the policy, the update is
+// coalesced into the pending trigger of the experiment if there is one and the oldest statuses beyond the history
+// limit are dropped
+func RecordPassedCondition(etp *litmuschaosv1.EventTrackerPolicy, passed litmuschaosv1.EventTrackerPolicyStatus, now time.Time) {
+	passed.TimeStamp = now.Format(StatusTimeFormat)
+	passed.Result = ConditionPassed
+	passed.IsTriggered = TriggerPending
+
+	for i := range etp.Statuses {
+		status := &etp.Statuses[i]
+		if status.Result == ConditionPassed && status.IsTriggered == TriggerPending && status.WorkflowID == passed.WorkflowID {
+			*status = passed
+			return
+		}
+	}
+
+	etp.Statuses = append(etp.Statuses, passed)
+
+	limit := etp.Spec.StatusHistoryLimit
+	if limit == 0 {
+		limit = defaultStatusHistoryLimit
+	}
+	if len(etp.Statuses) > limit {
+		etp.Statuses = etp.Statuses[len(etp.Statuses)-limit:]
+	}
+}
+
+// ThrottleTrigger decides whether the pending trigger at the given index of the statuses can be sent, it returns
+// the duration to wait for the debounce window to elapse or the reason to skip the trigger, the trigger can be
+// sent when both are empty
+func ThrottleTrigger(etp litmuschaosv1.EventTrackerPolicy, index int, now time.Time) (time.Duration, string) {
+	status := etp.Statuses[index]
+
+	if window, err := time.ParseDuration(etp.Spec.DebounceWindow); err == nil && window > 0 {
+		if passedAt, err := time.Parse(StatusTimeFormat, status.TimeStamp); err == nil {
+			if elapsed := now.Sub(passedAt); elapsed < window {
+				return window - elapsed, ""
+			}
+		}
+	}
+
+	cooldown, _ := time.ParseDuration(etp.Spec.Cooldown)
+	triggersInLastHour := 0
+	for _, previous := range etp.Statuses {
+		if previous.IsTriggered != TriggerSent {
+			continue
+		}
+		triggeredAt, err := time.Parse(StatusTimeFormat, previous.TriggeredAt)
+		if err != nil {
+			continue
+		}
+
+		if cooldown > 0 && previous.WorkflowID == status.WorkflowID && now.Sub(triggeredAt) < cooldown {
+			return 0, ReasonCooldown
+		}
+		if now.Sub(triggeredAt) < time.Hour {
+			triggersInLastHour++
+		}
+	}
+
+	if etp.Spec.MaxTriggersPerHour > 0 && triggersInLastHour >= etp.Spec.MaxTriggersPerHour {
+		return 0, ReasonRateLimited
+	}
+
+	return 0, ""
+}
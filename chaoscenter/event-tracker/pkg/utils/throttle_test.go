@@ -0,0 +1,221 @@
+package utils
+
+import (
+	"testing"
+	"time"
+
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+	"github.com/stretchr/testify/assert"
+)
+
+var throttleTestNow = time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+func sentStatus(workflowID string, triggeredAt time.Time) litmuschaosv1.EventTrackerPolicyStatus {
+	return litmuschaosv1.EventTrackerPolicyStatus{
+		WorkflowID:  workflowID,
+		Result:      ConditionPassed,
+		IsTriggered: TriggerSent,
+		TimeStamp:   triggeredAt.Format(StatusTimeFormat),
+		TriggeredAt: triggeredAt.Format(StatusTimeFormat),
+	}
+}
+
+func pendingStatus(workflowID string, passedAt time.Time) litmuschaosv1.EventTrackerPolicyStatus {
+	return litmuschaosv1.EventTrackerPolicyStatus{
+		WorkflowID:  workflowID,
+		Result:      ConditionPassed,
+		IsTriggered: TriggerPending,
+		TimeStamp:   passedAt.Format(StatusTimeFormat),
+	}
+}
+
+// TestRecordPassedCondition is used to test the coalescing of the updates passing the conditions and the status history limit
+func TestRecordPassedCondition(t *testing.T) {
+	tests := []struct {
+		name               string
+		statusHistoryLimit int
+		statuses           []litmuschaosv1.EventTrackerPolicyStatus
+		passed             litmuschaosv1.EventTrackerPolicyStatus
+		expectedWorkflows  []string
+		expectedPending    int
+	}{
+		{
+			name:              "first update",
+			passed:            litmuschaosv1.EventTrackerPolicyStatus{WorkflowID: "wf-1", ResourceName: "nginx"},
+			expectedWorkflows: []string{"wf-1"},
+			expectedPending:   0,
+		},
+		{
+			name:              "update coalesced into the pending trigger of the experiment",
+			statuses:          []litmuschaosv1.EventTrackerPolicyStatus{pendingStatus("wf-1", throttleTestNow.Add(-time.Minute))},
+			passed:            litmuschaosv1.EventTrackerPolicyStatus{WorkflowID: "wf-1", ResourceName: "nginx"},
+			expectedWorkflows: []string{"wf-1"},
+			expectedPending:   0,
+		},
+		{
+			name:              "update of another experiment",
+			statuses:          []litmuschaosv1.EventTrackerPolicyStatus{pendingStatus("wf-1", throttleTestNow.Add(-time.Minute))},
+			passed:            litmuschaosv1.EventTrackerPolicyStatus{WorkflowID: "wf-2", ResourceName: "nginx"},
+			expectedWorkflows: []string{"wf-1", "wf-2"},
+			expectedPending:   1,
+		},
+		{
+			name:              "update after the trigger of the experiment was sent",
+			statuses:          []litmuschaosv1.EventTrackerPolicyStatus{sentStatus("wf-1", throttleTestNow.Add(-time.Minute))},
+			passed:            litmuschaosv1.EventTrackerPolicyStatus{WorkflowID: "wf-1", ResourceName: "nginx"},
+			expectedWorkflows: []string{"wf-1", "wf-1"},
+			expectedPending:   1,
+		},
+		{
+			name:               "oldest statuses dropped beyond the history limit",
+			statusHistoryLimit: 2,
+			statuses: []litmuschaosv1.EventTrackerPolicyStatus{
+				sentStatus("wf-1", throttleTestNow.Add(-3*time.Minute)),
+				sentStatus("wf-2", throttleTestNow.Add(-2*time.Minute)),
+			},
+			passed:            litmuschaosv1.EventTrackerPolicyStatus{WorkflowID: "wf-3", ResourceName: "nginx"},
+			expectedWorkflows: []string{"wf-2", "wf-3"},
+			expectedPending:   1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			etp := &litmuschaosv1.EventTrackerPolicy{
+				Spec:     litmuschaosv1.EventTrackerPolicySpec{StatusHistoryLimit: tc.statusHistoryLimit},
+				Statuses: append([]litmuschaosv1.EventTrackerPolicyStatus{}, tc.statuses...),
+			}
+
+			// when
+			RecordPassedCondition(etp, tc.passed, throttleTestNow)
+
+			// then
+			var workflows []string
+			for _, status := range etp.Statuses {
+				workflows = append(workflows, status.WorkflowID)
+			}
+			assert.Equal(t, tc.expectedWorkflows, workflows)
+			pending := etp.Statuses[tc.expectedPending]
+			assert.Equal(t, TriggerPending, pending.IsTriggered)
+			assert.Equal(t, ConditionPassed, pending.Result)
+			assert.Equal(t, "nginx", pending.ResourceName)
+			assert.Equal(t, throttleTestNow.Format(StatusTimeFormat), pending.TimeStamp)
+		})
+	}
+}
+
+// TestRecordPassedConditionDefaultHistoryLimit is used to test that the statuses are capped when no limit is set
+func TestRecordPassedConditionDefaultHistoryLimit(t *testing.T) {
+	// given
+	etp := &litmuschaosv1.EventTrackerPolicy{}
+	for i := 0; i < defaultStatusHistoryLimit; i++ {
+		etp.Statuses = append(etp.Statuses, sentStatus("wf-1", throttleTestNow.Add(-time.Hour)))
+	}
+
+	// when
+	RecordPassedCondition(etp, litmuschaosv1.EventTrackerPolicyStatus{WorkflowID: "wf-2"}, throttleTestNow)
+
+	// then
+	assert.Len(t, etp.Statuses, defaultStatusHistoryLimit)
+	assert.Equal(t, "wf-2", etp.Statuses[defaultStatusHistoryLimit-1].WorkflowID)
+}
+
+// TestThrottleTrigger is used to test the debounce window, the cooldown and the hourly limit of the triggers
+func TestThrottleTrigger(t *testing.T) {
+	tests := []struct {
+		name           string
+		spec           litmuschaosv1.EventTrackerPolicySpec
+		previous       []litmuschaosv1.EventTrackerPolicyStatus
+		passedAt       time.Time
+		expectedWait   time.Duration
+		expectedReason string
+	}{
+		{
+			name:     "no throttling",
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{sentStatus("wf-1", throttleTestNow.Add(-time.Second))},
+			passedAt: throttleTestNow,
+		},
+		{
+			name:         "within the debounce window",
+			spec:         litmuschaosv1.EventTrackerPolicySpec{DebounceWindow: "5m"},
+			passedAt:     throttleTestNow.Add(-2 * time.Minute),
+			expectedWait: 3 * time.Minute,
+		},
+		{
+			name:     "debounce window elapsed",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{DebounceWindow: "5m"},
+			passedAt: throttleTestNow.Add(-5 * time.Minute),
+		},
+		{
+			name:           "within the cooldown of the experiment",
+			spec:           litmuschaosv1.EventTrackerPolicySpec{Cooldown: "10m"},
+			previous:       []litmuschaosv1.EventTrackerPolicyStatus{sentStatus("wf-1", throttleTestNow.Add(-9*time.Minute))},
+			passedAt:       throttleTestNow,
+			expectedReason: ReasonCooldown,
+		},
+		{
+			name:     "cooldown elapsed",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{Cooldown: "10m"},
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{sentStatus("wf-1", throttleTestNow.Add(-10*time.Minute))},
+			passedAt: throttleTestNow,
+		},
+		{
+			name:     "cooldown of another experiment",
+			spec:     litmuschaosv1.EventTrackerPolicySpec{Cooldown: "10m"},
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{sentStatus("wf-2", throttleTestNow.Add(-time.Minute))},
+			passedAt: throttleTestNow,
+		},
+		{
+			name: "hourly limit reached",
+			spec: litmuschaosv1.EventTrackerPolicySpec{MaxTriggersPerHour: 2},
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{
+				sentStatus("wf-1", throttleTestNow.Add(-50*time.Minute)),
+				sentStatus("wf-2", throttleTestNow.Add(-10*time.Minute)),
+			},
+			passedAt:       throttleTestNow,
+			expectedReason: ReasonRateLimited,
+		},
+		{
+			name: "triggers older than an hour aren't counted",
+			spec: litmuschaosv1.EventTrackerPolicySpec{MaxTriggersPerHour: 2},
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{
+				sentStatus("wf-1", throttleTestNow.Add(-2*time.Hour)),
+				sentStatus("wf-2", throttleTestNow.Add(-10*time.Minute)),
+			},
+			passedAt: throttleTestNow,
+		},
+		{
+			name: "skipped triggers aren't counted",
+			spec: litmuschaosv1.EventTrackerPolicySpec{MaxTriggersPerHour: 1},
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{
+				{WorkflowID: "wf-1", Result: ConditionPassed, IsTriggered: TriggerSkipped, Reason: ReasonCooldown},
+			},
+			passedAt: throttleTestNow,
+		},
+		{
+			name: "debounce window checked before the cooldown",
+			spec: litmuschaosv1.EventTrackerPolicySpec{DebounceWindow: "1m", Cooldown: "10m"},
+			previous: []litmuschaosv1.EventTrackerPolicyStatus{
+				sentStatus("wf-1", throttleTestNow.Add(-5*time.Minute)),
+			},
+			passedAt:     throttleTestNow.Add(-30 * time.Second),
+			expectedWait: 30 * time.Second,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			etp := litmuschaosv1.EventTrackerPolicy{
+				Spec:     tc.spec,
+				Statuses: append(append([]litmuschaosv1.EventTrackerPolicyStatus{}, tc.previous...), pendingStatus("wf-1", tc.passedAt)),
+			}
+
+			// when
+			wait, reason := ThrottleTrigger(etp, len(etp.Statuses)-1, throttleTestNow)
+
+			// then
+			assert.Equal(t, tc.expectedWait, wait)
+			assert.Equal(t, tc.expectedReason, reason)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
+)
+
+const (
+	TriggerModeGitOps = "gitops"
+	TriggerModeDirect = "direct"
+
+	// maxDiffLength caps the length of the diff recorded in the statuses and sent to the server
+	maxDiffLength = 4096
+
+	redactedValue = "<redacted>"
+)
+
+// redactedFields are the top level fields whose values are left out of the diff, as they hold the contents of the
+// secrets and configmaps which are stored in the statuses and on the experiment runs readable by the project viewers
+var redactedFields = map[string]bool{
+	"data":       true,
+	"stringData": true,
+	"binaryData": true,
+}
+
+const eventTriggerMutation = `mutation triggerExperimentOnEvent($clusterInfo: InfraIdentity!, $request: ExperimentEventTriggerRequest!) {
+  triggerExperimentOnEvent(clusterInfo: $clusterInfo, request: $request) {
+    notifyID
+    skipped
+    reason
+  }
+}`
+
+func validateTriggerMode(spec litmuschaosv1.EventTrackerPolicySpec) []string {
+	switch spec.TriggerMode {
+	case "", TriggerModeGitOps, TriggerModeDirect:
+		return nil
+	default:
+		return []string{fmt.Sprintf("spec.trigger_mode: unsupported trigger mode %s", spec.TriggerMode)}
+	}
+}
+
+// ResourceDiff lists the fields changed by the update of the resource as path: old -> new, the metadata of the
+// resource is left out, only the changed keys of the redacted fields are listed and the diff is truncated beyond
+// maxDiffLength. The format is the one of the drift diffs of the graphql server (gitops.ManifestDiff), which is a
+// separate module, keep both in sync
+func ResourceDiff(newData map[string]interface{}, oldData map[string]interface{}) string {
+	var changes []string
+	for _, key := range unionKeys(newData, oldData) {
+		if key == "metadata" {
+			continue
+		}
+		changes = appendChanges(changes, key, oldData[key], newData[key], redactedFields[key])
+	}
+	sort.Strings(changes)
+
+	diff := strings.Join(changes, "\n")
+	if len(diff) > maxDiffLength {
+		end := strings.LastIndex(diff[:maxDiffLength], "\n")
+		if end < 0 {
+			end = maxDiffLength
+		}
+		diff = diff[:end] + "\n..."
+	}
+
+	return diff
+}
+
+func appendChanges(changes []string, path string, oldValue interface{}, newValue interface{}, redact bool) []string {
+	if reflect.DeepEqual(oldValue, newValue) {
+		return changes
+	}
+
+	oldMap, oldIsMap := oldValue.(map[string]interface{})
+	newMap, newIsMap := newValue.(map[string]interface{})
+	// the keys of a redacted field added or removed as a whole are listed
+	if redact && oldValue == nil && newIsMap {
+		oldMap, oldIsMap = map[string]interface{}{}, true
+	}
+	if redact && newValue == nil && oldIsMap {
+		newMap, newIsMap = map[string]interface{}{}, true
+	}
+	if oldIsMap && newIsMap {
+		for _, key := range unionKeys(newMap, oldMap) {
+			changes = appendChanges(changes, path+"."+key, oldMap[key], newMap[key], redact)
+		}
+		return changes
+	}
+
+	if redact {
+		return append(changes, fmt.Sprintf("%s: %s -> %s", path, redactedDiffValue(oldValue), redactedDiffValue(newValue)))
+	}
+	return append(changes, fmt.Sprintf("%s: %s -> %s", path, diffValue(oldValue), diffValue(newValue)))
+}
+
+func unionKeys(a map[string]interface{}, b map[string]interface{}) []string {
+	var keys []string
+	for key := range a {
+		keys = append(keys, key)
+	}
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func diffValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
+
+// redactedDiffValue hides the value while showing whether the field was added or removed
+func redactedDiffValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	return redactedValue
+}
+
+// SendEventTrigger requests the litmus graphql server to run the experiment of the given passed status, the server
+// runs it regardless of the gitops settings of the project and stores the policy and the resource event on the run
+func SendEventTrigger(policyName string, status litmuschaosv1.EventTrackerPolicyStatus, skipIfRunning bool) (string, error) {
+	accessKey, clusterID, serverAddr, err := getAgentConfigMapData()
+	if err != nil {
+		return "", err
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"query": eventTriggerMutation,
+		"variables": map[string]interface{}{
+			"clusterInfo": map[string]string{
+				"infraID":   clusterID,
+				"version":   Version,
+				"accessKey": accessKey,
+			},
+			"request": map[string]interface{}{
+				"experimentID":      status.WorkflowID,
+				"policyName":        policyName,
+				"resourceKind":      status.Resource,
+				"resourceName":      status.ResourceName,
+				"resourceNamespace": status.ResourceNamespace,
+				"diff":              status.Diff,
+				"skipIfRunning":     skipIfRunning,
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return sendQuery(serverAddr, payload)
+}
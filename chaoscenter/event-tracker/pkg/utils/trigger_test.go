@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestResourceDiff is used to test the diff of the updates of the resources recorded in the statuses and on the runs
+func TestResourceDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		oldData  map[string]interface{}
+		newData  map[string]interface{}
+		expected string
+	}{
+		{
+			name: "changed, added and removed fields",
+			oldData: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": float64(2), "paused": true},
+			},
+			newData: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": float64(3), "minReadySeconds": float64(10)},
+			},
+			expected: "spec.minReadySeconds: <none> -> 10\nspec.paused: true -> <none>\nspec.replicas: 2 -> 3",
+		},
+		{
+			name: "metadata left out",
+			oldData: map[string]interface{}{
+				"metadata": map[string]interface{}{"resourceVersion": "1"},
+				"spec":     map[string]interface{}{"image": "nginx:1.20"},
+			},
+			newData: map[string]interface{}{
+				"metadata": map[string]interface{}{"resourceVersion": "2"},
+				"spec":     map[string]interface{}{"image": "nginx:1.21"},
+			},
+			expected: `spec.image: "nginx:1.20" -> "nginx:1.21"`,
+		},
+		{
+			name: "values of the secret redacted",
+			oldData: map[string]interface{}{
+				"kind": "Secret",
+				"data": map[string]interface{}{"password": "b2xk", "username": "YWRtaW4=", "token": "dG9rZW4="},
+			},
+			newData: map[string]interface{}{
+				"kind":       "Secret",
+				"data":       map[string]interface{}{"password": "bmV3", "username": "YWRtaW4=", "key": "a2V5"},
+				"stringData": map[string]interface{}{"host": "db.internal"},
+			},
+			expected: "data.key: <none> -> <redacted>\ndata.password: <redacted> -> <redacted>\n" +
+				"data.token: <redacted> -> <none>\nstringData.host: <none> -> <redacted>",
+		},
+		{
+			name: "values of the configmap redacted",
+			oldData: map[string]interface{}{
+				"kind":       "ConfigMap",
+				"data":       map[string]interface{}{"config.yaml": "level: info"},
+				"binaryData": map[string]interface{}{"cert": "Y2VydA=="},
+			},
+			newData: map[string]interface{}{
+				"kind": "ConfigMap",
+				"data": map[string]interface{}{"config.yaml": "level: debug"},
+			},
+			expected: "binaryData.cert: <redacted> -> <none>\ndata.config.yaml: <redacted> -> <redacted>",
+		},
+		{
+			name:     "unchanged resource",
+			oldData:  map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(2)}},
+			newData:  map[string]interface{}{"spec": map[string]interface{}{"replicas": float64(2)}},
+			expected: "",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			diff := ResourceDiff(tc.newData, tc.oldData)
+
+			// then
+			assert.Equal(t, tc.expected, diff)
+		})
+	}
+}
+
+// TestResourceDiffTruncated is used to test that the diff is truncated at a line boundary beyond maxDiffLength
+func TestResourceDiffTruncated(t *testing.T) {
+	// given
+	oldSpec, newSpec := map[string]interface{}{}, map[string]interface{}{}
+	for i := 0; i < 500; i++ {
+		key := "field" + strings.Repeat("x", i%10) + string(rune('a'+i%26)) + strings.Repeat("y", i/26)
+		oldSpec[key] = "old"
+		newSpec[key] = "new"
+	}
+
+	// when
+	diff := ResourceDiff(map[string]interface{}{"spec": newSpec}, map[string]interface{}{"spec": oldSpec})
+
+	// then
+	assert.LessOrEqual(t, len(diff), maxDiffLength+len("\n..."))
+	assert.True(t, strings.HasSuffix(diff, "\n..."))
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n..."), "\n") {
+		assert.True(t, strings.HasSuffix(line, `"old" -> "new"`))
+	}
+}
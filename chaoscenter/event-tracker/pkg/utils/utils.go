@@ -5,15 +5,12 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io/ioutil"
 
 	"github.com/sirupsen/logrus"
 
-	"github.com/jmespath/go-jmespath"
 	litmuschaosv1 "github.com/litmuschaos/litmus/chaoscenter/event-tracker/api/v1"
 	"github.com/litmuschaos/litmus/chaoscenter/event-tracker/pkg/k8s"
-	v1 "k8s.io/api/apps/v1"
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -22,6 +19,7 @@ import (
 
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -41,95 +39,9 @@ const (
 	//ConditionFailed       = "ConditionFailed"
 )
 
-const (
-	StateFulSet = "statefulset"
-	Deployment  = "deployment"
-	DaemonSet   = "daemonset"
-)
-
-func cases(key string, value string, operator string) bool {
-	switch operator {
-	case "EqualTo":
-		return key == value
-	case "NotEqualTo":
-		return key != value
-	case "LessThan":
-		return key < value
-	case "GreaterThan":
-		return key > value
-	case "GreaterThanEqualTo":
-		return key >= value
-	case "LessThanEqualTo":
-		return key <= value
-	}
-
-	return false
-}
-
-func conditionChecker(etp litmuschaosv1.EventTrackerPolicy, newData interface{}, oldData interface{}) bool {
-	final_result := false
-	if etp.Spec.ConditionType == "and" {
-		for _, condition := range etp.Spec.Conditions {
-			newDataResult, err := jmespath.Search(condition.Key, newData)
-			if err != nil {
-				logrus.Error(err)
-				return false
-			}
-
-			oldDataResult, err := jmespath.Search(condition.Key, oldData)
-			if err != nil {
-				logrus.Error(err)
-				return false
-			}
-
-			if newDataResult != oldDataResult {
-				if condition.Operator == "Change" {
-					final_result = true
-				} else {
-					str := fmt.Sprintf("%v", newDataResult)
-					if val := cases(str, *condition.Value, condition.Operator); !val {
-						final_result = val
-						break
-					} else if val {
-						final_result = true
-					}
-				}
-			} else {
-				logrus.Println("no changes in the resource")
-			}
-		}
-	} else if etp.Spec.ConditionType == "or" {
-		for _, condition := range etp.Spec.Conditions {
-			newDataResult, err := jmespath.Search(condition.Key, newData)
-			if err != nil {
-				logrus.Error(err)
-			}
-
-			oldDataResult, err := jmespath.Search(condition.Key, oldData)
-			if err != nil {
-				logrus.Error(err)
-				return false
-			}
-
-			if newDataResult != oldDataResult {
-				if condition.Operator == "Change" {
-					final_result = true
-				} else {
-					str := fmt.Sprintf("%v", newDataResult)
-					if val := cases(str, *condition.Value, condition.Operator); val {
-						final_result = val
-					}
-				}
-			} else {
-				logrus.Println("no changes in the resource")
-			}
-		}
-	}
-
-	return final_result
-}
-
-func PolicyAuditor(resourceType string, newObj interface{}, oldObj interface{}, workflowid string) error {
+// PolicyAuditor evaluates the conditions of the event-tracker policies auditing the given resource and records
+// the passed ones in the statuses of the policies
+func PolicyAuditor(gvr schema.GroupVersionResource, newObj *unstructured.Unstructured, oldObj *unstructured.Unstructured, workflowid string) error {
 	restConfig, err := k8s.GetKubeConfig()
 	if err != nil {
 		return err
@@ -169,103 +81,30 @@ func PolicyAuditor(resourceType string, newObj interface{}, oldObj interface{},
 			return err
 		}
 
-		var (
-			newDataInterface interface{}
-			resourceName     string
-			oldDataInterface interface{}
-		)
-
-		expr := strings.ToLower(resourceType)
-		switch expr {
-		case Deployment:
-			newDeploy := newObj.(*v1.Deployment)
-			resourceName = newDeploy.GetName()
-
-			newMar, err := json.Marshal(newDeploy)
-			if err != nil {
-				return err
-			}
-
-			err = json.Unmarshal(newMar, &newDataInterface)
-			if err != nil {
-				return err
-			}
-
-			oldDeploy := oldObj.(*v1.Deployment)
-			oldMar, err := json.Marshal(oldDeploy)
-			if err != nil {
-				return err
-			}
-
-			err = json.Unmarshal(oldMar, &oldDataInterface)
-			if err != nil {
-				return err
-			}
-
-		case StateFulSet:
-			newSts := newObj.(*v1.StatefulSet)
-			resourceName = newSts.GetName()
-
-			newMar, err := json.Marshal(newSts)
-			if err != nil {
-				return err
-			}
-
-			err = json.Unmarshal(newMar, &newDataInterface)
-			if err != nil {
-				return err
-			}
-
-			oldSts := oldObj.(*v1.StatefulSet)
-			oldMar, err := json.Marshal(oldSts)
-			if err != nil {
-				return err
-			}
-
-			err = json.Unmarshal(oldMar, &oldDataInterface)
-			if err != nil {
-				return err
-			}
-
-		case DaemonSet:
-			newDs := newObj.(*v1.DaemonSet)
-			resourceName = newDs.GetName()
-			newMar, err := json.Marshal(newDs)
-			if err != nil {
-				return err
-			}
-
-			err = json.Unmarshal(newMar, &newDataInterface)
-			if err != nil {
-				return err
-			}
-
-			oldDs := oldObj.(*v1.DaemonSet)
-			oldMar, err := json.Marshal(oldDs)
-			if err != nil {
-				return err
-			}
-
-			err = json.Unmarshal(oldMar, &oldDataInterface)
-			if err != nil {
-				return err
-			}
+		if !tracksResource(etp, gvr) {
+			continue
+		}
 
-		default:
-			return errors.New("resource not supported")
+		if validationErrors := ValidatePolicy(etp.Spec); len(validationErrors) > 0 {
+			logrus.Errorf("Skipping invalid event-tracker policy %s: %s", etp.GetName(), strings.Join(validationErrors, ", "))
+			continue
 		}
 
-		check := conditionChecker(etp, newDataInterface, oldDataInterface)
+		var (
+			resourceType = newObj.GetKind()
+			resourceName = newObj.GetName()
+		)
+
+		check := conditionChecker(etp, newObj.UnstructuredContent(), oldObj.UnstructuredContent())
 
 		if check == true {
-			etp.Statuses = append(etp.Statuses, litmuschaosv1.EventTrackerPolicyStatus{
-				TimeStamp:    time.Now().Format(time.RFC850),
-				Resource:     resourceType,
-				ResourceName: resourceName,
-				Result:       ConditionPassed,
-				WorkflowID:   workflowid,
-				IsTriggered:  "false",
-			})
+			RecordPassedCondition(&etp, litmuschaosv1.EventTrackerPolicyStatus{
+				Resource:          resourceType,
+				ResourceName:      resourceName,
+				ResourceNamespace: newObj.GetNamespace(),
+				WorkflowID:        workflowid,
+				Diff:              ResourceDiff(newObj.UnstructuredContent(), oldObj.UnstructuredContent()),
+			}, time.Now())
 
 			// Updating EventTrackerPolicy
 			var unstruc unstructured.Unstructured
@@ -293,6 +132,16 @@ func PolicyAuditor(resourceType string, newObj interface{}, oldObj interface{},
 	return nil
 }
 
+func tracksResource(etp litmuschaosv1.EventTrackerPolicy, gvr schema.GroupVersionResource) bool {
+	for _, resource := range PolicyResources(etp) {
+		if resource == gvr {
+			return true
+		}
+	}
+
+	return false
+}
+
 func getAgentConfigMapData() (string, string, string, error) {
 	clientSet, err := k8s.K8sClient()
 	if err != nil {
@@ -319,15 +168,22 @@ func getAgentConfigMapData() (string, string, string, error) {
 	return "", "", "", nil
 }
 
-// SendRequest Function to send request to litmus graphql server
-func SendRequest(workflowID string) (string, error) {
+// SendRequest Function to send request to litmus graphql server, the server skips the run if skipIfRunning is set and
+// a run of the experiment is in progress
+func SendRequest(workflowID string, skipIfRunning bool) (string, error) {
 	accessKey, clusterID, serverAddr, err := getAgentConfigMapData()
 	if err != nil {
 		return "", err
 	}
 
-	payload := `{"query": "mutation { gitopsNotifier(clusterInfo: { infraID: \"` + clusterID + `\", version: \"` + Version + `\", accessKey: \"` + accessKey + `\"}, experimentID: \"` + workflowID + `\")\n}"}`
-	req, err := http.NewRequest("POST", serverAddr, bytes.NewBuffer([]byte(payload)))
+	payload := `{"query": "mutation { gitopsNotifier(clusterInfo: { infraID: \"` + clusterID + `\", version: \"` + Version + `\", accessKey: \"` + accessKey + `\"}, experimentID: \"` + workflowID + `\", skipIfRunning: ` + strconv.FormatBool(skipIfRunning) + `)\n}"}`
+
+	return sendQuery(serverAddr, []byte(payload))
+}
+
+// sendQuery posts the given graphql payload to the litmus graphql server and returns the response body
+func sendQuery(serverAddr string, payload []byte) (string, error) {
+	req, err := http.NewRequest("POST", serverAddr, bytes.NewBuffer(payload))
 	if err != nil {
 		return "", err
 	}
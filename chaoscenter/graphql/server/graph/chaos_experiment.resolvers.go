@@ -22,7 +22,7 @@ func (r *mutationResolver) CreateChaosExperiment(ctx context.Context, request mo
 
 	logrus.WithFields(logFields).Info("request received to create chaos experiment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateEnvironment],
+		authorization.CreateEnvironment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -72,7 +72,7 @@ func (r *mutationResolver) SaveChaosExperiment(ctx context.Context, request mode
 	}
 	logrus.WithFields(logFields).Info("request received to save chaos experiment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateChaosWorkFlow],
+		authorization.CreateChaosWorkFlow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -97,7 +97,7 @@ func (r *mutationResolver) UpdateChaosExperiment(ctx context.Context, request *m
 
 	logrus.WithFields(logFields).Info("request received to update chaos experiment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ReRunChaosWorkFlow],
+		authorization.ReRunChaosWorkFlow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -121,7 +121,7 @@ func (r *mutationResolver) DeleteChaosExperiment(ctx context.Context, experiment
 	logrus.WithFields(logFields).Info("request received to delete chaos experiment")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteChaosWorkflow],
+		authorization.DeleteChaosWorkflow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -135,6 +135,48 @@ func (r *mutationResolver) DeleteChaosExperiment(ctx context.Context, experiment
 	return uiResponse, err
 }
 
+func (r *mutationResolver) UpdateScoringPolicy(ctx context.Context, projectID string, experimentID string, policy model.ScoringPolicyInput, recompute *bool) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId":    projectID,
+		"experimentId": experimentID,
+	}
+	logrus.WithFields(logFields).Info("request received to update scoring policy of chaos experiment")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateChaosWorkflow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+
+	updated, err := r.chaosExperimentHandler.UpdateScoringPolicy(ctx, projectID, experimentID, policy, recompute != nil && *recompute)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return false, err
+	}
+	return updated, nil
+}
+
+func (r *mutationResolver) RecomputeResiliencyScores(ctx context.Context, projectID string, experimentID string) (int, error) {
+	logFields := logrus.Fields{
+		"projectId":    projectID,
+		"experimentId": experimentID,
+	}
+	logrus.WithFields(logFields).Info("request received to recompute resiliency scores of chaos experiment")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateChaosWorkflow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := r.chaosExperimentHandler.RecomputeResiliencyScores(ctx, projectID, experimentID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return 0, err
+	}
+	return count, nil
+}
+
 func (r *queryResolver) GetExperiment(ctx context.Context, projectID string, experimentID string) (*model.GetExperimentResponse, error) {
 	logFields := logrus.Fields{
 		"projectId":         projectID,
@@ -142,7 +184,7 @@ func (r *queryResolver) GetExperiment(ctx context.Context, projectID string, exp
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos experiment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflow],
+		authorization.ListWorkflow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -164,7 +206,7 @@ func (r *queryResolver) ListExperiment(ctx context.Context, projectID string, re
 	logrus.WithFields(logFields).Info("request received to list chaos experiments")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflow],
+		authorization.ListWorkflow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -184,7 +226,7 @@ func (r *queryResolver) GetExperimentStats(ctx context.Context, projectID string
 	logrus.WithFields(logFields).Info("request received to get chaos experiment stats")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflow],
+		authorization.ListWorkflow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -198,6 +240,33 @@ func (r *queryResolver) GetExperimentStats(ctx context.Context, projectID string
 	return uiResponse, err
 }
 
+func (r *queryResolver) ValidateChaosExperiment(ctx context.Context, projectID string, request model.ValidateChaosExperimentRequest) (*model.ExperimentValidationResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to validate chaos experiment")
+
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.CreateChaosWorkFlow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	hubFaults, err := r.chaosHubService.ListHubFaults(ctx, projectID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentHandler.ValidateChaosExperiment(ctx, projectID, request, hubFaults, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, nil
+}
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
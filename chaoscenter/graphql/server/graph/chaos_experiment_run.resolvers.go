@@ -7,7 +7,9 @@ import (
 	"context"
 	"errors"
 
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
 	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/sirupsen/logrus"
@@ -15,7 +17,7 @@ import (
 )
 
 func (r *mutationResolver) ChaosExperimentRun(ctx context.Context, request model.ExperimentRunRequest) (string, error) {
-	return r.chaosExperimentRunHandler.ChaosExperimentRunEvent(request)
+	return r.chaosExperimentRunHandler.ChaosExperimentRunEvent(request, data_store.Store)
 }
 
 func (r *mutationResolver) RunChaosExperiment(ctx context.Context, experimentID string, projectID string) (*model.RunChaosExperimentResponse, error) {
@@ -26,7 +28,7 @@ func (r *mutationResolver) RunChaosExperiment(ctx context.Context, experimentID
 
 	logrus.WithFields(logFields).Info("request received to run chaos experiment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateChaosWorkFlow],
+		authorization.CreateChaosWorkFlow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -53,6 +55,53 @@ func (r *mutationResolver) RunChaosExperiment(ctx context.Context, experimentID
 	return &model.RunChaosExperimentResponse{NotifyID: uiResponse.NotifyID}, err
 }
 
+func (r *mutationResolver) TriggerExperimentOnEvent(ctx context.Context, clusterInfo model.InfraIdentity, request model.ExperimentEventTriggerRequest) (*model.ExperimentEventTriggerResponse, error) {
+	logFields := logrus.Fields{
+		"infraId":           clusterInfo.InfraID,
+		"chaosExperimentId": request.ExperimentID,
+		"policyName":        request.PolicyName,
+	}
+	logrus.WithFields(logFields).Info("request received to trigger chaos experiment on event")
+
+	infra, err := r.chaosInfrastructureService.VerifyInfra(clusterInfo)
+	if err != nil {
+		logrus.Error("Validation failed : ", clusterInfo.InfraID)
+		return nil, err
+	}
+	audit.SetInfraActor(ctx, infra.InfraID, infra.Name, infra.ProjectID)
+
+	response, err := r.chaosExperimentRunHandler.TriggerExperimentOnEvent(ctx, *infra, request, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (r *mutationResolver) StopExperimentRun(ctx context.Context, projectID string, experimentID string, experimentRunID *string) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId":            projectID,
+		"chaosExperimentId":    experimentID,
+		"chaosExperimentRunId": experimentRunID,
+	}
+	logrus.WithFields(logFields).Info("request received to stop chaos experiment run")
+
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.TerminateChaosWorkflow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+
+	uiResponse, err := r.chaosExperimentRunHandler.StopExperimentRuns(ctx, projectID, experimentID, experimentRunID, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return false, err
+	}
+	return uiResponse, nil
+}
+
 func (r *queryResolver) GetExperimentRun(ctx context.Context, projectID string, experimentRunID string) (*model.ExperimentRun, error) {
 	logFields := logrus.Fields{
 		"projectId":            projectID,
@@ -60,7 +109,7 @@ func (r *queryResolver) GetExperimentRun(ctx context.Context, projectID string,
 	}
 	logrus.WithFields(logFields).Info("request received to fetch chaos experiment run")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetWorkflowRun],
+		authorization.GetWorkflowRun,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -83,7 +132,7 @@ func (r *queryResolver) ListExperimentRun(ctx context.Context, projectID string,
 	logrus.WithFields(logFields).Info("request received to list chaos experiment run")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflowRuns],
+		authorization.ListWorkflowRuns,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -102,7 +151,7 @@ func (r *queryResolver) GetExperimentRunStats(ctx context.Context, projectID str
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos experiment run stats")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflowRuns],
+		authorization.ListWorkflowRuns,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -115,3 +164,82 @@ func (r *queryResolver) GetExperimentRunStats(ctx context.Context, projectID str
 	}
 	return uiResponse, err
 }
+
+func (r *queryResolver) GetExperimentRunGate(ctx context.Context, projectID string, notifyID string, gate *model.ExperimentRunGateInput, timeout *int) (*model.ExperimentRunGateResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"notifyId":  notifyID,
+	}
+	logrus.WithFields(logFields).Info("request received to get chaos experiment run gate")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetWorkflowRun,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentRunHandler.GetExperimentRunGate(ctx, projectID, notifyID, gate, timeout, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, err
+}
+
+func (r *queryResolver) CompareExperimentRuns(ctx context.Context, projectID string, experimentRunIDs []string) (*model.ExperimentRunComparison, error) {
+	logFields := logrus.Fields{
+		"projectId":        projectID,
+		"experimentRunIds": experimentRunIDs,
+	}
+	logrus.WithFields(logFields).Info("request received to compare chaos experiment runs")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListWorkflowRuns,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	comparison, err := r.chaosExperimentRunHandler.CompareExperimentRuns(ctx, projectID, experimentRunIDs)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return comparison, nil
+}
+
+func (r *subscriptionResolver) GetExperimentRunEvents(ctx context.Context, projectID string, experimentID *string) (<-chan *model.ExperimentRun, error) {
+	logFields := logrus.Fields{
+		"projectId":         projectID,
+		"chaosExperimentId": experimentID,
+	}
+	logrus.WithFields(logFields).Info("request received to listen to chaos experiment run events")
+
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListWorkflowRuns,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	// listeners scoped to an experiment are registered against the experiment of the project, others against the project
+	key := data_store.ExperimentRunObserverKey(projectID, "")
+	if experimentID != nil {
+		key = data_store.ExperimentRunObserverKey(projectID, *experimentID)
+	}
+
+	experimentRunEvent := make(chan *model.ExperimentRun, 10)
+	data_store.Store.AddExperimentRunObserver(key, experimentRunEvent)
+
+	go func() {
+		<-ctx.Done()
+		logrus.WithFields(logFields).Info("closed chaos experiment run events listener")
+		data_store.Store.RemoveExperimentRunObserver(key, experimentRunEvent)
+	}()
+
+	return experimentRunEvent, nil
+}
+
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
+type subscriptionResolver struct{ *Resolver }
@@ -5,12 +5,10 @@ package graph
 
 import (
 	"context"
-	"errors"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
-	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
@@ -27,7 +25,7 @@ func (r *mutationResolver) RegisterInfra(ctx context.Context, projectID string,
 	logrus.WithFields(logFields).Info("request received for new a chaos infrastructure")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UserInfrastructureReg],
+		authorization.UserInfrastructureReg,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -54,7 +52,7 @@ func (r *mutationResolver) DeleteInfra(ctx context.Context, projectID string, in
 	logrus.WithFields(logFields).Info("request received to delete chaos infrastructure")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteInfrastructures],
+		authorization.DeleteInfrastructures,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -92,6 +90,14 @@ func (r *mutationResolver) KubeObj(ctx context.Context, request model.KubeObject
 	return r.chaosInfrastructureService.KubeObj(request, *data_store.Store)
 }
 
+func (r *mutationResolver) DryRunResult(ctx context.Context, request model.DryRunResultRequest) (string, error) {
+	return r.chaosInfrastructureService.DryRunResult(request, *data_store.Store)
+}
+
+func (r *mutationResolver) InfraHeartbeat(ctx context.Context, request model.InfraIdentity) (bool, error) {
+	return r.chaosInfrastructureService.InfraHeartbeat(request)
+}
+
 func (r *queryResolver) GetInfra(ctx context.Context, projectID string, infraID string) (*model.Infra, error) {
 	logFields := logrus.Fields{
 		"projectId":    projectID,
@@ -99,7 +105,7 @@ func (r *queryResolver) GetInfra(ctx context.Context, projectID string, infraID
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetInfrastructure],
+		authorization.GetInfrastructure,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -118,7 +124,7 @@ func (r *queryResolver) ListInfras(ctx context.Context, projectID string, reques
 	}
 	logrus.WithFields(logFields).Info("request received to list chaos infrastructures")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListInfrastructures],
+		authorization.ListInfrastructures,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -139,7 +145,7 @@ func (r *queryResolver) GetInfraDetails(ctx context.Context, infraID string, pro
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure details")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetInfraDetails],
+		authorization.GetInfraDetails,
 		model.InvitationAccepted.String())
 
 	gcaResponse, err := r.chaosInfrastructureService.GetInfraDetails(ctx, infraID, projectID)
@@ -156,7 +162,7 @@ func (r *queryResolver) GetInfraManifest(ctx context.Context, infraID string, up
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure manifest")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetManifest],
+		authorization.GetManifest,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -181,7 +187,7 @@ func (r *queryResolver) GetInfraStats(ctx context.Context, projectID string) (*m
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure stats")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetInfraDetails],
+		authorization.GetInfraDetails,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -221,14 +227,26 @@ func (r *subscriptionResolver) InfraConnect(ctx context.Context, request model.I
 		return infraAction, err
 	}
 	data_store.Store.Mutex.Lock()
+	// subscribers reconnect as soon as their connection breaks, which the server may not have noticed yet,
+	// so the latest connection of an infra replaces the previous one
 	if _, ok := data_store.Store.ConnectedInfra[request.InfraID]; ok {
-		data_store.Store.Mutex.Unlock()
-		return infraAction, errors.New("CLUSTER ALREADY CONNECTED")
+		logrus.Print("REPLACING PREVIOUS CLUSTER CONNECTION: ", request.InfraID)
 	}
 	data_store.Store.ConnectedInfra[request.InfraID] = infraAction
 	data_store.Store.Mutex.Unlock()
 	go func() {
 		<-ctx.Done()
+		data_store.Store.Mutex.Lock()
+		// the connection is also removed by the sweep of the inactive infras, which already marks the infra inactive
+		replaced := data_store.Store.ConnectedInfra[request.InfraID] != infraAction
+		if !replaced {
+			delete(data_store.Store.ConnectedInfra, request.InfraID)
+		}
+		data_store.Store.Mutex.Unlock()
+		if replaced {
+			return
+		}
+
 		verifiedInfra.IsActive = false
 
 		newVerifiedInfra := model.Infra{}
@@ -236,9 +254,6 @@ func (r *subscriptionResolver) InfraConnect(ctx context.Context, request model.I
 
 		r.chaosInfrastructureService.SendInfraEvent("infra-status", "Infra Offline", "Infra Disconnect", newVerifiedInfra, *data_store.Store)
 
-		data_store.Store.Mutex.Lock()
-		delete(data_store.Store.ConnectedInfra, request.InfraID)
-		data_store.Store.Mutex.Unlock()
 		query := bson.D{{"infra_id", request.InfraID}}
 		update := bson.D{{"$set", bson.D{{"is_active", false}, {"updated_at", time.Now().UnixMilli()}}}}
 
@@ -249,7 +264,7 @@ func (r *subscriptionResolver) InfraConnect(ctx context.Context, request model.I
 	}()
 
 	query := bson.D{{"infra_id", request.InfraID}}
-	update := bson.D{{"$set", bson.D{{"is_active", true}, {"updated_at", time.Now().UnixMilli()}, {"version", request.Version}}}}
+	update := bson.D{{"$set", bson.D{{"is_active", true}, {"updated_at", time.Now().UnixMilli()}, {"last_heartbeat", time.Now().UnixMilli()}, {"version", request.Version}}}}
 
 	err = r.chaosInfrastructureService.UpdateInfra(query, update)
 	if err != nil {
@@ -261,20 +276,29 @@ func (r *subscriptionResolver) InfraConnect(ctx context.Context, request model.I
 
 	verifiedInfra.IsActive = true
 	r.chaosInfrastructureService.SendInfraEvent("infra-status", "Infra Live", "Infra is Live and Connected", newVerifiedInfra, *data_store.Store)
+
+	// the subscriber keeps the blackout windows in memory, they are sent again on every connection
+	go func() {
+		if err := r.blackoutWindowService.SendBlackoutWindows(context.Background(), *verifiedInfra, data_store.Store); err != nil {
+			logrus.WithField("infraId", request.InfraID).WithError(err).Error("failed to send blackout windows")
+		}
+	}()
+	// the runs stopped while the infra was offline are still running on it
+	go func() {
+		if err := r.choasExperimentRunService.SendPendingStops(context.Background(), request.InfraID, data_store.Store); err != nil {
+			logrus.WithField("infraId", request.InfraID).WithError(err).Error("failed to send pending experiment run stops")
+		}
+	}()
 	return infraAction, nil
 }
 
 func (r *subscriptionResolver) GetPodLog(ctx context.Context, request model.PodLogRequest) (<-chan *model.PodLogResponse, error) {
 	logrus.Print("NEW LOG REQUEST: ", request.InfraID, request.PodName)
-	workflowLog := make(chan *model.PodLogResponse, 1)
 	reqID := uuid.New()
-	data_store.Store.Mutex.Lock()
-	data_store.Store.ExperimentLog[reqID.String()] = workflowLog
-	data_store.Store.Mutex.Unlock()
+	workflowLog := data_store.Store.AddPodLogRequest(ctx, reqID.String())
 	go func() {
 		<-ctx.Done()
 		logrus.Print("CLOSED LOG LISTENER: ", request.InfraID, request.PodName)
-		delete(data_store.Store.ExperimentLog, reqID.String())
 	}()
 	go r.chaosExperimentHandler.GetLogs(reqID.String(), request, *data_store.Store)
 	return workflowLog, nil
@@ -290,14 +314,11 @@ func (r *subscriptionResolver) GetKubeObject(ctx context.Context, request model.
 	go func() {
 		<-ctx.Done()
 		logrus.Println("Closed KubeObj Listener")
+		data_store.Store.Mutex.Lock()
 		delete(data_store.Store.KubeObjectData, reqID.String())
+		data_store.Store.Mutex.Unlock()
 	}()
 	go r.chaosExperimentHandler.GetKubeObjData(reqID.String(), request, *data_store.Store)
 
 	return kubeObjData, nil
 }
-
-// Subscription returns generated.SubscriptionResolver implementation.
-func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
-
-type subscriptionResolver struct{ *Resolver }
@@ -13,7 +13,7 @@ import (
 
 func (r *mutationResolver) AddChaosHub(ctx context.Context, projectID string, request model.CreateChaosHubRequest) (*model.ChaosHub, error) {
 	if err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.AddChaosHub],
+		authorization.AddChaosHub,
 		model.InvitationAccepted.String()); err != nil {
 		return nil, err
 	}
@@ -23,7 +23,7 @@ func (r *mutationResolver) AddChaosHub(ctx context.Context, projectID string, re
 
 func (r *mutationResolver) AddRemoteChaosHub(ctx context.Context, projectID string, request model.CreateRemoteChaosHub) (*model.ChaosHub, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.SaveChaosHub],
+		authorization.SaveChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -34,7 +34,7 @@ func (r *mutationResolver) AddRemoteChaosHub(ctx context.Context, projectID stri
 
 func (r *mutationResolver) SaveChaosHub(ctx context.Context, projectID string, request model.CreateChaosHubRequest) (*model.ChaosHub, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.SaveChaosHub],
+		authorization.SaveChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -45,7 +45,7 @@ func (r *mutationResolver) SaveChaosHub(ctx context.Context, projectID string, r
 
 func (r *mutationResolver) SyncChaosHub(ctx context.Context, id string, projectID string) (string, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateChaosWorkflow],
+		authorization.UpdateChaosWorkflow,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -67,7 +67,7 @@ func (r *mutationResolver) GenerateSSHKey(ctx context.Context) (*model.SSHKey, e
 
 func (r *mutationResolver) UpdateChaosHub(ctx context.Context, projectID string, request model.UpdateChaosHubRequest) (*model.ChaosHub, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateChaosHub],
+		authorization.UpdateChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -77,7 +77,7 @@ func (r *mutationResolver) UpdateChaosHub(ctx context.Context, projectID string,
 
 func (r *mutationResolver) DeleteChaosHub(ctx context.Context, projectID string, hubID string) (bool, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteChaosHub],
+		authorization.DeleteChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -87,7 +87,7 @@ func (r *mutationResolver) DeleteChaosHub(ctx context.Context, projectID string,
 
 func (r *queryResolver) ListChaosFaults(ctx context.Context, hubID string, projectID string) ([]*model.Chart, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListCharts],
+		authorization.ListCharts,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
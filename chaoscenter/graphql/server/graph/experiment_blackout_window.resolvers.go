@@ -0,0 +1,86 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *mutationResolver) CreateBlackoutWindow(ctx context.Context, projectID string, request model.BlackoutWindowRequest) (*model.BlackoutWindow, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to create blackout window")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.CreateBlackoutWindow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	window, err := r.blackoutWindowService.CreateBlackoutWindow(ctx, projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	r.blackoutWindowService.SyncBlackoutWindows(ctx, projectID, data_store.Store)
+	return window, nil
+}
+
+func (r *mutationResolver) UpdateBlackoutWindow(ctx context.Context, projectID string, windowID string, request model.BlackoutWindowRequest) (*model.BlackoutWindow, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"windowId":  windowID,
+	}
+	logrus.WithFields(logFields).Info("request received to update blackout window")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateBlackoutWindow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	window, err := r.blackoutWindowService.UpdateBlackoutWindow(ctx, projectID, windowID, request)
+	if err != nil {
+		return nil, err
+	}
+	r.blackoutWindowService.SyncBlackoutWindows(ctx, projectID, data_store.Store)
+	return window, nil
+}
+
+func (r *mutationResolver) DeleteBlackoutWindow(ctx context.Context, projectID string, windowID string) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"windowId":  windowID,
+	}
+	logrus.WithFields(logFields).Info("request received to delete blackout window")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.DeleteBlackoutWindow,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+	deleted, err := r.blackoutWindowService.DeleteBlackoutWindow(ctx, projectID, windowID)
+	if err != nil {
+		return false, err
+	}
+	r.blackoutWindowService.SyncBlackoutWindows(ctx, projectID, data_store.Store)
+	return deleted, nil
+}
+
+func (r *queryResolver) ListBlackoutWindows(ctx context.Context, projectID string, environmentID *string) ([]*model.BlackoutWindow, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list blackout windows")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListBlackoutWindows,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	return r.blackoutWindowService.ListBlackoutWindows(ctx, projectID, environmentID)
+}
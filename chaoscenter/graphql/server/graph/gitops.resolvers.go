@@ -11,18 +11,18 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-func (r *mutationResolver) GitopsNotifier(ctx context.Context, clusterInfo model.InfraIdentity, experimentID string) (string, error) {
+func (r *mutationResolver) GitopsNotifier(ctx context.Context, clusterInfo model.InfraIdentity, experimentID string, skipIfRunning *bool) (string, error) {
 	infra, err := r.chaosInfrastructureService.VerifyInfra(clusterInfo)
 	if err != nil {
 		logrus.Error("Validation failed : ", clusterInfo.InfraID)
 		return "Validation failed", err
 	}
-	return r.gitopsService.GitOpsNotificationHandler(ctx, *infra, experimentID)
+	return r.gitopsService.GitOpsNotificationHandler(ctx, *infra, experimentID, skipIfRunning != nil && *skipIfRunning)
 }
 
 func (r *mutationResolver) EnableGitOps(ctx context.Context, configurations model.GitConfig) (bool, error) {
 	err := authorization.ValidateRole(ctx, configurations.ProjectID,
-		authorization.MutationRbacRules[authorization.EnableGitOps],
+		authorization.EnableGitOps,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -30,19 +30,19 @@ func (r *mutationResolver) EnableGitOps(ctx context.Context, configurations mode
 	return r.gitopsService.EnableGitOpsHandler(ctx, configurations)
 }
 
-func (r *mutationResolver) DisableGitOps(ctx context.Context, projectID string) (bool, error) {
+func (r *mutationResolver) DisableGitOps(ctx context.Context, projectID string, configID *string) (bool, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DisableGitOps],
+		authorization.DisableGitOps,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
 	}
-	return r.gitopsService.DisableGitOpsHandler(ctx, projectID)
+	return r.gitopsService.DisableGitOpsHandler(ctx, projectID, configID)
 }
 
 func (r *mutationResolver) UpdateGitOps(ctx context.Context, configurations model.GitConfig) (bool, error) {
 	err := authorization.ValidateRole(ctx, configurations.ProjectID,
-		authorization.MutationRbacRules[authorization.UpdateGitOps],
+		authorization.UpdateGitOps,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -50,13 +50,45 @@ func (r *mutationResolver) UpdateGitOps(ctx context.Context, configurations mode
 	return r.gitopsService.UpdateGitOpsDetailsHandler(ctx, configurations)
 }
 
-func (r *queryResolver) GetGitOpsDetails(ctx context.Context, projectID string) (*model.GitConfigResponse, error) {
+func (r *mutationResolver) ResolveGitOpsDrift(ctx context.Context, projectID string, experimentID string, resolution model.GitOpsDriftResolution) (*model.GitOpsDrift, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetGitOpsDetails],
+		authorization.ResolveGitOpsDrift,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	return r.gitopsService.ResolveGitOpsDrift(ctx, projectID, experimentID, resolution)
+}
+
+func (r *queryResolver) GetGitOpsDetails(ctx context.Context, projectID string, configID *string) (*model.GitConfigResponse, error) {
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetGitOpsDetails,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.gitopsService.GetGitOpsDetails(ctx, projectID, configID)
+}
+
+func (r *queryResolver) ListGitOpsConfigs(ctx context.Context, projectID string) ([]*model.GitConfigResponse, error) {
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetGitOpsDetails,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.gitopsService.ListGitOpsConfigs(ctx, projectID)
+}
+
+func (r *queryResolver) GetGitOpsDriftReport(ctx context.Context, projectID string, configID *string) (*model.GitOpsDriftReport, error) {
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetGitOpsDriftReport,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
 	}
 
-	return r.gitopsService.GetGitOpsDetails(ctx, projectID)
+	return r.gitopsService.GetGitOpsDriftReport(ctx, projectID, configID)
 }
@@ -13,7 +13,7 @@ import (
 
 func (r *mutationResolver) CreateImageRegistry(ctx context.Context, projectID string, imageRegistryInfo model.ImageRegistryInput) (*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateImageRegistry],
+		authorization.CreateImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -28,7 +28,7 @@ func (r *mutationResolver) CreateImageRegistry(ctx context.Context, projectID st
 
 func (r *mutationResolver) UpdateImageRegistry(ctx context.Context, imageRegistryID string, projectID string, imageRegistryInfo model.ImageRegistryInput) (*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateImageRegistry],
+		authorization.UpdateImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -44,7 +44,7 @@ func (r *mutationResolver) UpdateImageRegistry(ctx context.Context, imageRegistr
 
 func (r *mutationResolver) DeleteImageRegistry(ctx context.Context, imageRegistryID string, projectID string) (string, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteImageRegistry],
+		authorization.DeleteImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -60,7 +60,7 @@ func (r *mutationResolver) DeleteImageRegistry(ctx context.Context, imageRegistr
 
 func (r *queryResolver) ListImageRegistry(ctx context.Context, projectID string) ([]*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListImageRegistry],
+		authorization.ListImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -76,7 +76,7 @@ func (r *queryResolver) ListImageRegistry(ctx context.Context, projectID string)
 
 func (r *queryResolver) GetImageRegistry(ctx context.Context, imageRegistryID string, projectID string) (*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetImageRegistry],
+		authorization.GetImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
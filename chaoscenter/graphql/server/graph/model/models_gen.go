@@ -23,6 +23,8 @@ type ActionPayload struct {
 	Namespace    string  `json:"namespace"`
 	ExternalData *string `json:"externalData"`
 	Username     *string `json:"username"`
+	// W3C trace context of the request which triggered the action, encoded as a JSON object
+	TraceContext *string `json:"traceContext"`
 }
 
 type Annotation struct {
@@ -34,6 +36,110 @@ type Annotation struct {
 	ChartDescription string `json:"chartDescription"`
 }
 
+// Defines an operation performed by a user, audit events are append only
+type AuditEvent struct {
+	// ID of the audit event
+	EventID string `json:"eventID"`
+	// ID of the project the operation was performed in, empty for operations outside a project
+	ProjectID *string `json:"projectID"`
+	// Time at which the operation was performed in milliseconds
+	Timestamp string `json:"timestamp"`
+	// User who performed the operation
+	Actor *UserDetails `json:"actor"`
+	// Name of the operation, the name of the mutation for operations performed through the GraphQL API
+	Action string `json:"action"`
+	// Type of the resource the operation was performed on
+	ResourceType string `json:"resourceType"`
+	// ID of the resource the operation was performed on
+	ResourceID *string `json:"resourceID"`
+	// Outcome of the operation
+	Outcome AuditEventOutcome `json:"outcome"`
+	// Error message of the failed operation
+	Error *string `json:"error"`
+	// Service which recorded the operation
+	Source string `json:"source"`
+}
+
+type AuditEventFilterInput struct {
+	// Username or user ID of the user who performed the operation
+	Actor *string `json:"actor"`
+	// Type of the resource the operation was performed on
+	ResourceType *string `json:"resourceType"`
+	// ID of the resource the operation was performed on
+	ResourceID *string `json:"resourceID"`
+	// Start of the time range in milliseconds
+	StartTime *string `json:"startTime"`
+	// End of the time range in milliseconds
+	EndTime *string `json:"endTime"`
+}
+
+// Defines a period during which no fault is injected, experiments can't be run and the cron
+// experiments of the chaos infrastructures are suspended while a blackout window is in effect
+type BlackoutWindow struct {
+	// ID of the blackout window
+	WindowID string `json:"windowID"`
+	// ID of the project
+	ProjectID string `json:"projectID"`
+	// ID of the environment the window applies to, the window applies to every environment of the project if not set
+	EnvironmentID *string `json:"environmentID"`
+	// Name of the blackout window
+	Name string `json:"name"`
+	// Description of the blackout window
+	Description *string `json:"description"`
+	// IANA name of the timezone the times of the window are defined in
+	Timezone string `json:"timezone"`
+	// Type of the blackout window
+	Type BlackoutWindowType `json:"type"`
+	// Start time of a one-off window in milliseconds
+	StartTime *string `json:"startTime"`
+	// End time of a one-off window in milliseconds
+	EndTime *string `json:"endTime"`
+	// Weekdays a recurring window starts on
+	Weekdays []Weekday `json:"weekdays"`
+	// Time of the day a recurring window starts at in HH:MM format
+	DailyStartTime *string `json:"dailyStartTime"`
+	// Time of the day a recurring window ends at in HH:MM format, the window ends on the next day
+	// if it is not after the daily start time
+	DailyEndTime *string `json:"dailyEndTime"`
+	// Bool value indicating whether the window is currently in effect
+	IsActive bool `json:"isActive"`
+	// Timestamp when the blackout window was created
+	CreatedAt *string `json:"createdAt"`
+	// User who created the blackout window
+	CreatedBy *UserDetails `json:"createdBy"`
+	// Timestamp when the blackout window was last updated
+	UpdatedAt *string `json:"updatedAt"`
+	// User who last updated the blackout window
+	UpdatedBy *UserDetails `json:"updatedBy"`
+}
+
+func (BlackoutWindow) IsAudit() {}
+
+// Defines the details of a blackout window
+type BlackoutWindowRequest struct {
+	// ID of the environment the window applies to, the window applies to every environment of the project if not set
+	EnvironmentID *string `json:"environmentID"`
+	// Name of the blackout window
+	Name string `json:"name"`
+	// Description of the blackout window
+	Description *string `json:"description"`
+	// IANA name of the timezone the times of the window are defined in
+	Timezone string `json:"timezone"`
+	// Type of the blackout window
+	Type BlackoutWindowType `json:"type"`
+	// Start time of a one-off window in milliseconds
+	StartTime *string `json:"startTime"`
+	// End time of a one-off window in milliseconds
+	EndTime *string `json:"endTime"`
+	// Weekdays a recurring window starts on
+	Weekdays []Weekday `json:"weekdays"`
+	// Time of the day a recurring window starts at in HH:MM format
+	DailyStartTime *string `json:"dailyStartTime"`
+	// Time of the day a recurring window ends at in HH:MM format, the window ends on the next day
+	// if it is not after the daily start time
+	DailyEndTime *string `json:"dailyEndTime"`
+}
+
 // Defines the details for a chaos experiment
 type ChaosExperimentRequest struct {
 	// ID of the experiment
@@ -52,6 +158,8 @@ type ChaosExperimentRequest struct {
 	ExperimentDescription string `json:"experimentDescription"`
 	// Array containing weightage and name of each chaos experiment in the experiment
 	Weightages []*WeightagesInput `json:"weightages"`
+	// Policy used to calculate the resiliency score of the experiment runs
+	ScoringPolicy *ScoringPolicyInput `json:"scoringPolicy"`
 	// Bool value indicating whether the experiment is a custom experiment or not
 	IsCustomExperiment bool `json:"isCustomExperiment"`
 	// ID of the target infra in which the experiment will run
@@ -218,6 +326,22 @@ type CloningInput struct {
 	IsDefault     bool    `json:"isDefault"`
 }
 
+// Defines the summary of a compared experiment run
+type ComparedExperimentRun struct {
+	// ID of the experiment run
+	ExperimentRunID string `json:"experimentRunID"`
+	// ID of the experiment
+	ExperimentID string `json:"experimentID"`
+	// ID of the experiment revision used by the run
+	RevisionID string `json:"revisionID"`
+	// Phase of the experiment run
+	Phase ExperimentRunStatus `json:"phase"`
+	// Resiliency score of the experiment run
+	ResiliencyScore *float64 `json:"resiliencyScore"`
+	// Duration of the experiment run in seconds, unset while it is running
+	Duration *int `json:"duration"`
+}
+
 type ConfirmInfraRegistrationResponse struct {
 	IsInfraConfirmed bool    `json:"isInfraConfirmed"`
 	NewAccessKey     *string `json:"newAccessKey"`
@@ -279,6 +403,16 @@ type DateRange struct {
 	EndDate *string `json:"endDate"`
 }
 
+// Defines the result of dry-running the manifests of an experiment on the infra
+type DryRunResultRequest struct {
+	// Unique request ID of the dry-run request
+	RequestID string `json:"requestID"`
+	// ID of the infra
+	InfraID *InfraIdentity `json:"infraID"`
+	// JSON array of the dry-run results of the manifests
+	Results string `json:"results"`
+}
+
 type Environment struct {
 	ProjectID     string          `json:"projectID"`
 	EnvironmentID string          `json:"environmentID"`
@@ -317,6 +451,20 @@ type EnvironmentSortInput struct {
 	Ascending *bool `json:"ascending"`
 }
 
+// Defines the resource event which triggered an experiment run
+type EventContext struct {
+	// Name of the event-tracker policy whose conditions passed
+	PolicyName string `json:"policyName"`
+	// Kind of the updated resource
+	ResourceKind string `json:"resourceKind"`
+	// Name of the updated resource
+	ResourceName string `json:"resourceName"`
+	// Namespace of the updated resource
+	ResourceNamespace *string `json:"resourceNamespace"`
+	// Changes of the resource which passed the conditions of the policy
+	Diff *string `json:"diff"`
+}
+
 // Defines the details for a experiment
 type Experiment struct {
 	ProjectID string `json:"projectID"`
@@ -334,6 +482,8 @@ type Experiment struct {
 	Description string `json:"description"`
 	// Array containing weightage and name of each chaos fault in the experiment
 	Weightages []*Weightages `json:"weightages"`
+	// Policy used to calculate the resiliency score of the experiment runs
+	ScoringPolicy *ScoringPolicy `json:"scoringPolicy"`
 	// Bool value indicating whether the experiment is a custom experiment or not
 	IsCustomExperiment bool `json:"isCustomExperiment"`
 	// Timestamp when the experiment was last updated
@@ -352,6 +502,10 @@ type Experiment struct {
 	RecentExperimentRunDetails []*RecentExperimentRun `json:"recentExperimentRunDetails"`
 	// Details of the user who updated the experiment
 	UpdatedBy *UserDetails `json:"updatedBy"`
+	// Pull request of the last change of the experiment, set while the pull request is pending review
+	PendingReview *PendingReview `json:"pendingReview"`
+	// Error of the last write of the experiment to the gitops repository, null once it succeeds
+	GitSyncError *string `json:"gitSyncError"`
 }
 
 func (Experiment) IsResourceDetails() {}
@@ -364,6 +518,34 @@ type ExperimentDetails struct {
 	ExperimentDetails string `json:"experimentDetails"`
 }
 
+// Defines the details of the resource event for which an event-tracker policy triggers an experiment
+type ExperimentEventTriggerRequest struct {
+	// ID of the experiment to be run
+	ExperimentID string `json:"experimentID"`
+	// Name of the event-tracker policy whose conditions passed
+	PolicyName string `json:"policyName"`
+	// Kind of the updated resource
+	ResourceKind string `json:"resourceKind"`
+	// Name of the updated resource
+	ResourceName string `json:"resourceName"`
+	// Namespace of the updated resource
+	ResourceNamespace *string `json:"resourceNamespace"`
+	// Changes of the resource which passed the conditions of the policy
+	Diff *string `json:"diff"`
+	// Bool value indicating if the trigger is skipped when a run of the experiment is in progress
+	SkipIfRunning *bool `json:"skipIfRunning"`
+}
+
+// Defines the response of an experiment triggered by an event-tracker policy
+type ExperimentEventTriggerResponse struct {
+	// Notify ID of the experiment run, empty when the trigger is skipped
+	NotifyID *string `json:"notifyID"`
+	// Bool value indicating if the trigger has been skipped
+	Skipped bool `json:"skipped"`
+	// Reason for which the trigger has been skipped
+	Reason *string `json:"reason"`
+}
+
 // Defines filter options for experiments
 type ExperimentFilterInput struct {
 	// Name of the experiment
@@ -438,10 +620,22 @@ type ExperimentRun struct {
 	UpdatedBy *UserDetails `json:"updatedBy"`
 	// User who has created the experiment run
 	CreatedBy *UserDetails `json:"createdBy"`
+	// Details of the resource event which triggered the experiment run, set for the runs triggered by event-tracker policies
+	EventContext *EventContext `json:"eventContext"`
 }
 
 func (ExperimentRun) IsAudit() {}
 
+// Defines the comparison of experiment runs
+type ExperimentRunComparison struct {
+	// Compared experiment runs, in the requested order
+	ExperimentRuns []*ComparedExperimentRun `json:"experimentRuns"`
+	// Results of each fault executed in at least one of the experiment runs
+	Faults []*FaultComparison `json:"faults"`
+	// Manifest changes between the revisions used by consecutive experiment runs
+	ManifestDiffs []*RevisionManifestDiff `json:"manifestDiffs"`
+}
+
 // Defines input type for experiment run filter
 type ExperimentRunFilterInput struct {
 	// Name of the experiment
@@ -462,6 +656,26 @@ type ExperimentRunFilterInput struct {
 	InfraTypes []*InfrastructureType `json:"infraTypes"`
 }
 
+// Defines the thresholds a completed experiment run has to meet to pass the gate
+type ExperimentRunGateInput struct {
+	// Minimum resiliency score of the experiment run
+	MinResiliencyScore *float64 `json:"minResiliencyScore"`
+	// Maximum number of faults allowed to fail
+	MaxFaultsFailed *int `json:"maxFaultsFailed"`
+	// Names of the faults which must have passed
+	RequiredFaults []string `json:"requiredFaults"`
+}
+
+// Defines the result of evaluating an experiment run against a gate
+type ExperimentRunGateResponse struct {
+	// Verdict of the gate
+	Verdict GateVerdict `json:"verdict"`
+	// Reasons for which the gate failed
+	Reasons []string `json:"reasons"`
+	// Experiment run triggered with the notifyID
+	ExperimentRun *ExperimentRun `json:"experimentRun"`
+}
+
 // Defines the details for a experiment run
 type ExperimentRunRequest struct {
 	// ID of the experiment
@@ -478,6 +692,8 @@ type ExperimentRunRequest struct {
 	InfraID *InfraIdentity `json:"infraID"`
 	// ID of the revision which consists manifest details
 	RevisionID string `json:"revisionID"`
+	// ID of the event, events replaying the last processed event of the experiment run are acknowledged without being processed again
+	EventID *string `json:"eventID"`
 	// Bool value indicating if the experiment run has completed
 	Completed bool `json:"completed"`
 	// Bool value indicating if the experiment run has removed
@@ -502,12 +718,48 @@ type ExperimentSortInput struct {
 	Ascending *bool `json:"ascending"`
 }
 
+// Defines an issue found while validating an experiment manifest
+type ExperimentValidationIssue struct {
+	// Severity of the issue, experiments with errors fail when they are run
+	Severity ValidationSeverity `json:"severity"`
+	// Path of the object in the manifest which has the issue
+	Path string `json:"path"`
+	// Description of the issue
+	Message string `json:"message"`
+}
+
+// Defines the result of validating an experiment manifest
+type ExperimentValidationResponse struct {
+	// Bool value indicating if the manifest has no errors
+	IsValid bool `json:"isValid"`
+	// Kind of the experiment manifest
+	Kind string `json:"kind"`
+	// Faults referenced by the chaos engines of the experiment
+	Faults []string `json:"faults"`
+	// Issues found in the manifest
+	Issues []*ExperimentValidationIssue `json:"issues"`
+	// Bool value indicating if the manifests were dry-run on the infra
+	DryRunPerformed bool `json:"dryRunPerformed"`
+}
+
 type Experiments struct {
 	Name string `json:"name"`
 	Csv  string `json:"CSV"`
 	Desc string `json:"desc"`
 }
 
+// Defines the results of a fault across the compared experiment runs
+type FaultComparison struct {
+	// Name of the fault
+	FaultName string `json:"faultName"`
+	// Results of the fault, one per experiment run in the requested order
+	Results []*FaultRunResult `json:"results"`
+	// Bool value indicating if the verdict of the fault differs between the experiment runs
+	VerdictChanged bool `json:"verdictChanged"`
+	// Change of the probe success percentage between the first and the last experiment run executing the fault
+	ProbeSuccessPercentageDelta *float64 `json:"probeSuccessPercentageDelta"`
+}
+
 // Fault Detail consists of all the fault related details
 type FaultDetails struct {
 	// fault consists of fault.yaml
@@ -525,6 +777,36 @@ type FaultList struct {
 	Plan        []string `json:"plan"`
 }
 
+// Defines the result of a fault in an experiment run
+type FaultRunResult struct {
+	// ID of the experiment run
+	ExperimentRunID string `json:"experimentRunID"`
+	// Bool value indicating if the fault was executed in the experiment run
+	Executed bool `json:"executed"`
+	// Verdict of the fault
+	Verdict *string `json:"verdict"`
+	// Probe success percentage of the fault
+	ProbeSuccessPercentage *float64 `json:"probeSuccessPercentage"`
+	// Duration of the fault in seconds, unset while it is running
+	Duration *int `json:"duration"`
+}
+
+// Defines the minimum probe success percentage a fault needs to be considered as passed
+type FaultThreshold struct {
+	// Name of the fault
+	FaultName string `json:"faultName"`
+	// Minimum probe success percentage of the fault
+	ProbeSuccessPercentage int `json:"probeSuccessPercentage"`
+}
+
+// Defines the minimum probe success percentage a fault needs to be considered as passed
+type FaultThresholdInput struct {
+	// Name of the fault
+	FaultName string `json:"faultName"`
+	// Minimum probe success percentage of the fault
+	ProbeSuccessPercentage int `json:"probeSuccessPercentage"`
+}
+
 type GetChaosHubStatsResponse struct {
 	// Total number of chaoshubs
 	TotalChaosHubs int `json:"totalChaosHubs"`
@@ -577,6 +859,16 @@ type GetInfraStatsResponse struct {
 type GitConfig struct {
 	// ID of the project where GitOps is configured
 	ProjectID string `json:"projectID"`
+	// ID of the GitOps configuration to update, a new configuration is added to the project when enabling GitOps
+	ConfigID *string `json:"configID"`
+	// Name of the GitOps configuration
+	Name *string `json:"name"`
+	// Directory of the repository holding the experiments, litmus/<projectID> by default
+	Path *string `json:"path"`
+	// ID of the environment whose experiments are synced with the repository
+	EnvironmentID *string `json:"environmentID"`
+	// ID of the infra whose experiments are synced with the repository, it takes precedence over the environment
+	InfraID *string `json:"infraID"`
 	// Git branch where the chaos charts will be pushed and synced
 	Branch string `json:"branch"`
 	// URL of the Git repository
@@ -591,6 +883,18 @@ type GitConfig struct {
 	Password *string `json:"password"`
 	// Private SSH key authenticating into git repository
 	SSHPrivateKey *string `json:"sshPrivateKey"`
+	// How the experiments edited in ChaosCenter are written to git: PUSH only commits the existing experiments to the
+	// branch when the gitops is enabled, the later edits not being written back, and PULL_REQUEST opens a pull request
+	// against the branch for every edit, PUSH by default
+	WriteMode *GitWriteMode `json:"writeMode"`
+	// Git provider on which the pull requests are opened
+	Provider *GitProvider `json:"provider"`
+	// Base URL of the API of the git provider, derived from the repository URL if not provided, it must resolve to a
+	// public address
+	ProviderURL *string `json:"providerURL"`
+	// Secret verifying the push webhooks of the repository, the webhooks are rejected if it isn't set. It is kept when
+	// the config is updated without it and removed if empty
+	WebhookSecret *string `json:"webhookSecret"`
 }
 
 // Response received after configuring GitOps
@@ -599,6 +903,16 @@ type GitConfigResponse struct {
 	Enabled bool `json:"enabled"`
 	// ID of the project where GitOps is configured
 	ProjectID string `json:"projectID"`
+	// ID of the GitOps configuration
+	ConfigID *string `json:"configID"`
+	// Name of the GitOps configuration
+	Name *string `json:"name"`
+	// Directory of the repository holding the experiments
+	Path *string `json:"path"`
+	// ID of the environment whose experiments are synced with the repository
+	EnvironmentID *string `json:"environmentID"`
+	// ID of the infra whose experiments are synced with the repository
+	InfraID *string `json:"infraID"`
 	// Git branch where the chaos charts will be pushed and synced
 	Branch *string `json:"branch"`
 	// URL of the Git repository
@@ -613,6 +927,43 @@ type GitConfigResponse struct {
 	Password *string `json:"password"`
 	// Private SSH key authenticating into git repository
 	SSHPrivateKey *string `json:"sshPrivateKey"`
+	// How the experiments edited in ChaosCenter are written to git: PUSH only commits the existing experiments to the
+	// branch when the gitops is enabled, the later edits not being written back, and PULL_REQUEST opens a pull request
+	// against the branch for every edit, PUSH by default
+	WriteMode *GitWriteMode `json:"writeMode"`
+	// Git provider on which the pull requests are opened
+	Provider *GitProvider `json:"provider"`
+	// Base URL of the API of the git provider, derived from the repository URL if not provided, it must resolve to a
+	// public address
+	ProviderURL *string `json:"providerURL"`
+	// Whether a secret verifying the push webhooks of the repository is set, the secret itself is never returned
+	WebhookSecretSet bool `json:"webhookSecretSet"`
+}
+
+// Drift of an experiment between the DB and the gitops repository
+type GitOpsDrift struct {
+	// ID of the experiment
+	ExperimentID string `json:"experimentID"`
+	// Name of the experiment
+	ExperimentName string `json:"experimentName"`
+	// Drift status of the experiment
+	Status GitOpsDriftStatus `json:"status"`
+	// Timestamp of the last sync of the experiment between the DB and the repository
+	LastSyncedAt *string `json:"lastSyncedAt"`
+	// Fields which differ between the manifest of the DB and the repository as path: db -> git
+	Diff *string `json:"diff"`
+}
+
+// Drift report of the experiments synced by a GitOps configuration against the head of its repository
+type GitOpsDriftReport struct {
+	// ID of the project where GitOps is configured
+	ProjectID string `json:"projectID"`
+	// ID of the GitOps configuration
+	ConfigID string `json:"configID"`
+	// Latest commit of the experiments directory in the repository
+	Commit string `json:"commit"`
+	// Drift of the experiments synced by the configuration
+	Experiments []*GitOpsDrift `json:"experiments"`
 }
 
 // Defines details for image registry
@@ -720,6 +1071,8 @@ type Infra struct {
 	LastExperimentTimestamp *string `json:"lastExperimentTimestamp"`
 	// Timestamp when the infra got connected
 	StartTime string `json:"startTime"`
+	// Timestamp of the last heartbeat received from the infra
+	LastHeartbeat *string `json:"lastHeartbeat"`
 	// Version of the infra
 	Version string `json:"version"`
 	// User who created the infra
@@ -827,6 +1180,20 @@ type Link struct {
 	URL  string `json:"url"`
 }
 
+type ListAuditEventsRequest struct {
+	// Details for fetching filtered data
+	Filter *AuditEventFilterInput `json:"filter"`
+	// Details for fetching paginated data
+	Pagination *Pagination `json:"pagination"`
+}
+
+type ListAuditEventsResponse struct {
+	// Total number of audit events matching the filter
+	TotalNoOfEvents int `json:"totalNoOfEvents"`
+	// Audit events, latest first
+	Events []*AuditEvent `json:"events"`
+}
+
 type ListChaosHubRequest struct {
 	// Array of ChaosHub IDs for which details will be fetched
 	ChaosHubIDs []string `json:"chaosHubIDs"`
@@ -913,6 +1280,22 @@ type ListInfraResponse struct {
 	Infras []*Infra `json:"infras"`
 }
 
+type ListNotificationDeliveriesRequest struct {
+	// ID of the notification rule to list the deliveries of
+	RuleID *string `json:"ruleID"`
+	// Status of the deliveries to list
+	Status *NotificationDeliveryStatus `json:"status"`
+	// Details for fetching paginated data
+	Pagination *Pagination `json:"pagination"`
+}
+
+type ListNotificationDeliveriesResponse struct {
+	// Total number of deliveries matching the request
+	TotalNoOfDeliveries int `json:"totalNoOfDeliveries"`
+	// Deliveries of the page, latest first
+	Deliveries []*NotificationDelivery `json:"deliveries"`
+}
+
 // Defines the details of the maintainer
 type Maintainer struct {
 	// Name of the maintainer
@@ -934,6 +1317,110 @@ type NewInfraEventRequest struct {
 	AccessKey   string `json:"accessKey"`
 }
 
+// Defines the destination of the notifications of a rule, the secret of the channel is never returned
+type NotificationChannel struct {
+	// Type of the channel
+	Type NotificationChannelType `json:"type"`
+	// URL of the webhook, not set for email channels
+	URL *string `json:"url"`
+	// Email addresses the notifications are sent to
+	Recipients []string `json:"recipients"`
+	// Bool value indicating whether the webhook payloads are signed
+	IsSigned bool `json:"isSigned"`
+}
+
+// Defines the destination of the notifications of a rule
+type NotificationChannelInput struct {
+	// Type of the channel
+	Type NotificationChannelType `json:"type"`
+	// URL of the webhook, required for every channel except email, the loopback, link-local and private addresses are rejected
+	URL *string `json:"url"`
+	// Secret the webhook payloads are signed with, the signature is sent in the X-Litmus-Signature header
+	Secret *string `json:"secret"`
+	// Email addresses the notifications are sent to, required for email channels
+	Recipients []string `json:"recipients"`
+}
+
+// Defines a notification sent, or to be sent, to the channel of a rule
+type NotificationDelivery struct {
+	// ID of the delivery
+	DeliveryID string `json:"deliveryID"`
+	// ID of the notification rule
+	RuleID string `json:"ruleID"`
+	// ID of the event, deliveries of the same event share it
+	EventID string `json:"eventID"`
+	// Type of the event
+	EventType NotificationEventType `json:"eventType"`
+	// Type of the channel
+	ChannelType NotificationChannelType `json:"channelType"`
+	// Status of the delivery
+	Status NotificationDeliveryStatus `json:"status"`
+	// Number of attempts made to send the notification
+	Attempts int `json:"attempts"`
+	// Error of the last failed attempt
+	LastError *string `json:"lastError"`
+	// Timestamp of the next attempt for pending deliveries
+	NextAttemptAt *string `json:"nextAttemptAt"`
+	// Timestamp when the delivery was created
+	CreatedAt string `json:"createdAt"`
+	// Timestamp when the delivery was last updated
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// Defines a project scoped rule sending the matching run lifecycle events to a channel
+type NotificationRule struct {
+	// ID of the notification rule
+	RuleID string `json:"ruleID"`
+	// ID of the project
+	ProjectID string `json:"projectID"`
+	// Name of the notification rule
+	Name string `json:"name"`
+	// Bool value indicating whether the rule sends notifications
+	Enabled bool `json:"enabled"`
+	// Types of the events matched by the rule
+	EventTypes []NotificationEventType `json:"eventTypes"`
+	// IDs of the experiments matched by the rule, every experiment is matched if not set
+	ExperimentIDs []string `json:"experimentIDs"`
+	// IDs of the environments matched by the rule, every environment is matched if not set
+	EnvironmentIDs []string `json:"environmentIDs"`
+	// Tags of the experiments matched by the rule, experiments with any of the tags are matched
+	Tags []string `json:"tags"`
+	// Resiliency score below which RESILIENCY_SCORE_BELOW_THRESHOLD events are sent
+	ScoreThreshold *float64 `json:"scoreThreshold"`
+	// Channel the notifications are sent to
+	Channel *NotificationChannel `json:"channel"`
+	// Timestamp when the notification rule was created
+	CreatedAt *string `json:"createdAt"`
+	// User who created the notification rule
+	CreatedBy *UserDetails `json:"createdBy"`
+	// Timestamp when the notification rule was last updated
+	UpdatedAt *string `json:"updatedAt"`
+	// User who last updated the notification rule
+	UpdatedBy *UserDetails `json:"updatedBy"`
+}
+
+func (NotificationRule) IsAudit() {}
+
+// Defines the details of a notification rule
+type NotificationRuleRequest struct {
+	// Name of the notification rule
+	Name string `json:"name"`
+	// Bool value indicating whether the rule sends notifications, enabled by default
+	Enabled *bool `json:"enabled"`
+	// Types of the events matched by the rule
+	EventTypes []NotificationEventType `json:"eventTypes"`
+	// IDs of the experiments matched by the rule, every experiment is matched if not set
+	ExperimentIDs []string `json:"experimentIDs"`
+	// IDs of the environments matched by the rule, every environment is matched if not set
+	EnvironmentIDs []string `json:"environmentIDs"`
+	// Tags of the experiments matched by the rule, experiments with any of the tags are matched
+	Tags []string `json:"tags"`
+	// Resiliency score below which RESILIENCY_SCORE_BELOW_THRESHOLD events are sent
+	ScoreThreshold *float64 `json:"scoreThreshold"`
+	// Channel the notifications are sent to
+	Channel *NotificationChannelInput `json:"channel"`
+}
+
 type ObjectData struct {
 	// Labels present in the resource
 	Labels []string `json:"labels"`
@@ -954,6 +1441,18 @@ type Pagination struct {
 	Limit int `json:"limit"`
 }
 
+// Defines the pull request opened for a change of an experiment in the pull request gitops mode
+type PendingReview struct {
+	// Number of the pull request, 0 for the local provider
+	Number int `json:"number"`
+	// URL of the pull request
+	URL string `json:"url"`
+	// Branch holding the change of the experiment
+	Branch string `json:"branch"`
+	// Timestamp when the pull request was opened
+	OpenedAt string `json:"openedAt"`
+}
+
 // Response received for querying pod logs
 type PodLog struct {
 	// ID of the cluster
@@ -968,6 +1467,8 @@ type PodLog struct {
 	PodType string `json:"podType"`
 	// Logs for the pod
 	Log string `json:"log"`
+	// Bool value indicating if this is the last chunk of logs of the request, unset for requests which aren't streamed
+	IsFinal *bool `json:"isFinal"`
 }
 
 // Defines the details for fetching the pod logs
@@ -988,6 +1489,14 @@ type PodLogRequest struct {
 	RunnerPod *string `json:"runnerPod"`
 	// Namespace where the experiment is executing
 	ChaosNamespace *string `json:"chaosNamespace"`
+	// Bool value indicating if the logs of the pod should be streamed as they are written
+	Follow *bool `json:"follow"`
+	// Number of lines from the end of the logs to start from
+	TailLines *int `json:"tailLines"`
+	// Timestamp in milliseconds from which the logs are returned
+	SinceTime *string `json:"sinceTime"`
+	// Container of the pod for which logs are required, defaults to the main container
+	Container *string `json:"container"`
 }
 
 // Defines the response received for querying querying the pod logs
@@ -1000,6 +1509,8 @@ type PodLogResponse struct {
 	PodType string `json:"podType"`
 	// Logs for the pod
 	Log string `json:"log"`
+	// Bool value indicating if this is the last chunk of logs of the request
+	IsFinal bool `json:"isFinal"`
 }
 
 type PredefinedExperimentList struct {
@@ -1085,6 +1596,16 @@ type ResilienceScoreCategory struct {
 	Count int `json:"count"`
 }
 
+// Defines the manifest changes between two experiment revisions
+type RevisionManifestDiff struct {
+	// ID of the revision used by the earlier experiment run
+	FromRevisionID string `json:"fromRevisionID"`
+	// ID of the revision used by the later experiment run
+	ToRevisionID string `json:"toRevisionID"`
+	// Unified diff of the experiment manifests
+	Diff string `json:"diff"`
+}
+
 type RunChaosExperimentResponse struct {
 	NotifyID string `json:"notifyID"`
 }
@@ -1115,6 +1636,22 @@ type SaveChaosExperimentRequest struct {
 	Tags []string `json:"tags"`
 }
 
+// Defines the policy used to calculate the resiliency score of the experiment runs
+type ScoringPolicy struct {
+	// Strategy used to aggregate the fault results
+	Strategy ScoringStrategy `json:"strategy"`
+	// Thresholds below which a fault is considered as failed
+	FaultThresholds []*FaultThreshold `json:"faultThresholds"`
+}
+
+// Defines the policy used to calculate the resiliency score of the experiment runs
+type ScoringPolicyInput struct {
+	// Strategy used to aggregate the fault results
+	Strategy ScoringStrategy `json:"strategy"`
+	// Thresholds below which a fault is considered as failed
+	FaultThresholds []*FaultThresholdInput `json:"faultThresholds"`
+}
+
 // Response received for fetching GQL server version
 type ServerVersionResponse struct {
 	// Returns server version key
@@ -1199,6 +1736,16 @@ type UserDetails struct {
 	Email    string `json:"email"`
 }
 
+// Defines the details for validating an experiment manifest
+type ValidateChaosExperimentRequest struct {
+	// Manifest of the experiment
+	Manifest string `json:"manifest"`
+	// ID of the infra on which the manifests are dry-run
+	InfraID *string `json:"infraID"`
+	// Bool value indicating if the manifests should be dry-run on the infra
+	DryRun *bool `json:"dryRun"`
+}
+
 // Defines the details of the weightages of each chaos fault in the experiment
 type Weightages struct {
 	// Name of the fault
@@ -1221,6 +1768,47 @@ type Workload struct {
 	Namespace string `json:"namespace"`
 }
 
+type AuditEventOutcome string
+
+const (
+	AuditEventOutcomeSuccess AuditEventOutcome = "SUCCESS"
+	AuditEventOutcomeFailure AuditEventOutcome = "FAILURE"
+)
+
+var AllAuditEventOutcome = []AuditEventOutcome{
+	AuditEventOutcomeSuccess,
+	AuditEventOutcomeFailure,
+}
+
+func (e AuditEventOutcome) IsValid() bool {
+	switch e {
+	case AuditEventOutcomeSuccess, AuditEventOutcomeFailure:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventOutcome) String() string {
+	return string(e)
+}
+
+func (e *AuditEventOutcome) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventOutcome(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventOutcome", str)
+	}
+	return nil
+}
+
+func (e AuditEventOutcome) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type AuthType string
 
 const (
@@ -1266,6 +1854,49 @@ func (e AuthType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type BlackoutWindowType string
+
+const (
+	// Window in effect once between its start and end time
+	BlackoutWindowTypeOneOff BlackoutWindowType = "ONE_OFF"
+	// Window in effect every week on its weekdays between its daily start and end time
+	BlackoutWindowTypeRecurring BlackoutWindowType = "RECURRING"
+)
+
+var AllBlackoutWindowType = []BlackoutWindowType{
+	BlackoutWindowTypeOneOff,
+	BlackoutWindowTypeRecurring,
+}
+
+func (e BlackoutWindowType) IsValid() bool {
+	switch e {
+	case BlackoutWindowTypeOneOff, BlackoutWindowTypeRecurring:
+		return true
+	}
+	return false
+}
+
+func (e BlackoutWindowType) String() string {
+	return string(e)
+}
+
+func (e *BlackoutWindowType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BlackoutWindowType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BlackoutWindowType", str)
+	}
+	return nil
+}
+
+func (e BlackoutWindowType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type EnvironmentSortingField string
 
 const (
@@ -1536,6 +2167,235 @@ func (e FileType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type GateVerdict string
+
+const (
+	GateVerdictPass GateVerdict = "PASS"
+	GateVerdictFail GateVerdict = "FAIL"
+	// The experiment run did not complete within the timeout
+	GateVerdictTimeout GateVerdict = "TIMEOUT"
+)
+
+var AllGateVerdict = []GateVerdict{
+	GateVerdictPass,
+	GateVerdictFail,
+	GateVerdictTimeout,
+}
+
+func (e GateVerdict) IsValid() bool {
+	switch e {
+	case GateVerdictPass, GateVerdictFail, GateVerdictTimeout:
+		return true
+	}
+	return false
+}
+
+func (e GateVerdict) String() string {
+	return string(e)
+}
+
+func (e *GateVerdict) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GateVerdict(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GateVerdict", str)
+	}
+	return nil
+}
+
+func (e GateVerdict) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines which side wins when resolving the drift of an experiment
+type GitOpsDriftResolution string
+
+const (
+	// Manifest of the DB is written to the repository
+	GitOpsDriftResolutionUseDb GitOpsDriftResolution = "USE_DB"
+	// Manifest of the repository is applied to the DB, the experiment is deleted if it isn't present in the repository
+	GitOpsDriftResolutionUseGit GitOpsDriftResolution = "USE_GIT"
+)
+
+var AllGitOpsDriftResolution = []GitOpsDriftResolution{
+	GitOpsDriftResolutionUseDb,
+	GitOpsDriftResolutionUseGit,
+}
+
+func (e GitOpsDriftResolution) IsValid() bool {
+	switch e {
+	case GitOpsDriftResolutionUseDb, GitOpsDriftResolutionUseGit:
+		return true
+	}
+	return false
+}
+
+func (e GitOpsDriftResolution) String() string {
+	return string(e)
+}
+
+func (e *GitOpsDriftResolution) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GitOpsDriftResolution(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GitOpsDriftResolution", str)
+	}
+	return nil
+}
+
+func (e GitOpsDriftResolution) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the drift of an experiment between the DB and the gitops repository
+type GitOpsDriftStatus string
+
+const (
+	// Manifest of the DB and the repository are the same
+	GitOpsDriftStatusInSync GitOpsDriftStatus = "IN_SYNC"
+	// Manifest has been changed in ChaosCenter since the last sync
+	GitOpsDriftStatusDbChanged GitOpsDriftStatus = "DB_CHANGED"
+	// Manifest has been changed in the repository since the last sync
+	GitOpsDriftStatusGitChanged GitOpsDriftStatus = "GIT_CHANGED"
+	// Manifest has been changed on both sides since the last sync, or differs while the experiment has never been synced
+	GitOpsDriftStatusConflict GitOpsDriftStatus = "CONFLICT"
+	// Experiment isn't present in the repository
+	GitOpsDriftStatusMissingInGit GitOpsDriftStatus = "MISSING_IN_GIT"
+)
+
+var AllGitOpsDriftStatus = []GitOpsDriftStatus{
+	GitOpsDriftStatusInSync,
+	GitOpsDriftStatusDbChanged,
+	GitOpsDriftStatusGitChanged,
+	GitOpsDriftStatusConflict,
+	GitOpsDriftStatusMissingInGit,
+}
+
+func (e GitOpsDriftStatus) IsValid() bool {
+	switch e {
+	case GitOpsDriftStatusInSync, GitOpsDriftStatusDbChanged, GitOpsDriftStatusGitChanged, GitOpsDriftStatusConflict, GitOpsDriftStatusMissingInGit:
+		return true
+	}
+	return false
+}
+
+func (e GitOpsDriftStatus) String() string {
+	return string(e)
+}
+
+func (e *GitOpsDriftStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GitOpsDriftStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GitOpsDriftStatus", str)
+	}
+	return nil
+}
+
+func (e GitOpsDriftStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the git providers on which pull requests can be opened, LOCAL is a bare repository on the disk of the server
+type GitProvider string
+
+const (
+	GitProviderGithub GitProvider = "GITHUB"
+	GitProviderGitlab GitProvider = "GITLAB"
+	GitProviderGitea  GitProvider = "GITEA"
+	GitProviderLocal  GitProvider = "LOCAL"
+)
+
+var AllGitProvider = []GitProvider{
+	GitProviderGithub,
+	GitProviderGitlab,
+	GitProviderGitea,
+	GitProviderLocal,
+}
+
+func (e GitProvider) IsValid() bool {
+	switch e {
+	case GitProviderGithub, GitProviderGitlab, GitProviderGitea, GitProviderLocal:
+		return true
+	}
+	return false
+}
+
+func (e GitProvider) String() string {
+	return string(e)
+}
+
+func (e *GitProvider) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GitProvider(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GitProvider", str)
+	}
+	return nil
+}
+
+func (e GitProvider) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines how the experiments edited in ChaosCenter are written to git
+type GitWriteMode string
+
+const (
+	GitWriteModePush        GitWriteMode = "PUSH"
+	GitWriteModePullRequest GitWriteMode = "PULL_REQUEST"
+)
+
+var AllGitWriteMode = []GitWriteMode{
+	GitWriteModePush,
+	GitWriteModePullRequest,
+}
+
+func (e GitWriteMode) IsValid() bool {
+	switch e {
+	case GitWriteModePush, GitWriteModePullRequest:
+		return true
+	}
+	return false
+}
+
+func (e GitWriteMode) String() string {
+	return string(e)
+}
+
+func (e *GitWriteMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = GitWriteMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid GitWriteMode", str)
+	}
+	return nil
+}
+
+func (e GitWriteMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type HubType string
 
 const (
@@ -1743,6 +2603,153 @@ func (e MemberRole) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type NotificationChannelType string
+
+const (
+	// Generic webhook receiving the event as JSON, signed with HMAC-SHA256 if a secret is set
+	NotificationChannelTypeWebhook NotificationChannelType = "WEBHOOK"
+	// Slack compatible incoming webhook
+	NotificationChannelTypeSLACk NotificationChannelType = "SLACK"
+	// Email sent through the SMTP server of the control plane
+	NotificationChannelTypeEmail NotificationChannelType = "EMAIL"
+	// Microsoft Teams incoming webhook
+	NotificationChannelTypeTeams NotificationChannelType = "TEAMS"
+)
+
+var AllNotificationChannelType = []NotificationChannelType{
+	NotificationChannelTypeWebhook,
+	NotificationChannelTypeSLACk,
+	NotificationChannelTypeEmail,
+	NotificationChannelTypeTeams,
+}
+
+func (e NotificationChannelType) IsValid() bool {
+	switch e {
+	case NotificationChannelTypeWebhook, NotificationChannelTypeSLACk, NotificationChannelTypeEmail, NotificationChannelTypeTeams:
+		return true
+	}
+	return false
+}
+
+func (e NotificationChannelType) String() string {
+	return string(e)
+}
+
+func (e *NotificationChannelType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationChannelType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationChannelType", str)
+	}
+	return nil
+}
+
+func (e NotificationChannelType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryStatusPending   NotificationDeliveryStatus = "PENDING"
+	NotificationDeliveryStatusDelivered NotificationDeliveryStatus = "DELIVERED"
+	NotificationDeliveryStatusFailed    NotificationDeliveryStatus = "FAILED"
+)
+
+var AllNotificationDeliveryStatus = []NotificationDeliveryStatus{
+	NotificationDeliveryStatusPending,
+	NotificationDeliveryStatusDelivered,
+	NotificationDeliveryStatusFailed,
+}
+
+func (e NotificationDeliveryStatus) IsValid() bool {
+	switch e {
+	case NotificationDeliveryStatusPending, NotificationDeliveryStatusDelivered, NotificationDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e NotificationDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *NotificationDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e NotificationDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotificationEventType string
+
+const (
+	// An experiment run started on its chaos infrastructure
+	NotificationEventTypeExperimentRunStarted NotificationEventType = "EXPERIMENT_RUN_STARTED"
+	// An experiment run completed
+	NotificationEventTypeExperimentRunCompleted NotificationEventType = "EXPERIMENT_RUN_COMPLETED"
+	// An experiment run failed, errored or was stopped
+	NotificationEventTypeExperimentRunFailed NotificationEventType = "EXPERIMENT_RUN_FAILED"
+	// An experiment run completed with a resiliency score below the score threshold of the rule
+	NotificationEventTypeResiliencyScoreBelowThreshold NotificationEventType = "RESILIENCY_SCORE_BELOW_THRESHOLD"
+	// A chaos infrastructure connected to the control plane
+	NotificationEventTypeInfraConnected NotificationEventType = "INFRA_CONNECTED"
+	// A chaos infrastructure disconnected from the control plane
+	NotificationEventTypeInfraDisconnected NotificationEventType = "INFRA_DISCONNECTED"
+)
+
+var AllNotificationEventType = []NotificationEventType{
+	NotificationEventTypeExperimentRunStarted,
+	NotificationEventTypeExperimentRunCompleted,
+	NotificationEventTypeExperimentRunFailed,
+	NotificationEventTypeResiliencyScoreBelowThreshold,
+	NotificationEventTypeInfraConnected,
+	NotificationEventTypeInfraDisconnected,
+}
+
+func (e NotificationEventType) IsValid() bool {
+	switch e {
+	case NotificationEventTypeExperimentRunStarted, NotificationEventTypeExperimentRunCompleted, NotificationEventTypeExperimentRunFailed, NotificationEventTypeResiliencyScoreBelowThreshold, NotificationEventTypeInfraConnected, NotificationEventTypeInfraDisconnected:
+		return true
+	}
+	return false
+}
+
+func (e NotificationEventType) String() string {
+	return string(e)
+}
+
+func (e *NotificationEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationEventType", str)
+	}
+	return nil
+}
+
+func (e NotificationEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ScheduleType string
 
 const (
@@ -1786,6 +2793,53 @@ func (e ScheduleType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Defines how the resiliency score of an experiment run is aggregated from its faults
+type ScoringStrategy string
+
+const (
+	// Mean of the probe success percentage of each fault weighted by its weightage
+	ScoringStrategyWeightedMean ScoringStrategy = "WEIGHTED_MEAN"
+	// Lowest probe success percentage among the faults
+	ScoringStrategyMinimum ScoringStrategy = "MINIMUM"
+	// 100 if every fault passed, 0 otherwise
+	ScoringStrategyAllMustPass ScoringStrategy = "ALL_MUST_PASS"
+)
+
+var AllScoringStrategy = []ScoringStrategy{
+	ScoringStrategyWeightedMean,
+	ScoringStrategyMinimum,
+	ScoringStrategyAllMustPass,
+}
+
+func (e ScoringStrategy) IsValid() bool {
+	switch e {
+	case ScoringStrategyWeightedMean, ScoringStrategyMinimum, ScoringStrategyAllMustPass:
+		return true
+	}
+	return false
+}
+
+func (e ScoringStrategy) String() string {
+	return string(e)
+}
+
+func (e *ScoringStrategy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScoringStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScoringStrategy", str)
+	}
+	return nil
+}
+
+func (e ScoringStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 // UpdateStatus represents if infra needs to be updated
 type UpdateStatus string
 
@@ -1829,3 +2883,96 @@ func (e *UpdateStatus) UnmarshalGQL(v interface{}) error {
 func (e UpdateStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+// Defines the severity of a validation issue
+type ValidationSeverity string
+
+const (
+	ValidationSeverityError   ValidationSeverity = "ERROR"
+	ValidationSeverityWarning ValidationSeverity = "WARNING"
+)
+
+var AllValidationSeverity = []ValidationSeverity{
+	ValidationSeverityError,
+	ValidationSeverityWarning,
+}
+
+func (e ValidationSeverity) IsValid() bool {
+	switch e {
+	case ValidationSeverityError, ValidationSeverityWarning:
+		return true
+	}
+	return false
+}
+
+func (e ValidationSeverity) String() string {
+	return string(e)
+}
+
+func (e *ValidationSeverity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ValidationSeverity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ValidationSeverity", str)
+	}
+	return nil
+}
+
+func (e ValidationSeverity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type Weekday string
+
+const (
+	WeekdaySunday    Weekday = "SUNDAY"
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdaySunday,
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdaySunday, WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -0,0 +1,119 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *mutationResolver) CreateNotificationRule(ctx context.Context, projectID string, request model.NotificationRuleRequest) (*model.NotificationRule, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to create notification rule")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.CreateNotificationRule,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	rule, err := r.notificationService.CreateNotificationRule(ctx, projectID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *mutationResolver) UpdateNotificationRule(ctx context.Context, projectID string, ruleID string, request model.NotificationRuleRequest) (*model.NotificationRule, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"ruleId":    ruleID,
+	}
+	logrus.WithFields(logFields).Info("request received to update notification rule")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateNotificationRule,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	rule, err := r.notificationService.UpdateNotificationRule(ctx, projectID, ruleID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (r *mutationResolver) DeleteNotificationRule(ctx context.Context, projectID string, ruleID string) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"ruleId":    ruleID,
+	}
+	logrus.WithFields(logFields).Info("request received to delete notification rule")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.DeleteNotificationRule,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+	deleted, err := r.notificationService.DeleteNotificationRule(ctx, projectID, ruleID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return false, err
+	}
+	return deleted, nil
+}
+
+func (r *mutationResolver) RetryNotificationDelivery(ctx context.Context, projectID string, deliveryID string) (*model.NotificationDelivery, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"deliveryId": deliveryID,
+	}
+	logrus.WithFields(logFields).Info("request received to retry notification delivery")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.RetryNotificationDelivery,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := r.notificationService.RetryNotificationDelivery(ctx, projectID, deliveryID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return delivery, nil
+}
+
+func (r *queryResolver) ListNotificationRules(ctx context.Context, projectID string) ([]*model.NotificationRule, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list notification rules")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListNotificationRules,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	return r.notificationService.ListNotificationRules(ctx, projectID)
+}
+
+func (r *queryResolver) ListNotificationDeliveries(ctx context.Context, projectID string, request *model.ListNotificationDeliveriesRequest) (*model.ListNotificationDeliveriesResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list notification deliveries")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListNotificationDeliveries,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+	return r.notificationService.ListNotificationDeliveries(ctx, projectID, request)
+}
@@ -5,7 +5,9 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/blackout_window"
 	chaos_experiment2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment/handler"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
@@ -13,14 +15,18 @@ import (
 	chaos_experiment_run2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run"
 	runHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run/handler"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	dbBlackoutWindow "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/blackout_window"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
 	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
 	gitops2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
 	image_registry2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/image_registry"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
 	gitops3 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/image_registry"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
 )
 
 // This file will not be regenerated automatically.
@@ -34,6 +40,9 @@ type Resolver struct {
 	chaosExperimentService     chaos_experiment2.Service
 	choasExperimentRunService  chaos_experiment_run2.Service
 	gitopsService              gitops3.Service
+	auditService               audit.Service
+	blackoutWindowService      blackout_window.Service
+	notificationService        notification.Service
 	chaosExperimentHandler     handler.ChaosExperimentHandler
 	chaosExperimentRunHandler  runHandler.ChaosExperimentRunHandler
 }
@@ -46,18 +55,24 @@ func NewConfig(mongodbOperator mongodb.MongoOperator) generated.Config {
 	chaosExperimentRunOperator := chaos_experiment_run.NewChaosExperimentRunOperator(mongodbOperator)
 	gitopsOperator := gitops2.NewGitOpsOperator(mongodbOperator)
 	imageRegistryOperator := image_registry2.NewImageRegistryOperator(mongodbOperator)
+	auditOperator := dbAudit.NewAuditOperator(mongodbOperator)
+	blackoutWindowOperator := dbBlackoutWindow.NewBlackoutWindowOperator(mongodbOperator)
+	notificationOperator := dbNotification.NewNotificationOperator(mongodbOperator)
 
 	//service
 	chaosHubService := chaoshub.NewService(chaosHubOperator)
-	chaosInfrastructureService := chaos_infrastructure.NewChaosInfrastructureService(chaosInfraOperator)
+	notificationService := notification.NewNotificationService(notificationOperator)
+	chaosInfrastructureService := chaos_infrastructure.NewChaosInfrastructureService(chaosInfraOperator, notificationService)
 	chaosExperimentService := chaos_experiment2.NewChaosExperimentService(chaosExperimentOperator, chaosInfraOperator)
 	chaosExperimentRunService := chaos_experiment_run2.NewChaosExperimentRunService(chaosExperimentOperator, chaosInfraOperator, chaosExperimentRunOperator)
-	gitOpsService := gitops3.NewGitOpsService(gitopsOperator, chaosExperimentService, *chaosExperimentOperator)
+	gitOpsService := gitops3.NewGitOpsService(gitopsOperator, chaosExperimentService, *chaosExperimentOperator, *chaosExperimentRunOperator, *chaosInfraOperator)
 	imageRegistryService := image_registry.NewImageRegistryService(imageRegistryOperator)
+	auditService := audit.NewService(auditOperator)
+	blackoutWindowService := blackout_window.NewBlackoutWindowService(blackoutWindowOperator, chaosInfraOperator)
 
 	//handler
 	chaosExperimentHandler := handler.NewChaosExperimentHandler(chaosExperimentService, chaosExperimentRunService, chaosInfrastructureService, gitOpsService, chaosExperimentOperator, chaosExperimentRunOperator, mongodbOperator)
-	choasExperimentRunHandler := runHandler.NewChaosExperimentRunHandler(chaosExperimentRunService, chaosInfrastructureService, gitOpsService, chaosExperimentOperator, chaosExperimentRunOperator, mongodbOperator)
+	choasExperimentRunHandler := runHandler.NewChaosExperimentRunHandler(chaosExperimentRunService, chaosInfrastructureService, gitOpsService, blackoutWindowService, notificationService, chaosExperimentOperator, chaosExperimentRunOperator, mongodbOperator)
 
 	config := generated.Config{
 		Resolvers: &Resolver{
@@ -67,6 +82,9 @@ func NewConfig(mongodbOperator mongodb.MongoOperator) generated.Config {
 			choasExperimentRunService:  chaosExperimentRunService,
 			imageRegistryService:       imageRegistryService,
 			gitopsService:              gitOpsService,
+			auditService:               auditService,
+			blackoutWindowService:      blackoutWindowService,
+			notificationService:        notificationService,
 			chaosExperimentHandler:     *chaosExperimentHandler,
 			chaosExperimentRunHandler:  *choasExperimentRunHandler,
 		}}
@@ -0,0 +1,25 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
+	"github.com/sirupsen/logrus"
+)
+
+func (r *queryResolver) ListAuditEvents(ctx context.Context, projectID *string, request *model.ListAuditEventsRequest) (*model.ListAuditEventsResponse, error) {
+	logFields := logrus.Fields{}
+	if projectID != nil {
+		logFields["projectId"] = *projectID
+	}
+	logrus.WithFields(logFields).Info("request received to list audit events")
+	err := audit.Authorize(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return r.auditService.ListAuditEvents(ctx, projectID, request)
+}
@@ -0,0 +1,224 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const adminRole = "admin"
+
+// Service is the audit event service
+type Service interface {
+	ListAuditEvents(ctx context.Context, projectID *string, request *model.ListAuditEventsRequest) (*model.ListAuditEventsResponse, error)
+	ExportHandler() http.Handler
+}
+
+type auditService struct {
+	operator *dbAudit.Operator
+}
+
+// NewService returns a new instance of the audit event service
+func NewService(operator *dbAudit.Operator) Service {
+	return &auditService{
+		operator: operator,
+	}
+}
+
+// ListAuditEvents returns the paginated audit events matching the filter, latest first
+func (a *auditService) ListAuditEvents(ctx context.Context, projectID *string, request *model.ListAuditEventsRequest) (*model.ListAuditEventsResponse, error) {
+	var filter *model.AuditEventFilterInput
+	if request != nil {
+		filter = request.Filter
+	}
+	query, err := buildQuery(projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var skip, limit int64
+	if request != nil && request.Pagination != nil {
+		skip = int64(request.Pagination.Page * request.Pagination.Limit)
+		limit = int64(request.Pagination.Limit)
+	}
+
+	total, err := a.operator.CountEvents(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := a.operator.ListEvents(ctx, query, skip, limit)
+	if err != nil {
+		return nil, err
+	}
+	var events []dbAudit.Event
+	if err := cursor.All(ctx, &events); err != nil {
+		return nil, err
+	}
+
+	response := &model.ListAuditEventsResponse{
+		TotalNoOfEvents: int(total),
+		Events:          []*model.AuditEvent{},
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, toModel(event))
+	}
+
+	return response, nil
+}
+
+// ExportHandler exports the audit events matching the filter passed in the query parameters as JSON lines,
+// the projectID, actor, resourceType, resourceID, startTime and endTime parameters are supported
+func (a *auditService) ExportHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		params := r.URL.Query()
+		optional := func(key string) *string {
+			if value := params.Get(key); value != "" {
+				return &value
+			}
+			return nil
+		}
+
+		projectID := optional("projectID")
+		if err := Authorize(r.Context(), projectID); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+
+		query, err := buildQuery(projectID, &model.AuditEventFilterInput{
+			Actor:        optional("actor"),
+			ResourceType: optional("resourceType"),
+			ResourceID:   optional("resourceID"),
+			StartTime:    optional("startTime"),
+			EndTime:      optional("endTime"),
+		})
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		cursor, err := a.operator.ListEvents(r.Context(), query, 0, 0)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		defer cursor.Close(context.Background())
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.Header().Set("Content-Disposition", "attachment; filename=audit-events.jsonl")
+		encoder := json.NewEncoder(w)
+		for cursor.Next(r.Context()) {
+			var event dbAudit.Event
+			if err := cursor.Decode(&event); err != nil {
+				logrus.WithError(err).Error("failed to decode audit event")
+				return
+			}
+			if err := encoder.Encode(toModel(event)); err != nil {
+				logrus.WithError(err).Error("failed to export audit event")
+				return
+			}
+		}
+	})
+}
+
+// Authorize allows the owners of the project to list its audit events, the audit events
+// of every project can only be listed by admins with a token not scoped to projects
+func Authorize(ctx context.Context, projectID *string) error {
+	if projectID != nil {
+		return authorization.ValidateRole(ctx, *projectID,
+			authorization.ListAuditEvents,
+			model.InvitationAccepted.String())
+	}
+
+	jwt, _ := ctx.Value(authorization.AuthKey).(string)
+	claims, err := authorization.UserValidateJWT(jwt)
+	if err != nil {
+		return err
+	}
+	if role, _ := claims["role"].(string); role != adminRole {
+		return errors.New("permission_denied")
+	}
+	if _, scoped := claims["project_ids"]; scoped {
+		return errors.New("permission_denied")
+	}
+	return nil
+}
+
+// buildQuery builds the query matching the audit events of the project with the filter
+func buildQuery(projectID *string, filter *model.AuditEventFilterInput) (bson.D, error) {
+	query := bson.D{}
+	if projectID != nil {
+		query = append(query, bson.E{"project_id", *projectID})
+	}
+	if filter == nil {
+		return query, nil
+	}
+
+	if filter.Actor != nil && *filter.Actor != "" {
+		query = append(query, bson.E{"$or", bson.A{
+			bson.D{{"actor.username", *filter.Actor}},
+			bson.D{{"actor.user_id", *filter.Actor}},
+		}})
+	}
+	if filter.ResourceType != nil && *filter.ResourceType != "" {
+		query = append(query, bson.E{"resource_type", *filter.ResourceType})
+	}
+	if filter.ResourceID != nil && *filter.ResourceID != "" {
+		query = append(query, bson.E{"resource_id", *filter.ResourceID})
+	}
+
+	timeRange := bson.D{}
+	if filter.StartTime != nil && *filter.StartTime != "" {
+		startTime, err := strconv.ParseInt(*filter.StartTime, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid start time")
+		}
+		timeRange = append(timeRange, bson.E{"$gte", startTime})
+	}
+	if filter.EndTime != nil && *filter.EndTime != "" {
+		endTime, err := strconv.ParseInt(*filter.EndTime, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid end time")
+		}
+		timeRange = append(timeRange, bson.E{"$lte", endTime})
+	}
+	if len(timeRange) > 0 {
+		query = append(query, bson.E{"timestamp", timeRange})
+	}
+
+	return query, nil
+}
+
+// toModel converts the audit event to its GraphQL model
+func toModel(event dbAudit.Event) *model.AuditEvent {
+	auditEvent := &model.AuditEvent{
+		EventID:   event.EventID,
+		Timestamp: strconv.FormatInt(event.Timestamp, 10),
+		Actor: &model.UserDetails{
+			UserID:   event.Actor.UserID,
+			Username: event.Actor.Username,
+		},
+		Action:       event.Action,
+		ResourceType: event.ResourceType,
+		Outcome:      model.AuditEventOutcome(event.Outcome),
+		Source:       event.Source,
+	}
+	if event.ProjectID != "" {
+		auditEvent.ProjectID = &event.ProjectID
+	}
+	if event.ResourceID != "" {
+		auditEvent.ResourceID = &event.ResourceID
+	}
+	if event.Error != "" {
+		auditEvent.Error = &event.Error
+	}
+	return auditEvent
+}
@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	"github.com/sirupsen/logrus"
+)
+
+// Source of the audit events recorded by the GraphQL server
+const Source = "graphql"
+
+// Recorder is a GraphQL server extension recording an audit event for every mutation performed by a user
+type Recorder struct {
+	operator *dbAudit.Operator
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = Recorder{}
+
+// NewRecorder returns a new instance of Recorder
+func NewRecorder(operator *dbAudit.Operator) Recorder {
+	return Recorder{
+		operator: operator,
+	}
+}
+
+// ExtensionName returns the name of the extension
+func (r Recorder) ExtensionName() string {
+	return "AuditRecorder"
+}
+
+// Validate validates the executable schema of the server
+func (r Recorder) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// infraActorKey is the context key of the infra performing a mutation sent by the chaos infrastructures
+type infraActorKey struct{}
+
+// infraActor is the verified infra performing a mutation, set by the resolver with SetInfraActor
+type infraActor struct {
+	InfraID   string
+	InfraName string
+	ProjectID string
+}
+
+// SetInfraActor records the verified infra performing the mutation, the mutations of the infras are only audited
+// once their infra is verified
+func SetInfraActor(ctx context.Context, infraID string, infraName string, projectID string) {
+	if actor, ok := ctx.Value(infraActorKey{}).(*infraActor); ok {
+		*actor = infraActor{InfraID: infraID, InfraName: infraName, ProjectID: projectID}
+	}
+}
+
+// InterceptField records the outcome of the root mutation fields once they are resolved
+func (r Recorder) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	// mutations sent by the chaos infrastructures are authenticated by their access keys and are only audited
+	// when they act on behalf of the users, with the infra as actor
+	jwt, _ := ctx.Value(authorization.AuthKey).(string)
+	if jwt == "" {
+		if !infraMutations[fc.Field.Name] {
+			return next(ctx)
+		}
+		actor := &infraActor{}
+		res, resErr := next(context.WithValue(ctx, infraActorKey{}, actor))
+		if actor.InfraID != "" {
+			event := r.newEvent(fc, res, resErr)
+			event.Actor.UserID = actor.InfraID
+			event.Actor.Username = actor.InfraName
+			event.ProjectID = actor.ProjectID
+			r.insertEvent(event)
+		}
+		return res, resErr
+	}
+	claims, err := authorization.UserValidateJWT(jwt)
+	if err != nil {
+		return next(ctx)
+	}
+
+	res, resErr := next(ctx)
+
+	event := r.newEvent(fc, res, resErr)
+	event.Actor.UserID, _ = claims["uid"].(string)
+	event.Actor.Username, _ = claims["username"].(string)
+	r.insertEvent(event)
+
+	return res, resErr
+}
+
+// newEvent returns the audit event of the resolved mutation
+func (r Recorder) newEvent(fc *graphql.FieldContext, res interface{}, resErr error) dbAudit.Event {
+	resourceType, resourceID, projectID := GetMutationResource(fc.Field.Name, fc.Args, res)
+	event := dbAudit.Event{
+		EventID:      uuid.New().String(),
+		ProjectID:    projectID,
+		Timestamp:    time.Now().UnixMilli(),
+		Action:       fc.Field.Name,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Outcome:      dbAudit.OutcomeSuccess,
+		Source:       Source,
+	}
+	if resErr != nil {
+		event.Outcome = dbAudit.OutcomeFailure
+		event.Error = resErr.Error()
+	}
+	return event
+}
+
+// insertEvent records the audit event, the request context may already be cancelled so the event is recorded regardless
+func (r Recorder) insertEvent(event dbAudit.Event) {
+	if err := r.operator.InsertEvent(context.Background(), event); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"action":     event.Action,
+			"resourceId": event.ResourceID,
+		}).WithError(err).Error("failed to record audit event")
+	}
+}
@@ -0,0 +1,89 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	"github.com/stretchr/testify/assert"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// auditOperator records the created audit events
+type auditOperator struct {
+	mongodb.MongoOperator
+	events []dbAudit.Event
+}
+
+func (o *auditOperator) Create(ctx context.Context, collectionType int, document interface{}) error {
+	o.events = append(o.events, document.(dbAudit.Event))
+	return nil
+}
+
+// TestRecorderInfraMutation is used to test that the mutations sent by the infras are audited with the infra as
+// actor once the infra is verified
+func TestRecorderInfraMutation(t *testing.T) {
+	tests := []struct {
+		name           string
+		mutation       string
+		verified       bool
+		expectedEvents int
+	}{
+		{
+			name:           "verified infra triggering an experiment",
+			mutation:       "triggerExperimentOnEvent",
+			verified:       true,
+			expectedEvents: 1,
+		},
+		{
+			name:     "unverified infra triggering an experiment",
+			mutation: "triggerExperimentOnEvent",
+		},
+		{
+			name:     "infra mutation not performed on behalf of the users",
+			mutation: "chaosExperimentRun",
+			verified: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			operator := &auditOperator{}
+			recorder := audit.NewRecorder(dbAudit.NewAuditOperator(operator))
+			ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+				Object: "Mutation",
+				Field:  graphql.CollectedField{Field: &ast.Field{Name: tc.mutation}},
+				Args: map[string]interface{}{
+					"request": model.ExperimentEventTriggerRequest{ExperimentID: "experiment-id"},
+				},
+			})
+			resolver := func(ctx context.Context) (interface{}, error) {
+				if !tc.verified {
+					return nil, errors.New("ERROR:  infra_ID MISMATCH")
+				}
+				audit.SetInfraActor(ctx, "infra-id", "infra", "project-id")
+				return &model.ExperimentEventTriggerResponse{}, nil
+			}
+
+			// when
+			_, _ = recorder.InterceptField(ctx, resolver)
+
+			// then
+			assert.Len(t, operator.events, tc.expectedEvents)
+			if tc.expectedEvents == 0 {
+				return
+			}
+			event := operator.events[0]
+			assert.Equal(t, dbAudit.Actor{UserID: "infra-id", Username: "infra"}, event.Actor)
+			assert.Equal(t, "project-id", event.ProjectID)
+			assert.Equal(t, audit.ChaosExperimentResource, event.ResourceType)
+			assert.Equal(t, "experiment-id", event.ResourceID)
+			assert.Equal(t, dbAudit.OutcomeSuccess, event.Outcome)
+		})
+	}
+}
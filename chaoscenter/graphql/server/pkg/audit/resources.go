@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Types of the resources the audited operations are performed on
+const (
+	ChaosExperimentResource      = "ChaosExperiment"
+	ChaosExperimentRunResource   = "ChaosExperimentRun"
+	ChaosInfrastructureResource  = "ChaosInfrastructure"
+	ChaosHubResource             = "ChaosHub"
+	EnvironmentResource          = "Environment"
+	GitOpsResource               = "GitOps"
+	ImageRegistryResource        = "ImageRegistry"
+	SSHKeyResource               = "SSHKey"
+	BlackoutWindowResource       = "BlackoutWindow"
+	NotificationRuleResource     = "NotificationRule"
+	NotificationDeliveryResource = "NotificationDelivery"
+	UnknownResource              = "Unknown"
+)
+
+// resource describes the resource a mutation is performed on, the ID of the resource is looked
+// up in order from the paths, prefixed by "args." for the arguments and "result." for the response
+type resource struct {
+	Type    string
+	IDPaths []string
+}
+
+// mutationResources maps the mutations performed by users to the resource they are performed on
+var mutationResources = map[string]resource{
+	"createChaosExperiment":     {ChaosExperimentResource, []string{"args.request.experimentID", "result.experimentID"}},
+	"saveChaosExperiment":       {ChaosExperimentResource, []string{"args.request.id"}},
+	"updateChaosExperiment":     {ChaosExperimentResource, []string{"args.request.experimentID"}},
+	"deleteChaosExperiment":     {ChaosExperimentResource, []string{"args.experimentID"}},
+	"updateScoringPolicy":       {ChaosExperimentResource, []string{"args.experimentID"}},
+	"recomputeResiliencyScores": {ChaosExperimentResource, []string{"args.experimentID"}},
+	"runChaosExperiment":        {ChaosExperimentResource, []string{"args.experimentID"}},
+	"triggerExperimentOnEvent":  {ChaosExperimentResource, []string{"args.request.experimentID"}},
+	"stopExperimentRun":         {ChaosExperimentRunResource, []string{"args.experimentRunID", "args.experimentID"}},
+	"registerInfra":             {ChaosInfrastructureResource, []string{"result.infraID"}},
+	"deleteInfra":               {ChaosInfrastructureResource, []string{"args.infraID"}},
+	"getManifestWithInfraID":    {ChaosInfrastructureResource, []string{"args.infraID"}},
+	"addChaosHub":               {ChaosHubResource, []string{"result.id"}},
+	"addRemoteChaosHub":         {ChaosHubResource, []string{"result.id"}},
+	"saveChaosHub":              {ChaosHubResource, []string{"result.id"}},
+	"syncChaosHub":              {ChaosHubResource, []string{"args.id"}},
+	"updateChaosHub":            {ChaosHubResource, []string{"args.request.id"}},
+	"deleteChaosHub":            {ChaosHubResource, []string{"args.hubID"}},
+	"generateSSHKey":            {SSHKeyResource, nil},
+	"createEnvironment":         {EnvironmentResource, []string{"args.request.environmentID"}},
+	"updateEnvironment":         {EnvironmentResource, []string{"args.request.environmentID"}},
+	"deleteEnvironment":         {EnvironmentResource, []string{"args.environmentID"}},
+	"enableGitOps":              {GitOpsResource, nil},
+	"disableGitOps":             {GitOpsResource, nil},
+	"resolveGitOpsDrift":        {ChaosExperimentResource, []string{"args.experimentID"}},
+	"updateGitOps":              {GitOpsResource, nil},
+	"createImageRegistry":       {ImageRegistryResource, []string{"result.imageRegistryID"}},
+	"updateImageRegistry":       {ImageRegistryResource, []string{"args.imageRegistryID"}},
+	"deleteImageRegistry":       {ImageRegistryResource, []string{"args.imageRegistryID"}},
+	"createBlackoutWindow":      {BlackoutWindowResource, []string{"result.windowID"}},
+	"updateBlackoutWindow":      {BlackoutWindowResource, []string{"args.windowID"}},
+	"deleteBlackoutWindow":      {BlackoutWindowResource, []string{"args.windowID"}},
+	"createNotificationRule":    {NotificationRuleResource, []string{"result.ruleID"}},
+	"updateNotificationRule":    {NotificationRuleResource, []string{"args.ruleID"}},
+	"deleteNotificationRule":    {NotificationRuleResource, []string{"args.ruleID"}},
+	"retryNotificationDelivery": {NotificationDeliveryResource, []string{"args.deliveryID"}},
+}
+
+// infraMutations are the mutations sent by the chaos infrastructures which are audited, with the infra as actor
+var infraMutations = map[string]bool{
+	"triggerExperimentOnEvent": true,
+}
+
+// projectIDPaths are the paths the project of a mutation is looked up in
+var projectIDPaths = []string{"args.projectID", "args.configurations.projectID", "args.request.projectID"}
+
+// GetMutationResource returns the type and ID of the resource a mutation is performed on and the project it is
+// performed in, the arguments are only used to look up the identifiers and are never stored as they may hold secrets
+func GetMutationResource(mutation string, args map[string]interface{}, result interface{}) (resourceType string, resourceID string, projectID string) {
+	values := map[string]interface{}{
+		"args":   toGeneric(args),
+		"result": toGeneric(result),
+	}
+
+	resourceType = UnknownResource
+	if res, ok := mutationResources[mutation]; ok {
+		resourceType = res.Type
+		resourceID = lookup(values, res.IDPaths)
+	}
+	projectID = lookup(values, projectIDPaths)
+
+	return resourceType, resourceID, projectID
+}
+
+// toGeneric converts the typed GraphQL models to maps so that they can be looked up by their field names
+func toGeneric(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil
+	}
+	return generic
+}
+
+// lookup returns the first non-empty value found at the paths
+func lookup(values map[string]interface{}, paths []string) string {
+	for _, path := range paths {
+		var current interface{} = values
+		for _, key := range strings.Split(path, ".") {
+			object, ok := current.(map[string]interface{})
+			if !ok {
+				current = nil
+				break
+			}
+			current = object[key]
+		}
+		if current == nil {
+			continue
+		}
+		if value := fmt.Sprint(current); value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -0,0 +1,96 @@
+package audit_test
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGetMutationResource is used to test the lookup of the resource of a mutation in its arguments and response
+func TestGetMutationResource(t *testing.T) {
+	experimentID := "experiment-id"
+	tests := []struct {
+		name                 string
+		mutation             string
+		args                 map[string]interface{}
+		result               interface{}
+		expectedResourceType string
+		expectedResourceID   string
+		expectedProjectID    string
+	}{
+		{
+			name:     "resource ID in a nested argument",
+			mutation: "updateChaosExperiment",
+			args: map[string]interface{}{
+				"projectID": "project-id",
+				"request":   &model.ChaosExperimentRequest{ExperimentID: &experimentID},
+			},
+			expectedResourceType: audit.ChaosExperimentResource,
+			expectedResourceID:   experimentID,
+			expectedProjectID:    "project-id",
+		},
+		{
+			name:     "resource ID in the response",
+			mutation: "createImageRegistry",
+			args: map[string]interface{}{
+				"projectID":         "project-id",
+				"imageRegistryInfo": model.ImageRegistryInput{},
+			},
+			result:               &model.ImageRegistryResponse{ImageRegistryID: "registry-id"},
+			expectedResourceType: audit.ImageRegistryResource,
+			expectedResourceID:   "registry-id",
+			expectedProjectID:    "project-id",
+		},
+		{
+			name:     "project ID in the configurations",
+			mutation: "enableGitOps",
+			args: map[string]interface{}{
+				"configurations": model.GitConfig{ProjectID: "project-id"},
+			},
+			expectedResourceType: audit.GitOpsResource,
+			expectedProjectID:    "project-id",
+		},
+		{
+			name:     "notification rule in the response",
+			mutation: "createNotificationRule",
+			args: map[string]interface{}{
+				"projectID": "project-id",
+				"request":   model.NotificationRuleRequest{},
+			},
+			result:               &model.NotificationRule{RuleID: "rule-id"},
+			expectedResourceType: audit.NotificationRuleResource,
+			expectedResourceID:   "rule-id",
+			expectedProjectID:    "project-id",
+		},
+		{
+			name:     "experiment of the resolved drift",
+			mutation: "resolveGitOpsDrift",
+			args: map[string]interface{}{
+				"projectID":    "project-id",
+				"experimentID": experimentID,
+				"resolution":   model.GitOpsDriftResolutionUseGit,
+			},
+			expectedResourceType: audit.ChaosExperimentResource,
+			expectedResourceID:   experimentID,
+			expectedProjectID:    "project-id",
+		},
+		{
+			name:                 "unknown mutation",
+			mutation:             "unknownMutation",
+			expectedResourceType: audit.UnknownResource,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			resourceType, resourceID, projectID := audit.GetMutationResource(tc.mutation, tc.args, tc.result)
+
+			// then
+			assert.Equal(t, tc.expectedResourceType, resourceType)
+			assert.Equal(t, tc.expectedResourceID, resourceID)
+			assert.Equal(t, tc.expectedProjectID, projectID)
+		})
+	}
+}
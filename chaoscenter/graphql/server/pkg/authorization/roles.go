@@ -64,6 +64,8 @@ const (
 	ListDataSource               RoleQuery = "ListDataSource"
 	ListDashboard                RoleQuery = "ListDashboard"
 	GetGitOpsDetails             RoleQuery = "GetGitOpsDetails"
+	GetGitOpsDriftReport         RoleQuery = "GetGitOpsDriftReport"
+	ResolveGitOpsDrift           RoleQuery = "ResolveGitOpsDrift"
 	ListWorkflowManifests        RoleQuery = "ListWorkflowManifests"
 	GetWorkflowManifestByID      RoleQuery = "GetWorkflowManifestByID"
 	ListImageRegistry            RoleQuery = "ListImageRegistry"
@@ -73,6 +75,17 @@ const (
 	DeleteEnvironment            RoleQuery = "DeleteEnvironment"
 	GetEnvironment               RoleQuery = "GetEnvironment"
 	ListEnvironments             RoleQuery = "ListEnvironments"
+	ListAuditEvents              RoleQuery = "ListAuditEvents"
+	CreateBlackoutWindow         RoleQuery = "CreateBlackoutWindow"
+	UpdateBlackoutWindow         RoleQuery = "UpdateBlackoutWindow"
+	DeleteBlackoutWindow         RoleQuery = "DeleteBlackoutWindow"
+	ListBlackoutWindows          RoleQuery = "ListBlackoutWindows"
+	CreateNotificationRule       RoleQuery = "CreateNotificationRule"
+	UpdateNotificationRule       RoleQuery = "UpdateNotificationRule"
+	DeleteNotificationRule       RoleQuery = "DeleteNotificationRule"
+	ListNotificationRules        RoleQuery = "ListNotificationRules"
+	ListNotificationDeliveries   RoleQuery = "ListNotificationDeliveries"
+	RetryNotificationDelivery    RoleQuery = "RetryNotificationDelivery"
 	MemberRoleOwnerString                  = string(model.MemberRoleOwner)
 	MemberRoleEditorString                 = string(model.MemberRoleEditor)
 	MemberRoleViewerString                 = string(model.MemberRoleViewer)
@@ -137,6 +150,8 @@ var MutationRbacRules = map[RoleQuery][]string{
 	ListDataSource:               {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
 	ListDashboard:                {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
 	GetGitOpsDetails:             {MemberRoleOwnerString},
+	GetGitOpsDriftReport:         {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
+	ResolveGitOpsDrift:           {MemberRoleOwnerString, MemberRoleEditorString},
 	ListWorkflowManifests:        {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
 	GetExperimentDetails:         {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
 	GetWorkflowManifestByID:      {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
@@ -147,4 +162,15 @@ var MutationRbacRules = map[RoleQuery][]string{
 	DeleteEnvironment:            {MemberRoleOwnerString, MemberRoleEditorString},
 	GetEnvironment:               {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
 	ListEnvironments:             {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
+	ListAuditEvents:              {MemberRoleOwnerString},
+	CreateBlackoutWindow:         {MemberRoleOwnerString},
+	UpdateBlackoutWindow:         {MemberRoleOwnerString},
+	DeleteBlackoutWindow:         {MemberRoleOwnerString},
+	ListBlackoutWindows:          {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
+	CreateNotificationRule:       {MemberRoleOwnerString},
+	UpdateNotificationRule:       {MemberRoleOwnerString},
+	DeleteNotificationRule:       {MemberRoleOwnerString},
+	ListNotificationRules:        {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
+	ListNotificationDeliveries:   {MemberRoleOwnerString, MemberRoleEditorString, MemberRoleViewerString},
+	RetryNotificationDelivery:    {MemberRoleOwnerString, MemberRoleEditorString},
 }
@@ -9,15 +9,17 @@ import (
 	grpc2 "google.golang.org/grpc"
 )
 
-// ValidateRole Validates the role of a user in a given project
+// ValidateRole Validates the role of a user in a given project, the user is allowed to perform the
+// operation if its role is one of the MutationRbacRules of the operation or a custom role granting it
 func ValidateRole(ctx context.Context, projectID string,
-	requiredRoles []string, invitation string) error {
+	permission RoleQuery, invitation string) error {
 	jwt := ctx.Value(AuthKey).(string)
 	var conn *grpc2.ClientConn
 	client, conn := grpc.GetAuthGRPCSvcClient(conn)
 	defer conn.Close()
-	err := grpc.ValidatorGRPCRequest(client, jwt, projectID,
-		requiredRoles,
+	err := grpc.ValidatorGRPCRequest(ctx, client, jwt, projectID,
+		MutationRbacRules[permission],
+		string(permission),
 		invitation)
 	if err != nil {
 		return errors.New("permission_denied")
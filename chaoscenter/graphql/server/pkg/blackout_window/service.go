@@ -0,0 +1,381 @@
+package blackout_window
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbBlackoutWindow "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/blackout_window"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// SubscriberRequestType is the type of the requests sending the blackout windows to the subscribers
+	SubscriberRequestType = "blackout_windows"
+	// SuspendedAnnotation is set on the cron experiments suspended by a blackout window with the ID of the window,
+	// the subscriber resumes them once no window is in effect
+	SuspendedAnnotation = "litmuschaos.io/blackout-window"
+)
+
+// SubscriberBlackoutWindow is the blackout window sent to the subscribers, which suspend
+// the cron experiments of their chaos infrastructure while one of the windows is in effect
+type SubscriberBlackoutWindow struct {
+	WindowID       string   `json:"windowID"`
+	Name           string   `json:"name"`
+	Timezone       string   `json:"timezone"`
+	Type           string   `json:"type"`
+	StartTime      int64    `json:"startTime,omitempty"`
+	EndTime        int64    `json:"endTime,omitempty"`
+	Weekdays       []string `json:"weekdays,omitempty"`
+	DailyStartTime string   `json:"dailyStartTime,omitempty"`
+	DailyEndTime   string   `json:"dailyEndTime,omitempty"`
+}
+
+// Service is the interface for the blackout window service
+type Service interface {
+	CreateBlackoutWindow(ctx context.Context, projectID string, request model.BlackoutWindowRequest) (*model.BlackoutWindow, error)
+	UpdateBlackoutWindow(ctx context.Context, projectID string, windowID string, request model.BlackoutWindowRequest) (*model.BlackoutWindow, error)
+	DeleteBlackoutWindow(ctx context.Context, projectID string, windowID string) (bool, error)
+	ListBlackoutWindows(ctx context.Context, projectID string, environmentID *string) ([]*model.BlackoutWindow, error)
+	GetActiveBlackoutWindow(ctx context.Context, projectID string, environmentID string) (*dbBlackoutWindow.BlackoutWindow, error)
+	SyncBlackoutWindows(ctx context.Context, projectID string, r *store.StateData)
+	SendBlackoutWindows(ctx context.Context, infra dbChaosInfra.ChaosInfra, r *store.StateData) error
+}
+
+// blackoutWindowService is the implementation of Service interface
+type blackoutWindowService struct {
+	blackoutWindowOperator *dbBlackoutWindow.Operator
+	infraOperator          *dbChaosInfra.Operator
+}
+
+// NewBlackoutWindowService returns a new instance of blackoutWindowService
+func NewBlackoutWindowService(blackoutWindowOperator *dbBlackoutWindow.Operator, infraOperator *dbChaosInfra.Operator) Service {
+	return &blackoutWindowService{
+		blackoutWindowOperator: blackoutWindowOperator,
+		infraOperator:          infraOperator,
+	}
+}
+
+// CreateBlackoutWindow creates a new blackout window for the project
+func (b *blackoutWindowService) CreateBlackoutWindow(ctx context.Context, projectID string, request model.BlackoutWindowRequest) (*model.BlackoutWindow, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := newBlackoutWindow(projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	currentTime := time.Now().UnixMilli()
+	window.WindowID = uuid.New().String()
+	window.Audit = mongodb.Audit{
+		CreatedAt: currentTime,
+		UpdatedAt: currentTime,
+		CreatedBy: username,
+		UpdatedBy: username,
+		IsRemoved: false,
+	}
+
+	err = b.blackoutWindowOperator.InsertBlackoutWindow(ctx, window)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModel(window, time.Now()), nil
+}
+
+// UpdateBlackoutWindow replaces the definition of the blackout window
+func (b *blackoutWindowService) UpdateBlackoutWindow(ctx context.Context, projectID string, windowID string, request model.BlackoutWindowRequest) (*model.BlackoutWindow, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	query := bson.D{
+		{"window_id", windowID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	existing, err := b.blackoutWindowOperator.GetBlackoutWindow(ctx, query)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no blackout window found with windowID: " + windowID)
+		}
+		return nil, err
+	}
+
+	window, err := newBlackoutWindow(projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	window.WindowID = existing.WindowID
+	window.Audit = existing.Audit
+	window.UpdatedAt = time.Now().UnixMilli()
+	window.UpdatedBy = username
+
+	update := bson.D{
+		{"$set", bson.D{
+			{"environment_id", window.EnvironmentID},
+			{"name", window.Name},
+			{"description", window.Description},
+			{"timezone", window.Timezone},
+			{"type", window.Type},
+			{"start_time", window.StartTime},
+			{"end_time", window.EndTime},
+			{"weekdays", window.Weekdays},
+			{"daily_start_time", window.DailyStartTime},
+			{"daily_end_time", window.DailyEndTime},
+			{"updated_at", window.UpdatedAt},
+			{"updated_by", window.UpdatedBy},
+		}},
+	}
+	err = b.blackoutWindowOperator.UpdateBlackoutWindow(ctx, query, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModel(window, time.Now()), nil
+}
+
+// DeleteBlackoutWindow removes the blackout window
+func (b *blackoutWindowService) DeleteBlackoutWindow(ctx context.Context, projectID string, windowID string) (bool, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return false, err
+	}
+
+	query := bson.D{
+		{"window_id", windowID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	if _, err = b.blackoutWindowOperator.GetBlackoutWindow(ctx, query); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return false, errors.New("no blackout window found with windowID: " + windowID)
+		}
+		return false, err
+	}
+
+	update := bson.D{
+		{"$set", bson.D{
+			{"is_removed", true},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", username},
+		}},
+	}
+	err = b.blackoutWindowOperator.UpdateBlackoutWindow(ctx, query, update)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListBlackoutWindows returns the blackout windows of the project, or the windows applying to the environment if environmentID is set
+func (b *blackoutWindowService) ListBlackoutWindows(ctx context.Context, projectID string, environmentID *string) ([]*model.BlackoutWindow, error) {
+	var envID string
+	if environmentID != nil {
+		envID = *environmentID
+	}
+	windows, err := b.listApplicableWindows(ctx, projectID, envID, environmentID == nil)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := []*model.BlackoutWindow{}
+	for _, window := range windows {
+		result = append(result, toModel(window, now))
+	}
+	return result, nil
+}
+
+// GetActiveBlackoutWindow returns the blackout window in effect for the environment of the project, nil if there is none
+func (b *blackoutWindowService) GetActiveBlackoutWindow(ctx context.Context, projectID string, environmentID string) (*dbBlackoutWindow.BlackoutWindow, error) {
+	windows, err := b.listApplicableWindows(ctx, projectID, environmentID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	for _, window := range windows {
+		if IsActive(window, now) {
+			return &window, nil
+		}
+	}
+	return nil, nil
+}
+
+// SyncBlackoutWindows sends the blackout windows to the connected chaos infrastructures of the project
+func (b *blackoutWindowService) SyncBlackoutWindows(ctx context.Context, projectID string, r *store.StateData) {
+	infras, err := b.infraOperator.GetInfras(ctx, bson.D{
+		{"project_id", projectID},
+		{"is_active", true},
+		{"is_removed", false},
+	})
+	if err != nil {
+		logrus.WithField("projectId", projectID).WithError(err).Error("failed to get the infras to sync blackout windows with")
+		return
+	}
+
+	for _, infra := range infras {
+		if err := b.SendBlackoutWindows(ctx, infra, r); err != nil {
+			logrus.WithField("infraId", infra.InfraID).WithError(err).Error("failed to sync blackout windows")
+		}
+	}
+}
+
+// SendBlackoutWindows sends the blackout windows applying to the chaos infrastructure to its subscriber
+func (b *blackoutWindowService) SendBlackoutWindows(ctx context.Context, infra dbChaosInfra.ChaosInfra, r *store.StateData) error {
+	windows, err := b.listApplicableWindows(ctx, infra.ProjectID, infra.EnvironmentID, false)
+	if err != nil {
+		return err
+	}
+
+	subscriberWindows := []SubscriberBlackoutWindow{}
+	for _, window := range windows {
+		subscriberWindows = append(subscriberWindows, SubscriberBlackoutWindow{
+			WindowID:       window.WindowID,
+			Name:           window.Name,
+			Timezone:       window.Timezone,
+			Type:           window.Type,
+			StartTime:      window.StartTime,
+			EndTime:        window.EndTime,
+			Weekdays:       window.Weekdays,
+			DailyStartTime: window.DailyStartTime,
+			DailyEndTime:   window.DailyEndTime,
+		})
+	}
+	data, err := json.Marshal(subscriberWindows)
+	if err != nil {
+		return err
+	}
+
+	externalData := string(data)
+	chaos_infrastructure.SendRequestToSubscriber(ctx, chaos_infrastructure.SubscriberRequests{
+		RequestType:  SubscriberRequestType,
+		ProjectID:    infra.ProjectID,
+		InfraID:      infra.InfraID,
+		Namespace:    utils.Config.InfraNamespace,
+		ExternalData: &externalData,
+	}, *r)
+	return nil
+}
+
+// listApplicableWindows returns the blackout windows of the project applying to the environment,
+// or every window of the project if allEnvironments is set
+func (b *blackoutWindowService) listApplicableWindows(ctx context.Context, projectID string, environmentID string, allEnvironments bool) ([]dbBlackoutWindow.BlackoutWindow, error) {
+	query := bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	if !allEnvironments {
+		environments := bson.A{
+			bson.D{{"environment_id", bson.D{{"$exists", false}}}},
+			bson.D{{"environment_id", ""}},
+		}
+		if environmentID != "" {
+			environments = append(environments, bson.D{{"environment_id", environmentID}})
+		}
+		query = append(query, bson.E{"$or", environments})
+	}
+
+	return b.blackoutWindowOperator.ListBlackoutWindows(ctx, query)
+}
+
+// newBlackoutWindow builds the blackout window defined by the request and validates it
+func newBlackoutWindow(projectID string, request model.BlackoutWindowRequest) (dbBlackoutWindow.BlackoutWindow, error) {
+	window := dbBlackoutWindow.BlackoutWindow{
+		ProjectID: projectID,
+		Name:      request.Name,
+		Timezone:  request.Timezone,
+		Type:      string(request.Type),
+	}
+	if request.EnvironmentID != nil {
+		window.EnvironmentID = *request.EnvironmentID
+	}
+	if request.Description != nil {
+		window.Description = *request.Description
+	}
+
+	switch request.Type {
+	case model.BlackoutWindowTypeOneOff:
+		var err error
+		if request.StartTime != nil {
+			if window.StartTime, err = strconv.ParseInt(*request.StartTime, 10, 64); err != nil {
+				return window, errors.New("invalid start time")
+			}
+		}
+		if request.EndTime != nil {
+			if window.EndTime, err = strconv.ParseInt(*request.EndTime, 10, 64); err != nil {
+				return window, errors.New("invalid end time")
+			}
+		}
+	case model.BlackoutWindowTypeRecurring:
+		for _, weekday := range request.Weekdays {
+			window.Weekdays = append(window.Weekdays, string(weekday))
+		}
+		if request.DailyStartTime != nil {
+			window.DailyStartTime = *request.DailyStartTime
+		}
+		if request.DailyEndTime != nil {
+			window.DailyEndTime = *request.DailyEndTime
+		}
+	}
+
+	return window, Validate(window)
+}
+
+// toModel converts the blackout window to its GraphQL model
+func toModel(window dbBlackoutWindow.BlackoutWindow, now time.Time) *model.BlackoutWindow {
+	var (
+		createdAt = strconv.FormatInt(window.CreatedAt, 10)
+		updatedAt = strconv.FormatInt(window.UpdatedAt, 10)
+	)
+	result := &model.BlackoutWindow{
+		WindowID:    window.WindowID,
+		ProjectID:   window.ProjectID,
+		Name:        window.Name,
+		Description: &window.Description,
+		Timezone:    window.Timezone,
+		Type:        model.BlackoutWindowType(window.Type),
+		IsActive:    IsActive(window, now),
+		CreatedAt:   &createdAt,
+		CreatedBy:   &model.UserDetails{Username: window.CreatedBy},
+		UpdatedAt:   &updatedAt,
+		UpdatedBy:   &model.UserDetails{Username: window.UpdatedBy},
+	}
+	if window.EnvironmentID != "" {
+		result.EnvironmentID = &window.EnvironmentID
+	}
+	switch model.BlackoutWindowType(window.Type) {
+	case model.BlackoutWindowTypeOneOff:
+		startTime := strconv.FormatInt(window.StartTime, 10)
+		endTime := strconv.FormatInt(window.EndTime, 10)
+		result.StartTime = &startTime
+		result.EndTime = &endTime
+	case model.BlackoutWindowTypeRecurring:
+		for _, weekday := range window.Weekdays {
+			result.Weekdays = append(result.Weekdays, model.Weekday(weekday))
+		}
+		result.DailyStartTime = &window.DailyStartTime
+		result.DailyEndTime = &window.DailyEndTime
+	}
+	return result
+}
@@ -0,0 +1,97 @@
+package blackout_window
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbBlackoutWindow "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/blackout_window"
+)
+
+// dailyTimeLayout is the layout of the daily start and end time of recurring windows
+const dailyTimeLayout = "15:04"
+
+// IsActive checks if the blackout window is in effect at the given time
+func IsActive(window dbBlackoutWindow.BlackoutWindow, now time.Time) bool {
+	switch model.BlackoutWindowType(window.Type) {
+	case model.BlackoutWindowTypeOneOff:
+		nowMilli := now.UnixMilli()
+		return window.StartTime <= nowMilli && nowMilli < window.EndTime
+	case model.BlackoutWindowTypeRecurring:
+		location, err := time.LoadLocation(window.Timezone)
+		if err != nil {
+			return false
+		}
+		start, err := time.Parse(dailyTimeLayout, window.DailyStartTime)
+		if err != nil {
+			return false
+		}
+		end, err := time.Parse(dailyTimeLayout, window.DailyEndTime)
+		if err != nil {
+			return false
+		}
+
+		localNow := now.In(location)
+		// a window spanning midnight may have started on the previous day
+		for _, day := range []time.Time{localNow, localNow.AddDate(0, 0, -1)} {
+			if !hasWeekday(window.Weekdays, day.Weekday()) {
+				continue
+			}
+			startAt := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, location)
+			endAt := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, location)
+			if !endAt.After(startAt) {
+				endAt = endAt.AddDate(0, 0, 1)
+			}
+			if !localNow.Before(startAt) && localNow.Before(endAt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Validate checks that the blackout window defines the fields required by its type
+func Validate(window dbBlackoutWindow.BlackoutWindow) error {
+	if strings.TrimSpace(window.Name) == "" {
+		return errors.New("name of the blackout window is required")
+	}
+	if _, err := time.LoadLocation(window.Timezone); err != nil || window.Timezone == "" {
+		return fmt.Errorf("invalid timezone %q", window.Timezone)
+	}
+
+	switch model.BlackoutWindowType(window.Type) {
+	case model.BlackoutWindowTypeOneOff:
+		if window.StartTime <= 0 || window.EndTime <= window.StartTime {
+			return errors.New("one-off blackout windows require a start time before their end time")
+		}
+	case model.BlackoutWindowTypeRecurring:
+		if len(window.Weekdays) == 0 {
+			return errors.New("recurring blackout windows require at least one weekday")
+		}
+		for _, weekday := range window.Weekdays {
+			if !model.Weekday(weekday).IsValid() {
+				return fmt.Errorf("invalid weekday %q", weekday)
+			}
+		}
+		if _, err := time.Parse(dailyTimeLayout, window.DailyStartTime); err != nil {
+			return errors.New("recurring blackout windows require a daily start time in HH:MM format")
+		}
+		if _, err := time.Parse(dailyTimeLayout, window.DailyEndTime); err != nil {
+			return errors.New("recurring blackout windows require a daily end time in HH:MM format")
+		}
+	default:
+		return fmt.Errorf("invalid blackout window type %q", window.Type)
+	}
+	return nil
+}
+
+func hasWeekday(weekdays []string, weekday time.Weekday) bool {
+	for _, w := range weekdays {
+		if strings.EqualFold(w, weekday.String()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,131 @@
+package blackout_window_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/blackout_window"
+	dbBlackoutWindow "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/blackout_window"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsActive is used to test the evaluation of one-off and recurring blackout windows
+func TestIsActive(t *testing.T) {
+	// given
+	location, err := time.LoadLocation("Europe/Berlin")
+	assert.NoError(t, err)
+	oneOff := dbBlackoutWindow.BlackoutWindow{
+		Type:      "ONE_OFF",
+		Timezone:  "UTC",
+		StartTime: time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC).UnixMilli(),
+		EndTime:   time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC).UnixMilli(),
+	}
+	afterHours := dbBlackoutWindow.BlackoutWindow{
+		Type:           "RECURRING",
+		Timezone:       "Europe/Berlin",
+		Weekdays:       []string{"MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY"},
+		DailyStartTime: "18:00",
+		DailyEndTime:   "09:00",
+	}
+
+	tests := []struct {
+		name     string
+		window   dbBlackoutWindow.BlackoutWindow
+		now      time.Time
+		expected bool
+	}{
+		{
+			name:     "during a one-off window",
+			window:   oneOff,
+			now:      time.Date(2024, 12, 24, 12, 0, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			name:     "at the end of a one-off window",
+			window:   oneOff,
+			now:      time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC),
+			expected: false,
+		},
+		{
+			name:     "during business hours",
+			window:   afterHours,
+			now:      time.Date(2024, 3, 6, 12, 0, 0, 0, location),
+			expected: false,
+		},
+		{
+			name:     "on the evening of a weekday",
+			window:   afterHours,
+			now:      time.Date(2024, 3, 6, 20, 0, 0, 0, location),
+			expected: true,
+		},
+		{
+			name:     "on the morning after a weekday",
+			window:   afterHours,
+			now:      time.Date(2024, 3, 9, 8, 0, 0, 0, location),
+			expected: true,
+		},
+		{
+			name:     "on the morning after a weekend day",
+			window:   afterHours,
+			now:      time.Date(2024, 3, 10, 8, 0, 0, 0, location),
+			expected: false,
+		},
+		{
+			name:     "in another timezone",
+			window:   afterHours,
+			now:      time.Date(2024, 3, 6, 18, 30, 0, 0, time.UTC),
+			expected: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			active := blackout_window.IsActive(tc.window, tc.now)
+
+			// then
+			assert.Equal(t, tc.expected, active)
+		})
+	}
+}
+
+// TestValidate is used to test the validation of the fields required by the blackout window types
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		window  dbBlackoutWindow.BlackoutWindow
+		isError bool
+	}{
+		{
+			name:   "valid recurring window",
+			window: dbBlackoutWindow.BlackoutWindow{Name: "weekend", Type: "RECURRING", Timezone: "UTC", Weekdays: []string{"SATURDAY"}, DailyStartTime: "00:00", DailyEndTime: "00:00"},
+		},
+		{
+			name:    "invalid timezone",
+			window:  dbBlackoutWindow.BlackoutWindow{Name: "weekend", Type: "RECURRING", Timezone: "Mars/Olympus", Weekdays: []string{"SATURDAY"}, DailyStartTime: "00:00", DailyEndTime: "00:00"},
+			isError: true,
+		},
+		{
+			name:    "recurring window without weekdays",
+			window:  dbBlackoutWindow.BlackoutWindow{Name: "weekend", Type: "RECURRING", Timezone: "UTC", DailyStartTime: "00:00", DailyEndTime: "00:00"},
+			isError: true,
+		},
+		{
+			name:    "one-off window ending before its start",
+			window:  dbBlackoutWindow.BlackoutWindow{Name: "freeze", Type: "ONE_OFF", Timezone: "UTC", StartTime: 2000, EndTime: 1000},
+			isError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			err := blackout_window.Validate(tc.window)
+
+			// then
+			if tc.isError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
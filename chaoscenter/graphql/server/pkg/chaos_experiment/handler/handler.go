@@ -26,6 +26,7 @@ import (
 	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run"
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 
 	"github.com/google/uuid"
 )
@@ -101,11 +102,13 @@ func (c *ChaosExperimentHandler) SaveChaosExperiment(ctx context.Context, reques
 	if wfDetails.ExperimentID == request.ID {
 		logrus.WithFields(logFields).Info("request received to update k8s chaos experiment")
 
-		err = c.chaosExperimentService.ProcessExperimentUpdate(newRequest, username, wfType, revID, false, projectID, nil)
+		err = c.chaosExperimentService.ProcessExperimentUpdate(ctx, newRequest, username, wfType, revID, false, projectID, nil)
 		if err != nil {
 			return "", err
 		}
 
+		c.syncExperimentToGit(projectID, newRequest)
+
 		return "experiment updated successfully", nil
 	}
 
@@ -117,6 +120,8 @@ func (c *ChaosExperimentHandler) SaveChaosExperiment(ctx context.Context, reques
 		return "", err
 	}
 
+	c.syncExperimentToGit(projectID, newRequest)
+
 	return "experiment saved successfully", nil
 }
 
@@ -151,6 +156,8 @@ func (c *ChaosExperimentHandler) CreateChaosExperiment(ctx context.Context, requ
 		return nil, err
 	}
 
+	c.syncExperimentToGit(projectID, newRequest)
+
 	return &model.ChaosExperimentResponse{
 		ExperimentID:          *newRequest.ExperimentID,
 		CronSyntax:            newRequest.CronSyntax,
@@ -190,11 +197,18 @@ func (c *ChaosExperimentHandler) DeleteChaosExperiment(ctx context.Context, proj
 			}
 		}
 		// Delete experiment
-		err = c.chaosExperimentService.ProcessExperimentDelete(query, workflow, uid, r)
+		err = c.chaosExperimentService.ProcessExperimentDelete(ctx, query, workflow, uid, r)
 		if err != nil {
 			return false, err
 		}
 
+		err = c.gitOpsService.DeleteExperimentFromGit(ctx, projectID, &model.ChaosExperimentRequest{
+			ExperimentID:   &workflow.ExperimentID,
+			ExperimentName: workflow.Name,
+			InfraID:        workflow.InfraID,
+		})
+		c.recordGitSyncError(ctx, projectID, workflow.ExperimentID, "failed to delete experiment from git", err)
+
 	} else if workflowRunID != nil && *workflowRunID != "" {
 		query := bson.D{
 			{"experiment_id", workflowID},
@@ -227,11 +241,13 @@ func (c *ChaosExperimentHandler) UpdateChaosExperiment(ctx context.Context, requ
 	}
 	tkn := ctx.Value(authorization.AuthKey).(string)
 	uid, err := authorization.GetUsername(tkn)
-	err = c.chaosExperimentService.ProcessExperimentUpdate(newRequest, uid, wfType, revID, false, projectID, r)
+	err = c.chaosExperimentService.ProcessExperimentUpdate(ctx, newRequest, uid, wfType, revID, false, projectID, r)
 	if err != nil {
 		return nil, err
 	}
 
+	c.syncExperimentToGit(projectID, newRequest)
+
 	return &model.ChaosExperimentResponse{
 		ExperimentID:          *newRequest.ExperimentID,
 		CronSyntax:            newRequest.CronSyntax,
@@ -410,6 +426,7 @@ func (c *ChaosExperimentHandler) GetExperiment(ctx context.Context, projectID st
 			CronSyntax:         exp.CronSyntax,
 			Description:        exp.Description,
 			Weightages:         weightages,
+			ScoringPolicy:      getScoringPolicy(exp.Revision[len(exp.Revision)-1].ScoringPolicy),
 			IsCustomExperiment: exp.IsCustomExperiment,
 			UpdatedAt:          strconv.FormatInt(exp.UpdatedAt, 10),
 			CreatedAt:          strconv.FormatInt(exp.CreatedAt, 10),
@@ -424,6 +441,8 @@ func (c *ChaosExperimentHandler) GetExperiment(ctx context.Context, projectID st
 				Username: exp.UpdatedBy,
 			},
 			RecentExperimentRunDetails: recentExpRuns,
+			PendingReview:              getPendingReview(exp.PendingReview),
+			GitSyncError:               getGitSyncError(exp.GitSyncError),
 		},
 		AverageResiliencyScore: &avg,
 	}
@@ -766,6 +785,7 @@ func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.
 			CronSyntax:         workflow.CronSyntax,
 			Description:        workflow.Description,
 			Weightages:         weightages,
+			ScoringPolicy:      getScoringPolicy(workflow.Revision[len(workflow.Revision)-1].ScoringPolicy),
 			IsCustomExperiment: workflow.IsCustomExperiment,
 			UpdatedAt:          strconv.FormatInt(workflow.UpdatedAt, 10),
 			CreatedAt:          strconv.FormatInt(workflow.CreatedAt, 10),
@@ -780,6 +800,8 @@ func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.
 				Username: workflow.UpdatedBy,
 			},
 			RecentExperimentRunDetails: recentExpRuns,
+			PendingReview:              getPendingReview(workflow.PendingReview),
+			GitSyncError:               getGitSyncError(workflow.GitSyncError),
 		}
 		result = append(result, &newChaosExperiments)
 
@@ -944,6 +966,143 @@ func (c *ChaosExperimentHandler) getWfRunDetails(workflowIDs []string) (map[stri
 	return lastRunDetails, nil
 }
 
+// UpdateScoringPolicy sets the scoring policy of the latest revision of the experiment and recalculates
+// the resiliency score of its completed runs if requested
+func (c *ChaosExperimentHandler) UpdateScoringPolicy(ctx context.Context, projectID string, experimentID string, policy model.ScoringPolicyInput, recompute bool) (bool, error) {
+	scoringPolicy, err := types.NewScoringPolicy(&policy)
+	if err != nil {
+		return false, err
+	}
+
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return false, err
+	}
+	if len(experiment.Revision) == 0 {
+		return false, errors.New("no revision found for experiment: " + experimentID)
+	}
+
+	username, err := authorization.GetUsername(ctx.Value(authorization.AuthKey).(string))
+	if err != nil {
+		return false, err
+	}
+
+	latestRevision := &experiment.Revision[len(experiment.Revision)-1]
+	err = c.chaosExperimentOperator.UpdateChaosExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"revision.revision_id", latestRevision.RevisionID},
+	}, bson.D{
+		{"$set", bson.D{
+			{"revision.$.scoring_policy", scoringPolicy},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", username},
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	if recompute {
+		latestRevision.ScoringPolicy = scoringPolicy
+		_, err = c.chaosExperimentRunService.ProcessExperimentRunsRescore(ctx, experiment)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// RecomputeResiliencyScores recalculates the resiliency score of the completed runs of the experiment
+func (c *ChaosExperimentHandler) RecomputeResiliencyScores(ctx context.Context, projectID string, experimentID string) (int, error) {
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return c.chaosExperimentRunService.ProcessExperimentRunsRescore(ctx, experiment)
+}
+
+// getScoringPolicy converts the scoring policy stored with a revision to its graphql model
+func getScoringPolicy(policy *dbChaosExperiment.ScoringPolicy) *model.ScoringPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	scoringPolicy := &model.ScoringPolicy{
+		Strategy: model.ScoringStrategy(policy.Strategy),
+	}
+	for _, threshold := range policy.FaultThresholds {
+		scoringPolicy.FaultThresholds = append(scoringPolicy.FaultThresholds, &model.FaultThreshold{
+			FaultName:              threshold.FaultName,
+			ProbeSuccessPercentage: threshold.ProbeSuccessPercentage,
+		})
+	}
+	return scoringPolicy
+}
+
+// syncExperimentToGit opens a pull request with the saved experiment when its infra is synced with git in the pull
+// request mode. It runs in the background as the clone, the push and the call to the git provider can take long, a
+// failure is recorded on the experiment as it is already saved in the DB
+func (c *ChaosExperimentHandler) syncExperimentToGit(projectID string, experiment *model.ChaosExperimentRequest) {
+	go func() {
+		ctx := context.Background()
+		err := c.gitOpsService.UpsertExperimentToGit(ctx, projectID, experiment)
+		c.recordGitSyncError(ctx, projectID, *experiment.ExperimentID, "failed to write experiment to git", err)
+	}()
+}
+
+// recordGitSyncError stores the error of the last git sync of the experiment, or clears it if the sync succeeded
+func (c *ChaosExperimentHandler) recordGitSyncError(ctx context.Context, projectID, experimentID, message string, syncErr error) {
+	query := bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+	}
+	update := bson.D{{"$unset", bson.D{{"git_sync_error", ""}}}}
+	if syncErr == nil {
+		query = append(query, bson.E{Key: "git_sync_error", Value: bson.D{{"$exists", true}}})
+	} else {
+		logrus.WithFields(logrus.Fields{
+			"projectId":    projectID,
+			"experimentId": experimentID,
+		}).Error(message, " : ", syncErr)
+		update = bson.D{{"$set", bson.D{{"git_sync_error", message + ": " + syncErr.Error()}}}}
+	}
+
+	err := c.chaosExperimentOperator.UpdateChaosExperiment(ctx, query, update)
+	if err != nil {
+		logrus.Error("failed to record the git sync error of experiment ", experimentID, " : ", err)
+	}
+}
+
+func getPendingReview(review *dbChaosExperiment.PendingReview) *model.PendingReview {
+	if review == nil {
+		return nil
+	}
+
+	return &model.PendingReview{
+		Number:   review.Number,
+		URL:      review.URL,
+		Branch:   review.Branch,
+		OpenedAt: strconv.FormatInt(review.OpenedAt, 10),
+	}
+}
+
+func getGitSyncError(gitSyncError string) *string {
+	if gitSyncError == "" {
+		return nil
+	}
+	return &gitSyncError
+}
+
 func (c *ChaosExperimentHandler) DisableCronExperiment(username string, experiment dbChaosExperiment.ChaosExperimentRequest, projectID string, r *store.StateData) error {
 	workflowManifest, err := sjson.Set(experiment.Revision[len(experiment.Revision)-1].ExperimentManifest, "spec.suspend", true)
 	if err != nil {
@@ -966,7 +1125,7 @@ func (c *ChaosExperimentHandler) DisableCronExperiment(username string, experime
 		revisionID = experiment.Revision[len(experiment.Revision)-1].RevisionID
 	}
 
-	err = c.chaosExperimentService.ProcessExperimentUpdate(&model.ChaosExperimentRequest{
+	err = c.chaosExperimentService.ProcessExperimentUpdate(context.Background(), &model.ChaosExperimentRequest{
 		ExperimentID:          &experiment.ExperimentID,
 		ExperimentManifest:    workflowManifest,
 		ExperimentName:        experiment.Name,
@@ -1137,17 +1296,14 @@ func (c *ChaosExperimentHandler) GetLogs(reqID string, pod model.PodLogRequest,
 			ExternalData: &externalData,
 		},
 	}
-	if clusterChan, ok := r.ConnectedInfra[pod.InfraID]; ok {
-		clusterChan <- &payload
-	} else if reqChan, ok := r.ExperimentLog[reqID]; ok {
-		resp := model.PodLogResponse{
+	if !r.SendInfraAction(pod.InfraID, &payload) {
+		r.SendPodLog(reqID, &model.PodLogResponse{
 			PodName:         pod.PodName,
 			ExperimentRunID: pod.ExperimentRunID,
 			PodType:         pod.PodType,
 			Log:             "INFRA ERROR : INFRA NOT CONNECTED",
-		}
-		reqChan <- &resp
-		close(reqChan)
+			IsFinal:         true,
+		})
 	}
 }
 
@@ -1165,16 +1321,96 @@ func (c *ChaosExperimentHandler) GetKubeObjData(reqID string, kubeObject model.K
 			ExternalData: &externalData,
 		},
 	}
-	if clusterChan, ok := r.ConnectedInfra[kubeObject.InfraID]; ok {
-		clusterChan <- &payload
-	} else if reqChan, ok := r.KubeObjectData[reqID]; ok {
-		resp := model.KubeObjectResponse{
+	if !r.SendInfraAction(kubeObject.InfraID, &payload) {
+		r.SendKubeObject(reqID, &model.KubeObjectResponse{
 			InfraID: kubeObject.InfraID,
 			KubeObj: []*model.KubeObject{},
-		}
-		reqChan <- &resp
-		close(reqChan)
+		})
+	}
+}
+
+// dryRunTimeout is the time for which the infra is awaited to dry-run the manifests of an experiment
+const dryRunTimeout = 30 * time.Second
+
+// ValidateChaosExperiment validates the experiment manifest against the faults available in the chaos hubs of the
+// project and, if requested, dry-runs the manifests on the infra
+func (c *ChaosExperimentHandler) ValidateChaosExperiment(ctx context.Context, projectID string, request model.ValidateChaosExperimentRequest, hubFaults map[string][]string, r *store.StateData) (*model.ExperimentValidationResponse, error) {
+	response, manifests := types.ValidateExperimentManifest(request.Manifest, hubFaults)
+	if request.DryRun == nil || !*request.DryRun || len(manifests) == 0 {
+		return response, nil
 	}
+
+	if request.InfraID == nil || *request.InfraID == "" {
+		return nil, errors.New("infraID is required to dry-run the experiment")
+	}
+	infra, err := c.infrastructureService.GetInfra(ctx, projectID, *request.InfraID)
+	if err != nil {
+		return nil, errors.New("failed to get infra details: " + err.Error())
+	}
+	if !infra.IsActive {
+		return nil, errors.New("dry-run failed due to inactive infra")
+	}
+
+	dryRunRequest := types.DryRunRequest{Manifests: manifests}
+	if infra.InfraNamespace != nil {
+		dryRunRequest.Namespace = *infra.InfraNamespace
+	}
+	data, err := json.Marshal(dryRunRequest)
+	if err != nil {
+		return nil, err
+	}
+	externalData := string(data)
+
+	reqID := uuid.New().String()
+	resultChan := make(chan *model.DryRunResultRequest, 1)
+	r.Mutex.Lock()
+	r.DryRunResults[reqID] = resultChan
+	r.Mutex.Unlock()
+	defer func() {
+		r.Mutex.Lock()
+		delete(r.DryRunResults, reqID)
+		r.Mutex.Unlock()
+	}()
+
+	payload := model.InfraActionResponse{
+		ProjectID: projectID,
+		Action: &model.ActionPayload{
+			RequestID:    reqID,
+			RequestType:  "dry_run",
+			ExternalData: &externalData,
+			TraceContext: tracing.ActionTraceContext(ctx),
+		},
+	}
+	if !r.SendInfraAction(*request.InfraID, &payload) {
+		return nil, errors.New("dry-run failed as the infra is not connected")
+	}
+
+	var result *model.DryRunResultRequest
+	select {
+	case result = <-resultChan:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(dryRunTimeout):
+		return nil, errors.New("timed out waiting for the dry-run results of the infra")
+	}
+
+	var dryRunResults []types.DryRunResult
+	if err := json.Unmarshal([]byte(result.Results), &dryRunResults); err != nil {
+		return nil, errors.New("failed to parse the dry-run results: " + err.Error())
+	}
+	for _, dryRunResult := range dryRunResults {
+		if dryRunResult.Error == "" {
+			continue
+		}
+		response.IsValid = false
+		response.Issues = append(response.Issues, &model.ExperimentValidationIssue{
+			Severity: model.ValidationSeverityError,
+			Path:     "dryRun." + dryRunResult.Kind + "/" + dryRunResult.Name,
+			Message:  dryRunResult.Error,
+		})
+	}
+	response.DryRunPerformed = true
+	return response, nil
 }
 
 func (c *ChaosExperimentHandler) GetDBExperiment(query bson.D) (dbChaosExperiment.ChaosExperimentRequest, error) {
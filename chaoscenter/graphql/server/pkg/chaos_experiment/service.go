@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"time"
@@ -36,8 +37,8 @@ import (
 type Service interface {
 	ProcessExperiment(workflow *model.ChaosExperimentRequest, projectID string, revID string) (*model.ChaosExperimentRequest, *dbChaosExperiment.ChaosExperimentType, error)
 	ProcessExperimentCreation(ctx context.Context, input *model.ChaosExperimentRequest, username string, projectID string, wfType *dbChaosExperiment.ChaosExperimentType, revisionID string, r *store.StateData) error
-	ProcessExperimentUpdate(workflow *model.ChaosExperimentRequest, username string, wfType *dbChaosExperiment.ChaosExperimentType, revisionID string, updateRevision bool, projectID string, r *store.StateData) error
-	ProcessExperimentDelete(query bson.D, workflow dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error
+	ProcessExperimentUpdate(ctx context.Context, workflow *model.ChaosExperimentRequest, username string, wfType *dbChaosExperiment.ChaosExperimentType, revisionID string, updateRevision bool, projectID string, r *store.StateData) error
+	ProcessExperimentDelete(ctx context.Context, query bson.D, workflow dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error
 }
 
 // chaosWorkflowService is the implementation of the chaos workflow service
@@ -140,12 +141,40 @@ func (c *chaosExperimentService) ProcessExperiment(workflow *model.ChaosExperime
 	return workflow, &wfType, nil
 }
 
+// NewScoringPolicy validates the scoring policy input and converts it to the form stored with the experiment revision
+func NewScoringPolicy(input *model.ScoringPolicyInput) (*dbChaosExperiment.ScoringPolicy, error) {
+	if input == nil {
+		return nil, nil
+	}
+	if !input.Strategy.IsValid() {
+		return nil, fmt.Errorf("invalid scoring strategy %s", input.Strategy)
+	}
+
+	policy := &dbChaosExperiment.ScoringPolicy{
+		Strategy: input.Strategy.String(),
+	}
+	for _, threshold := range input.FaultThresholds {
+		if threshold.ProbeSuccessPercentage < 0 || threshold.ProbeSuccessPercentage > 100 {
+			return nil, fmt.Errorf("probe success percentage threshold of fault %s should be between 0 and 100", threshold.FaultName)
+		}
+		policy.FaultThresholds = append(policy.FaultThresholds, &dbChaosExperiment.FaultThreshold{
+			FaultName:              threshold.FaultName,
+			ProbeSuccessPercentage: threshold.ProbeSuccessPercentage,
+		})
+	}
+	return policy, nil
+}
+
 // ProcessExperimentCreation creates new workflow entry and sends the workflow to the specific chaos_infra for execution
 func (c *chaosExperimentService) ProcessExperimentCreation(ctx context.Context, input *model.ChaosExperimentRequest, username string, projectID string, wfType *dbChaosExperiment.ChaosExperimentType, revisionID string, r *store.StateData) error {
 	var (
 		weightages []*dbChaosExperiment.WeightagesInput
 		revision   []dbChaosExperiment.ExperimentRevision
 	)
+	scoringPolicy, err := NewScoringPolicy(input.ScoringPolicy)
+	if err != nil {
+		return err
+	}
 	if input.Weightages != nil {
 		//TODO: Once we make the new chaos terminology change in APIs, then we can we the copier instead of for loop
 		for _, v := range input.Weightages {
@@ -163,6 +192,7 @@ func (c *chaosExperimentService) ProcessExperimentCreation(ctx context.Context,
 		ExperimentManifest: input.ExperimentManifest,
 		UpdatedAt:          timeNow,
 		Weightages:         weightages,
+		ScoringPolicy:      scoringPolicy,
 	})
 
 	newChaosExperiment := dbChaosExperiment.ChaosExperimentRequest{
@@ -188,23 +218,38 @@ func (c *chaosExperimentService) ProcessExperimentCreation(ctx context.Context,
 		RecentExperimentRunDetails: []dbChaosExperiment.ExperimentRunDetail{},
 	}
 
-	err := c.chaosExperimentOperator.InsertChaosExperiment(ctx, newChaosExperiment)
+	err = c.chaosExperimentOperator.InsertChaosExperiment(ctx, newChaosExperiment)
 	if err != nil {
 		return err
 	}
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, input, &username, nil, "create", r)
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, input, &username, nil, "create", r)
 	}
 	return nil
 }
 
 // ProcessExperimentUpdate updates the workflow entry and sends update resource request to required agent
-func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosExperimentRequest, username string, wfType *dbChaosExperiment.ChaosExperimentType, revisionID string, updateRevision bool, projectID string, r *store.StateData) error {
+func (c *chaosExperimentService) ProcessExperimentUpdate(ctx context.Context, workflow *model.ChaosExperimentRequest, username string, wfType *dbChaosExperiment.ChaosExperimentType, revisionID string, updateRevision bool, projectID string, r *store.StateData) error {
 	var (
 		weightages  []*dbChaosExperiment.WeightagesInput
 		workflowObj unstructured.Unstructured
 	)
 
+	scoringPolicy, err := NewScoringPolicy(workflow.ScoringPolicy)
+	if err != nil {
+		return err
+	}
+	// the scoring policy is carried over from the previous revision unless a new one is provided
+	if scoringPolicy == nil && !updateRevision {
+		experiment, err := c.chaosExperimentOperator.GetExperiment(context.Background(), bson.D{
+			{"experiment_id", workflow.ExperimentID},
+			{"project_id", projectID},
+		})
+		if err == nil && len(experiment.Revision) > 0 {
+			scoringPolicy = experiment.Revision[len(experiment.Revision)-1].ScoringPolicy
+		}
+	}
+
 	if workflow.Weightages != nil {
 		//TODO: Once we make the new chaos terminology change in APIs, then we can use the copier instead of for loop
 		for _, v := range workflow.Weightages {
@@ -220,6 +265,7 @@ func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosEx
 		ExperimentManifest: workflow.ExperimentManifest,
 		UpdatedAt:          time.Now().UnixMilli(),
 		Weightages:         weightages,
+		ScoringPolicy:      scoringPolicy,
 	}
 
 	query := bson.D{
@@ -260,7 +306,7 @@ func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosEx
 		}
 	}
 
-	err := c.chaosExperimentOperator.UpdateChaosExperiment(context.Background(), query, update)
+	err = c.chaosExperimentOperator.UpdateChaosExperiment(context.Background(), query, update)
 	if err != nil {
 		return err
 	}
@@ -271,18 +317,17 @@ func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosEx
 	}
 
 	if /* strings.ToLower(workflowObj.GetKind()) == "cronworkflow" */ r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, workflow, &username, nil, "update", r)
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, workflow, &username, nil, "update", r)
 	}
 	return nil
 }
 
 // ProcessExperimentDelete deletes the workflow entry and sends delete resource request to required chaos_infra
-func (c *chaosExperimentService) ProcessExperimentDelete(query bson.D, workflow dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error {
+func (c *chaosExperimentService) ProcessExperimentDelete(ctx context.Context, query bson.D, workflow dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error {
 	var (
 		wc      = writeconcern.New(writeconcern.WMajority())
 		rc      = readconcern.Snapshot()
 		txnOpts = options.Transaction().SetWriteConcern(wc).SetReadConcern(rc)
-		ctx     = context.Background()
 	)
 
 	session, err := mongodb.MgoClient.StartSession()
@@ -328,7 +373,7 @@ func (c *chaosExperimentService) ProcessExperimentDelete(query bson.D, workflow
 
 	session.EndSession(ctx)
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(workflow.ProjectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, workflow.ProjectID, &model.ChaosExperimentRequest{
 			InfraID: workflow.InfraID,
 		}, &username, &workflow.ExperimentID, "workflow_delete", r)
 	}
@@ -128,3 +128,16 @@ type FaultDetailsForAnalytics struct {
 	TotalProbes int                        `json:"total_probes"`
 	Probes      []ProbeDetailsForAnalytics `json:"probes"`
 }
+
+// DryRunRequest is the request sent to the subscriber to dry-run the manifests of an experiment
+type DryRunRequest struct {
+	Namespace string   `json:"namespace"`
+	Manifests []string `json:"manifests"`
+}
+
+// DryRunResult is the result of dry-running a manifest on the infra, Error is empty if the manifest was accepted
+type DryRunResult struct {
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
@@ -0,0 +1,432 @@
+package chaos_experiment
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/ghodss/yaml"
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	documentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+	// workflowParameter matches the workflow parameters used in the embedded manifests, for example {{ workflow.parameters.adminModeNamespace }}
+	workflowParameter = regexp.MustCompile(`\{\{\s*workflow\.parameters\.([\w-]+)\s*\}\}`)
+
+	probeModes         = map[string]bool{"SOT": true, "EOT": true, "Edge": true, "Continuous": true, "OnChaos": true}
+	k8sProbeOperations = map[string]bool{"create": true, "delete": true, "present": true, "absent": true}
+)
+
+// probeRefAnnotation references the probes of the project which are attached to the chaos engine
+const probeRefAnnotation = "probeRef"
+
+// chaosEngine holds the fields of the chaos engine which are validated, it is decoded separately from the operator
+// types so that manifests of newer operator versions, which changed the type of some probe fields, can be validated
+type chaosEngine struct {
+	Metadata struct {
+		Name         string            `json:"name"`
+		GenerateName string            `json:"generateName"`
+		Annotations  map[string]string `json:"annotations"`
+	} `json:"metadata"`
+	Spec chaosEngineSpec `json:"spec"`
+}
+
+type chaosEngineSpec struct {
+	EngineState string `json:"engineState"`
+	Experiments []struct {
+		Name string `json:"name"`
+		Spec struct {
+			Probe []engineProbe `json:"probe"`
+		} `json:"spec"`
+	} `json:"experiments"`
+}
+
+type engineProbe struct {
+	Name            string                      `json:"name"`
+	Type            string                      `json:"type"`
+	Mode            string                      `json:"mode"`
+	RunProperties   map[string]interface{}      `json:"runProperties"`
+	K8sProbeInputs  *chaosTypes.K8sProbeInputs  `json:"k8sProbe/inputs"`
+	HTTPProbeInputs *chaosTypes.HTTPProbeInputs `json:"httpProbe/inputs"`
+	CmdProbeInputs  *struct {
+		Command string `json:"command"`
+	} `json:"cmdProbe/inputs"`
+	PromProbeInputs *chaosTypes.PromProbeInputs `json:"promProbe/inputs"`
+}
+
+type probeRef struct {
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+}
+
+type engineFault struct {
+	path string
+	name string
+}
+
+// manifestValidator collects the issues found while walking through an experiment manifest
+type manifestValidator struct {
+	response  *model.ExperimentValidationResponse
+	hubFaults map[string][]string
+	// installsFaults is set for experiments which install the ChaosExperiment CRs of their faults
+	installsFaults  bool
+	installedFaults map[string]bool
+	engineFaults    []engineFault
+	dryRunManifests []string
+}
+
+// ValidateExperimentManifest validates the experiment manifest along with the chaos engines and probes embedded in it.
+// hubFaults maps the faults available in the chaos hubs of the project to the hubs providing them. It returns the
+// validation result and the manifests which are to be dry-run on the infra.
+func ValidateExperimentManifest(manifest string, hubFaults map[string][]string) (*model.ExperimentValidationResponse, []string) {
+	v := &manifestValidator{
+		response: &model.ExperimentValidationResponse{
+			Faults: []string{},
+			Issues: []*model.ExperimentValidationIssue{},
+		},
+		hubFaults:       hubFaults,
+		installedFaults: map[string]bool{},
+	}
+
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		v.addIssue(model.ValidationSeverityError, "", "failed to parse the manifest: "+err.Error())
+		return v.result()
+	}
+
+	var objMeta unstructured.Unstructured
+	if err := objMeta.UnmarshalJSON(data); err != nil {
+		v.addIssue(model.ValidationSeverityError, "", "failed to parse the manifest: "+err.Error())
+		return v.result()
+	}
+	v.response.Kind = objMeta.GetKind()
+	if objMeta.GetName() == "" && objMeta.GetGenerateName() == "" {
+		v.addIssue(model.ValidationSeverityError, "metadata.name", "name of the "+objMeta.GetKind()+" is not set")
+	}
+
+	switch strings.ToLower(objMeta.GetKind()) {
+	case "workflow":
+		var workflow v1alpha1.Workflow
+		if err := json.Unmarshal(data, &workflow); err != nil {
+			v.addIssue(model.ValidationSeverityError, "", "failed to parse the workflow: "+err.Error())
+			break
+		}
+		v.installsFaults = true
+		v.dryRunManifests = append(v.dryRunManifests, string(data))
+		v.validateWorkflowSpec("spec", workflow.Spec)
+	case "cronworkflow":
+		var cronWorkflow v1alpha1.CronWorkflow
+		if err := json.Unmarshal(data, &cronWorkflow); err != nil {
+			v.addIssue(model.ValidationSeverityError, "", "failed to parse the cron workflow: "+err.Error())
+			break
+		}
+		if strings.TrimSpace(cronWorkflow.Spec.Schedule) == "" {
+			v.addIssue(model.ValidationSeverityError, "spec.schedule", "schedule of the cron workflow is not set")
+		}
+		v.installsFaults = true
+		v.dryRunManifests = append(v.dryRunManifests, string(data))
+		v.validateWorkflowSpec("spec.workflowSpec", cronWorkflow.Spec.WorkflowSpec)
+	case "chaosengine":
+		v.dryRunManifests = append(v.dryRunManifests, string(data))
+		v.validateChaosEngine("", data)
+	case "chaosschedule":
+		var schedule struct {
+			Spec struct {
+				EngineTemplateSpec chaosEngineSpec `json:"engineTemplateSpec"`
+			} `json:"spec"`
+		}
+		if err := json.Unmarshal(data, &schedule); err != nil {
+			v.addIssue(model.ValidationSeverityError, "", "failed to parse the chaos schedule: "+err.Error())
+			break
+		}
+		v.dryRunManifests = append(v.dryRunManifests, string(data))
+		v.validateChaosEngineSpec("spec.engineTemplateSpec", schedule.Spec.EngineTemplateSpec)
+	default:
+		v.addIssue(model.ValidationSeverityError, "kind", "not a valid object, only workflows/cron workflows/chaos engines/chaos schedules supported")
+	}
+
+	v.validateFaults()
+	return v.result()
+}
+
+func (v *manifestValidator) addIssue(severity model.ValidationSeverity, path string, message string) {
+	v.response.Issues = append(v.response.Issues, &model.ExperimentValidationIssue{
+		Severity: severity,
+		Path:     path,
+		Message:  message,
+	})
+}
+
+func (v *manifestValidator) result() (*model.ExperimentValidationResponse, []string) {
+	v.response.IsValid = true
+	for _, issue := range v.response.Issues {
+		if issue.Severity == model.ValidationSeverityError {
+			v.response.IsValid = false
+			break
+		}
+	}
+	sort.Strings(v.response.Faults)
+	return v.response, v.dryRunManifests
+}
+
+// validateWorkflowSpec checks the templates referenced by the workflow and validates the manifests embedded in them
+func (v *manifestValidator) validateWorkflowSpec(path string, spec v1alpha1.WorkflowSpec) {
+	parameters := map[string]string{}
+	for _, parameter := range spec.Arguments.Parameters {
+		if parameter.Value != nil {
+			parameters[parameter.Name] = parameter.Value.String()
+		}
+	}
+
+	templates := map[string]bool{}
+	for _, template := range spec.Templates {
+		templates[template.Name] = true
+	}
+	if spec.Entrypoint == "" {
+		v.addIssue(model.ValidationSeverityError, path+".entrypoint", "entrypoint of the workflow is not set")
+	} else if !templates[spec.Entrypoint] {
+		v.addIssue(model.ValidationSeverityError, path+".entrypoint", "entrypoint template "+spec.Entrypoint+" doesn't exist")
+	}
+
+	for _, template := range spec.Templates {
+		templatePath := fmt.Sprintf("%s.templates[%s]", path, template.Name)
+		for i, parallelSteps := range template.Steps {
+			for j, step := range parallelSteps.Steps {
+				if step.Template != "" && step.TemplateRef == nil && !templates[step.Template] {
+					v.addIssue(model.ValidationSeverityError, fmt.Sprintf("%s.steps[%d][%d]", templatePath, i, j), "template "+step.Template+" of step "+step.Name+" doesn't exist")
+				}
+			}
+		}
+		if template.DAG != nil {
+			for i, task := range template.DAG.Tasks {
+				if task.Template != "" && task.TemplateRef == nil && !templates[task.Template] {
+					v.addIssue(model.ValidationSeverityError, fmt.Sprintf("%s.dag.tasks[%d]", templatePath, i), "template "+task.Template+" of task "+task.Name+" doesn't exist")
+				}
+			}
+		}
+
+		for i, artifact := range template.Inputs.Artifacts {
+			if artifact.Raw == nil {
+				continue
+			}
+			for j, document := range documentSeparator.Split(artifact.Raw.Data, -1) {
+				if strings.TrimSpace(document) == "" {
+					continue
+				}
+				v.validateEmbeddedManifest(fmt.Sprintf("%s.inputs.artifacts[%d].raw[%d]", templatePath, i, j), resolveParameters(document, parameters))
+			}
+		}
+	}
+}
+
+// validateEmbeddedManifest validates the chaos engines and records the ChaosExperiment CRs installed by the workflow,
+// other manifests are only dry-run
+func (v *manifestValidator) validateEmbeddedManifest(path string, manifest string) {
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	if err != nil {
+		v.addIssue(model.ValidationSeverityError, path, "failed to parse the embedded manifest: "+err.Error())
+		return
+	}
+	var objMeta unstructured.Unstructured
+	if err := objMeta.UnmarshalJSON(data); err != nil {
+		// artifacts may carry data other than kubernetes manifests
+		return
+	}
+
+	switch strings.ToLower(objMeta.GetKind()) {
+	case "chaosengine":
+		v.validateChaosEngine(path, data)
+	case "chaosexperiment":
+		v.installedFaults[objMeta.GetName()] = true
+	default:
+		return
+	}
+	v.dryRunManifests = append(v.dryRunManifests, string(data))
+}
+
+func (v *manifestValidator) validateChaosEngine(path string, data []byte) {
+	var engine chaosEngine
+	if err := json.Unmarshal(data, &engine); err != nil {
+		v.addIssue(model.ValidationSeverityError, path, "failed to parse the chaos engine: "+err.Error())
+		return
+	}
+	if engine.Metadata.Name == "" && engine.Metadata.GenerateName == "" {
+		v.addIssue(model.ValidationSeverityError, joinPath(path, "metadata.name"), "name of the chaos engine is not set")
+	}
+	v.validateChaosEngineSpec(joinPath(path, "spec"), engine.Spec)
+
+	if refs, ok := engine.Metadata.Annotations[probeRefAnnotation]; ok {
+		refPath := joinPath(path, "metadata.annotations."+probeRefAnnotation)
+		var probeRefs []probeRef
+		if err := json.Unmarshal([]byte(refs), &probeRefs); err != nil {
+			v.addIssue(model.ValidationSeverityError, refPath, "failed to parse the probe references: "+err.Error())
+			return
+		}
+		for i, ref := range probeRefs {
+			if ref.Name == "" {
+				v.addIssue(model.ValidationSeverityError, fmt.Sprintf("%s[%d]", refPath, i), "name of the probe is not set")
+			}
+			if !probeModes[ref.Mode] {
+				v.addIssue(model.ValidationSeverityError, fmt.Sprintf("%s[%d]", refPath, i), "invalid probe mode "+ref.Mode)
+			}
+		}
+	}
+}
+
+func (v *manifestValidator) validateChaosEngineSpec(path string, spec chaosEngineSpec) {
+	switch spec.EngineState {
+	case "", string(chaosTypes.EngineStateActive), string(chaosTypes.EngineStateStop):
+	default:
+		v.addIssue(model.ValidationSeverityError, path+".engineState", "invalid engine state "+spec.EngineState)
+	}
+
+	if len(spec.Experiments) == 0 {
+		v.addIssue(model.ValidationSeverityError, path+".experiments", "no experiments specified in the chaos engine")
+		return
+	}
+	for i, experiment := range spec.Experiments {
+		experimentPath := fmt.Sprintf("%s.experiments[%d]", path, i)
+		if experiment.Name == "" {
+			v.addIssue(model.ValidationSeverityError, experimentPath+".name", "empty chaos experiment name")
+		} else {
+			v.engineFaults = append(v.engineFaults, engineFault{path: experimentPath + ".name", name: experiment.Name})
+		}
+
+		probeNames := map[string]bool{}
+		for j, probe := range experiment.Spec.Probe {
+			probePath := fmt.Sprintf("%s.spec.probe[%d]", experimentPath, j)
+			if probeNames[probe.Name] {
+				v.addIssue(model.ValidationSeverityError, probePath+".name", "duplicate probe name "+probe.Name)
+			}
+			probeNames[probe.Name] = true
+			v.validateProbe(probePath, probe)
+		}
+	}
+}
+
+func (v *manifestValidator) validateProbe(path string, probe engineProbe) {
+	if probe.Name == "" {
+		v.addIssue(model.ValidationSeverityError, path+".name", "name of the probe is not set")
+	}
+	if !probeModes[probe.Mode] {
+		v.addIssue(model.ValidationSeverityError, path+".mode", "invalid probe mode "+probe.Mode)
+	}
+	for _, property := range []string{"probeTimeout", "interval"} {
+		if !isPositiveDuration(probe.RunProperties[property]) {
+			v.addIssue(model.ValidationSeverityError, path+".runProperties."+property, property+" of the probe must be a positive duration")
+		}
+	}
+
+	switch probe.Type {
+	case "httpProbe":
+		inputs := probe.HTTPProbeInputs
+		if inputs == nil {
+			v.addIssue(model.ValidationSeverityError, path+".httpProbe/inputs", "inputs of the http probe are not set")
+			return
+		}
+		if inputs.URL == "" {
+			v.addIssue(model.ValidationSeverityError, path+".httpProbe/inputs.url", "url of the http probe is not set")
+		}
+		hasGet := inputs.Method.Get != (chaosTypes.GetMethod{})
+		hasPost := inputs.Method.Post != (chaosTypes.PostMethod{})
+		if hasGet == hasPost {
+			v.addIssue(model.ValidationSeverityError, path+".httpProbe/inputs.method", "exactly one of the get or post methods must be set")
+		}
+	case "cmdProbe":
+		if probe.CmdProbeInputs == nil || probe.CmdProbeInputs.Command == "" {
+			v.addIssue(model.ValidationSeverityError, path+".cmdProbe/inputs.command", "command of the cmd probe is not set")
+		}
+	case "k8sProbe":
+		inputs := probe.K8sProbeInputs
+		if inputs == nil {
+			v.addIssue(model.ValidationSeverityError, path+".k8sProbe/inputs", "inputs of the k8s probe are not set")
+			return
+		}
+		if inputs.Version == "" || inputs.Resource == "" {
+			v.addIssue(model.ValidationSeverityError, path+".k8sProbe/inputs", "version and resource of the k8s probe must be set")
+		}
+		if !k8sProbeOperations[inputs.Operation] {
+			v.addIssue(model.ValidationSeverityError, path+".k8sProbe/inputs.operation", "invalid k8s probe operation "+inputs.Operation)
+		}
+	case "promProbe":
+		inputs := probe.PromProbeInputs
+		if inputs == nil {
+			v.addIssue(model.ValidationSeverityError, path+".promProbe/inputs", "inputs of the prom probe are not set")
+			return
+		}
+		if inputs.Endpoint == "" {
+			v.addIssue(model.ValidationSeverityError, path+".promProbe/inputs.endpoint", "endpoint of the prom probe is not set")
+		}
+		if inputs.Query == "" && inputs.QueryPath == "" {
+			v.addIssue(model.ValidationSeverityError, path+".promProbe/inputs", "either query or queryPath of the prom probe must be set")
+		}
+	default:
+		v.addIssue(model.ValidationSeverityError, path+".type", "unsupported probe type "+probe.Type)
+	}
+}
+
+// validateFaults checks that the faults referenced by the chaos engines are either installed by the experiment
+// or available in the chaos hubs of the project
+func (v *manifestValidator) validateFaults() {
+	seen := map[string]bool{}
+	for _, fault := range v.engineFaults {
+		if !seen[fault.name] {
+			seen[fault.name] = true
+			v.response.Faults = append(v.response.Faults, fault.name)
+		}
+
+		_, inHub := v.hubFaults[fault.name]
+		installed := v.installedFaults[fault.name]
+		switch {
+		case !v.installsFaults && !inHub:
+			v.addIssue(model.ValidationSeverityWarning, fault.path, "fault "+fault.name+" is not available in the chaos hubs of the project, its ChaosExperiment CR must be installed on the infra")
+		case v.installsFaults && !installed && !inHub:
+			v.addIssue(model.ValidationSeverityError, fault.path, "ChaosExperiment CR of fault "+fault.name+" is neither installed by the experiment nor available in the chaos hubs of the project")
+		case v.installsFaults && !installed:
+			v.addIssue(model.ValidationSeverityWarning, fault.path, "ChaosExperiment CR of fault "+fault.name+" is not installed by the experiment")
+		}
+	}
+}
+
+// resolveParameters replaces the workflow parameters used in the embedded manifest with their values, templates
+// which can't be resolved are stripped as they are when the experiment is processed
+func resolveParameters(manifest string, parameters map[string]string) string {
+	manifest = workflowParameter.ReplaceAllStringFunc(manifest, func(match string) string {
+		if value, ok := parameters[workflowParameter.FindStringSubmatch(match)[1]]; ok {
+			return value
+		}
+		return match
+	})
+	manifest = strings.ReplaceAll(manifest, "{{", "")
+	return strings.ReplaceAll(manifest, "}}", "")
+}
+
+// isPositiveDuration accepts the probe durations as seconds, which older operators use, or as duration strings
+func isPositiveDuration(value interface{}) bool {
+	switch value := value.(type) {
+	case float64:
+		return value > 0
+	case string:
+		if seconds, err := strconv.Atoi(value); err == nil {
+			return seconds > 0
+		}
+		duration, err := time.ParseDuration(value)
+		return err == nil && duration > 0
+	}
+	return false
+}
+
+func joinPath(path string, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
@@ -0,0 +1,189 @@
+package chaos_experiment_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	chaos_experiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment"
+	"github.com/stretchr/testify/assert"
+)
+
+const workflowManifest = `
+apiVersion: argoproj.io/v1alpha1
+kind: Workflow
+metadata:
+  name: pod-delete-experiment
+spec:
+  entrypoint: chaos
+  arguments:
+    parameters:
+      - name: adminModeNamespace
+        value: litmus
+  templates:
+    - name: chaos
+      steps:
+        - - name: install-chaos-faults
+            template: install-chaos-faults
+        - - name: pod-delete
+            template: pod-delete
+    - name: install-chaos-faults
+      inputs:
+        artifacts:
+          - name: pod-delete
+            path: /tmp/pod-delete.yaml
+            raw:
+              data: |
+                apiVersion: litmuschaos.io/v1alpha1
+                kind: ChaosExperiment
+                metadata:
+                  name: pod-delete
+    - name: pod-delete
+      inputs:
+        artifacts:
+          - name: pod-delete
+            path: /tmp/chaosengine.yaml
+            raw:
+              data: |
+                apiVersion: litmuschaos.io/v1alpha1
+                kind: ChaosEngine
+                metadata:
+                  namespace: "{{workflow.parameters.adminModeNamespace}}"
+                  generateName: pod-delete
+                spec:
+                  engineState: active
+                  experiments:
+                    - name: pod-delete
+                      spec:
+                        probe:
+                          - name: check-frontend
+                            type: httpProbe
+                            mode: Continuous
+                            httpProbe/inputs:
+                              url: http://frontend
+                              method:
+                                get:
+                                  criteria: ==
+                                  responseCode: "200"
+                            runProperties:
+                              probeTimeout: 5s
+                              interval: 2
+`
+
+const chaosEngineManifest = `
+apiVersion: litmuschaos.io/v1alpha1
+kind: ChaosEngine
+metadata:
+  name: node-drain
+spec:
+  engineState: active
+  experiments:
+    - name: node-drain
+`
+
+func issueMessages(response *model.ExperimentValidationResponse, severity model.ValidationSeverity) []string {
+	var messages []string
+	for _, issue := range response.Issues {
+		if issue.Severity == severity {
+			messages = append(messages, issue.Path+": "+issue.Message)
+		}
+	}
+	return messages
+}
+
+// TestValidateExperimentManifestValidWorkflow is used to test that a valid workflow passes and its embedded manifests are dry-run
+func TestValidateExperimentManifestValidWorkflow(t *testing.T) {
+	// given
+	hubFaults := map[string][]string{"pod-delete": {"Litmus ChaosHub"}}
+
+	// when
+	response, manifests := chaos_experiment.ValidateExperimentManifest(workflowManifest, hubFaults)
+
+	// then
+	assert.True(t, response.IsValid)
+	assert.Empty(t, response.Issues)
+	assert.Equal(t, "Workflow", response.Kind)
+	assert.Equal(t, []string{"pod-delete"}, response.Faults)
+	assert.Len(t, manifests, 3)
+	assert.Contains(t, manifests[2], `"namespace":"litmus"`)
+}
+
+// TestValidateExperimentManifestInvalidProbes is used to test that every invalid probe field is reported
+func TestValidateExperimentManifestInvalidProbes(t *testing.T) {
+	// given
+	manifest := chaosEngineManifest + `
+      spec:
+        probe:
+          - name: check-frontend
+            type: httpProbe
+            mode: Always
+            httpProbe/inputs:
+              method:
+                get:
+                  responseCode: "200"
+                post:
+                  body: "{}"
+            runProperties:
+              probeTimeout: 5s
+          - name: check-frontend
+            type: tcpProbe
+            mode: SOT
+            runProperties:
+              probeTimeout: 5
+              interval: 0
+`
+
+	// when
+	response, _ := chaos_experiment.ValidateExperimentManifest(manifest, map[string][]string{"node-drain": {"Litmus ChaosHub"}})
+
+	// then
+	assert.False(t, response.IsValid)
+	assert.ElementsMatch(t, []string{
+		"spec.experiments[0].spec.probe[0].mode: invalid probe mode Always",
+		"spec.experiments[0].spec.probe[0].runProperties.interval: interval of the probe must be a positive duration",
+		"spec.experiments[0].spec.probe[0].httpProbe/inputs.url: url of the http probe is not set",
+		"spec.experiments[0].spec.probe[0].httpProbe/inputs.method: exactly one of the get or post methods must be set",
+		"spec.experiments[0].spec.probe[1].name: duplicate probe name check-frontend",
+		"spec.experiments[0].spec.probe[1].runProperties.interval: interval of the probe must be a positive duration",
+		"spec.experiments[0].spec.probe[1].type: unsupported probe type tcpProbe",
+	}, issueMessages(response, model.ValidationSeverityError))
+}
+
+// TestValidateExperimentManifestMissingFault is used to test that workflows must install or find the faults they run
+func TestValidateExperimentManifestMissingFault(t *testing.T) {
+	// given
+	manifest := strings.Replace(workflowManifest, "  name: pod-delete\n    - name: pod-delete", "  name: pod-cpu-hog\n    - name: pod-delete", 1)
+	manifest = strings.Replace(manifest, "entrypoint: chaos", "entrypoint: run-chaos", 1)
+
+	// when
+	response, _ := chaos_experiment.ValidateExperimentManifest(manifest, map[string][]string{})
+
+	// then
+	assert.False(t, response.IsValid)
+	assert.ElementsMatch(t, []string{
+		"spec.entrypoint: entrypoint template run-chaos doesn't exist",
+		"spec.templates[pod-delete].inputs.artifacts[0].raw[0].spec.experiments[0].name: ChaosExperiment CR of fault pod-delete is neither installed by the experiment nor available in the chaos hubs of the project",
+	}, issueMessages(response, model.ValidationSeverityError))
+}
+
+// TestValidateExperimentManifestStandaloneEngine is used to test that faults of chaos engines missing from the hubs are only warned about
+func TestValidateExperimentManifestStandaloneEngine(t *testing.T) {
+	// when
+	response, manifests := chaos_experiment.ValidateExperimentManifest(chaosEngineManifest, map[string][]string{})
+
+	// then
+	assert.True(t, response.IsValid)
+	assert.Len(t, issueMessages(response, model.ValidationSeverityWarning), 1)
+	assert.Len(t, manifests, 1)
+}
+
+// TestValidateExperimentManifestUnsupportedKind is used to test that only experiment kinds are accepted
+func TestValidateExperimentManifestUnsupportedKind(t *testing.T) {
+	// when
+	response, manifests := chaos_experiment.ValidateExperimentManifest(`{"kind": "Deployment", "metadata": {"name": "frontend"}}`, nil)
+
+	// then
+	assert.False(t, response.IsValid)
+	assert.Equal(t, "Deployment", response.Kind)
+	assert.Empty(t, manifests)
+}
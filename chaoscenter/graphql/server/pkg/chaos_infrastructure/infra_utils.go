@@ -1,6 +1,7 @@
 package chaos_infrastructure
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ghodss/yaml"
@@ -8,6 +9,7 @@ import (
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/k8s"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
@@ -222,7 +224,7 @@ func ManifestParser(infra dbChaosInfra.ChaosInfra, rootPath string, config *Subs
 }
 
 // SendRequestToSubscriber sends events from the graphQL server to the subscribers listening for the requests
-func SendRequestToSubscriber(subscriberRequest SubscriberRequests, r store.StateData) {
+func SendRequestToSubscriber(ctx context.Context, subscriberRequest SubscriberRequests, r store.StateData) bool {
 	if utils.Config.InfraScope == string(model.InfraScopeCluster) {
 		/*
 			namespace = Obtain from WorkflowManifest or
@@ -238,24 +240,22 @@ func SendRequestToSubscriber(subscriberRequest SubscriberRequests, r store.State
 			RequestType:  subscriberRequest.RequestType,
 			ExternalData: subscriberRequest.ExternalData,
 			Username:     subscriberRequest.Username,
+			TraceContext: tracing.ActionTraceContext(ctx),
 		},
 	}
 
-	r.Mutex.Lock()
-	if observer, ok := r.ConnectedInfra[subscriberRequest.InfraID]; ok {
-		observer <- newAction
-	}
-	r.Mutex.Unlock()
+	return r.SendInfraAction(subscriberRequest.InfraID, newAction)
 }
 
-// SendExperimentToSubscriber sends the workflow to the subscriber to be handled
-func SendExperimentToSubscriber(projectID string, workflow *model.ChaosExperimentRequest, username *string, externalData *string, reqType string, r *store.StateData) {
+// SendExperimentToSubscriber sends the workflow to the subscriber to be handled, it returns false if the infra isn't
+// connected
+func SendExperimentToSubscriber(ctx context.Context, projectID string, workflow *model.ChaosExperimentRequest, username *string, externalData *string, reqType string, r *store.StateData) bool {
 	workflowNamespace := gjson.Get(workflow.ExperimentManifest, "metadata.namespace").String()
 
 	if workflowNamespace == "" {
 		workflowNamespace = utils.Config.InfraNamespace
 	}
-	SendRequestToSubscriber(SubscriberRequests{
+	return SendRequestToSubscriber(ctx, SubscriberRequests{
 		K8sManifest:  workflow.ExperimentManifest,
 		RequestType:  reqType,
 		ProjectID:    projectID,
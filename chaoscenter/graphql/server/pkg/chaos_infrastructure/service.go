@@ -23,6 +23,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -53,18 +54,24 @@ type Service interface {
 	QueryServerVersion(ctx context.Context) (*model.ServerVersionResponse, error)
 	PodLog(request model.PodLog, r store.StateData) (string, error)
 	KubeObj(request model.KubeObjectData, r store.StateData) (string, error)
+	DryRunResult(request model.DryRunResultRequest, r store.StateData) (string, error)
 	UpdateInfra(query bson.D, update bson.D) error
 	GetDBInfra(infraID string) (dbChaosInfra.ChaosInfra, error)
+	InfraHeartbeat(request model.InfraIdentity) (bool, error)
+	SweepInactiveInfras(ctx context.Context, timeout time.Duration, r store.StateData) error
+	RecurringInactiveInfraSweep(timeout time.Duration, r store.StateData)
 }
 
 type infraService struct {
-	infraOperator *dbChaosInfra.Operator
+	infraOperator       *dbChaosInfra.Operator
+	notificationService notification.Service
 }
 
 // NewChaosInfrastructureService returns a new instance of Service
-func NewChaosInfrastructureService(infraOperator *dbChaosInfra.Operator) Service {
+func NewChaosInfrastructureService(infraOperator *dbChaosInfra.Operator, notificationService notification.Service) Service {
 	return &infraService{
-		infraOperator: infraOperator,
+		infraOperator:       infraOperator,
+		notificationService: notificationService,
 	}
 }
 
@@ -254,7 +261,7 @@ func (in *infraService) DeleteInfra(ctx context.Context, projectID string, infra
 	}
 
 	for _, request := range requests {
-		SendRequestToSubscriber(SubscriberRequests{
+		SendRequestToSubscriber(ctx, SubscriberRequests{
 			K8sManifest: request,
 			RequestType: "delete",
 			ProjectID:   infra.ProjectID,
@@ -363,6 +370,7 @@ func (in *infraService) GetInfra(ctx context.Context, projectID string, infraID
 			ServiceAccount:   infra.ServiceAccount,
 			InfraScope:       infra.InfraScope,
 			StartTime:        infra.StartTime,
+			LastHeartbeat:    formatHeartbeat(infra.LastHeartbeatAt),
 			Version:          infra.Version,
 			Tags:             infra.Tags,
 			CreatedBy:        &model.UserDetails{Username: username},
@@ -669,6 +677,7 @@ func (in *infraService) ListInfras(projectID string, request *model.ListInfraReq
 			ServiceAccount:   infra.ServiceAccount,
 			InfraScope:       infra.InfraScope,
 			StartTime:        infra.StartTime,
+			LastHeartbeat:    formatHeartbeat(infra.LastHeartbeatAt),
 			Version:          infra.Version,
 			Tags:             infra.Tags,
 			IsRemoved:        infra.IsRemoved,
@@ -925,15 +934,15 @@ func (in *infraService) PodLog(request model.PodLog, r store.StateData) (string,
 		log.Print("ERROR", err)
 		return "", err
 	}
-	if reqChan, ok := r.ExperimentLog[request.RequestID]; ok {
-		resp := model.PodLogResponse{
-			PodName:         request.PodName,
-			ExperimentRunID: request.ExperimentRunID,
-			PodType:         request.PodType,
-			Log:             request.Log,
-		}
-		reqChan <- &resp
-		close(reqChan)
+	resp := model.PodLogResponse{
+		PodName:         request.PodName,
+		ExperimentRunID: request.ExperimentRunID,
+		PodType:         request.PodType,
+		Log:             request.Log,
+		// logs which aren't streamed are sent in a single chunk
+		IsFinal: request.IsFinal == nil || *request.IsFinal,
+	}
+	if r.SendPodLog(request.RequestID, &resp) {
 		return "LOGS SENT SUCCESSFULLY", nil
 	}
 	return "LOG REQUEST CANCELLED", nil
@@ -946,24 +955,33 @@ func (in *infraService) KubeObj(request model.KubeObjectData, r store.StateData)
 		log.Print("Error", err)
 		return "", err
 	}
-	if reqChan, ok := r.KubeObjectData[request.RequestID]; ok {
-		var kubeObjData []*model.KubeObject
-		err = json.Unmarshal([]byte(request.KubeObj), &kubeObjData)
-		if err != nil {
-			return "", fmt.Errorf("failed to unmarshal kubeObj data %w", err)
-		}
+	var kubeObjData []*model.KubeObject
+	err = json.Unmarshal([]byte(request.KubeObj), &kubeObjData)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal kubeObj data %w", err)
+	}
 
-		resp := model.KubeObjectResponse{
-			InfraID: request.InfraID.InfraID,
-			KubeObj: kubeObjData,
-		}
-		reqChan <- &resp
-		close(reqChan)
-		return "KubeData sent successfully", nil
+	resp := model.KubeObjectResponse{
+		InfraID: request.InfraID.InfraID,
+		KubeObj: kubeObjData,
 	}
+	r.SendKubeObject(request.RequestID, &resp)
 	return "KubeData sent successfully", nil
 }
 
+// DryRunResult receives the dry-run results of experiment manifests from subscriber
+func (in *infraService) DryRunResult(request model.DryRunResultRequest, r store.StateData) (string, error) {
+	_, err := in.VerifyInfra(*request.InfraID)
+	if err != nil {
+		log.Print("Error", err)
+		return "", err
+	}
+	if r.SendDryRunResult(request.RequestID, &request) {
+		return "dry-run results sent successfully", nil
+	}
+	return "DRY-RUN REQUEST CANCELLED", nil
+}
+
 // SendInfraEvent sends events from the infras to the appropriate users listening for the events
 func (in *infraService) SendInfraEvent(eventType, eventName, description string, infra model.Infra, r store.StateData) {
 	newEvent := model.InfraEventResponse{
@@ -973,13 +991,98 @@ func (in *infraService) SendInfraEvent(eventType, eventName, description string,
 		Description: description,
 		Infra:       &infra,
 	}
-	r.Mutex.Lock()
-	if r.InfraEventPublish != nil {
-		for _, observer := range r.InfraEventPublish[infra.ProjectID] {
-			observer <- &newEvent
+	r.PublishInfraEvent(infra.ProjectID, &newEvent)
+
+	var notificationType model.NotificationEventType
+	switch eventName {
+	case "Infra Live":
+		notificationType = model.NotificationEventTypeInfraConnected
+	case "Infra Offline":
+		notificationType = model.NotificationEventTypeInfraDisconnected
+	default:
+		return
+	}
+	in.notificationService.Notify(context.Background(), notification.Event{
+		EventID:       newEvent.EventID,
+		Type:          notificationType,
+		ProjectID:     infra.ProjectID,
+		Tags:          infra.Tags,
+		EnvironmentID: infra.EnvironmentID,
+		InfraID:       infra.InfraID,
+		InfraName:     infra.Name,
+		Message:       description,
+	})
+}
+
+// InfraHeartbeat records the heartbeat of an infra, the heartbeat doesn't mark the infra active as it is sent over
+// http while the actions are sent over the connection of the infra, inactive infras are marked active when they connect
+func (in *infraService) InfraHeartbeat(request model.InfraIdentity) (bool, error) {
+	_, err := in.VerifyInfra(request)
+	if err != nil {
+		return false, err
+	}
+
+	query := bson.D{{"infra_id", request.InfraID}}
+	update := bson.D{{"$set", bson.D{{"last_heartbeat", time.Now().UnixMilli()}}}}
+	err = in.infraOperator.UpdateInfra(context.TODO(), query, update)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// SweepInactiveInfras marks the active infras which haven't sent a heartbeat within the timeout as inactive and closes
+// their connection to this server replica, so that the live infras reconnect and are marked active again. Infras which
+// never sent a heartbeat are left to the state of their connection
+func (in *infraService) SweepInactiveInfras(ctx context.Context, timeout time.Duration, r store.StateData) error {
+	cutoff := time.Now().Add(-timeout).UnixMilli()
+	// the infras which are already inactive are swept as well, as their connection may be held by another replica
+	query := bson.D{
+		{"is_removed", false},
+		{"last_heartbeat", bson.D{{"$lt", cutoff}}},
+	}
+	infras, err := in.infraOperator.GetInfras(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	for _, infra := range infras {
+		if r.DisconnectInfra(infra.InfraID) {
+			logrus.WithField("infraId", infra.InfraID).Info("closed the connection of the infra after it missed its heartbeats")
+		}
+		if !infra.IsActive {
+			continue
+		}
+
+		err = in.infraOperator.UpdateInfra(ctx, bson.D{
+			{"infra_id", infra.InfraID},
+			{"is_active", true},
+			{"last_heartbeat", bson.D{{"$lt", cutoff}}},
+		}, bson.D{{"$set", bson.D{{"is_active", false}, {"updated_at", time.Now().UnixMilli()}}}})
+		if err != nil {
+			logrus.WithField("infraId", infra.InfraID).Errorf("failed to mark the infra inactive, error: %v", err)
+			continue
+		}
+		logrus.WithField("infraId", infra.InfraID).Info("infra marked inactive after missing its heartbeats")
+
+		infra.IsActive = false
+		newInfra := model.Infra{}
+		copier.Copy(&newInfra, &infra)
+		in.SendInfraEvent("infra-status", "Infra Offline", "Infra missed its heartbeats", newInfra, r)
+	}
+	return nil
+}
+
+// RecurringInactiveInfraSweep periodically marks the infras which stopped sending heartbeats as inactive
+func (in *infraService) RecurringInactiveInfraSweep(timeout time.Duration, r store.StateData) {
+	ticker := time.NewTicker(timeout / 2)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := in.SweepInactiveInfras(context.Background(), timeout, r); err != nil {
+			logrus.Errorf("failed to sweep inactive infras, error: %v", err)
 		}
 	}
-	r.Mutex.Unlock()
 }
 
 // ConfirmInfraRegistration takes the cluster_id and access_key from the subscriber and validates it, if validated generates and sends new access_key
@@ -1148,3 +1251,11 @@ func (c *infraService) UpdateInfra(query bson.D, update bson.D) error {
 func (c *infraService) GetDBInfra(infraID string) (dbChaosInfra.ChaosInfra, error) {
 	return c.infraOperator.GetInfra(infraID)
 }
+
+func formatHeartbeat(lastHeartbeatAt int64) *string {
+	if lastHeartbeatAt == 0 {
+		return nil
+	}
+	lastHeartbeat := strconv.FormatInt(lastHeartbeatAt, 10)
+	return &lastHeartbeat
+}
@@ -0,0 +1,155 @@
+package chaos_infrastructure_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// infraOperator returns the infra for every query and records the updates
+type infraOperator struct {
+	mongodb.MongoOperator
+	infra   dbChaosInfra.ChaosInfra
+	updates []bson.D
+}
+
+func (o *infraOperator) Get(ctx context.Context, collectionType int, query bson.D) (*mongo.SingleResult, error) {
+	return mongo.NewSingleResultFromDocument(o.infra, nil, nil), nil
+}
+
+func (o *infraOperator) List(ctx context.Context, collectionType int, query bson.D, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	return mongo.NewCursorFromDocuments([]interface{}{o.infra}, nil, nil)
+}
+
+func (o *infraOperator) UpdateMany(ctx context.Context, collectionType int, query, update bson.D, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	o.updates = append(o.updates, update)
+	return &mongo.UpdateResult{}, nil
+}
+
+// notificationService ignores the notifications of the infra events
+type notificationService struct {
+	notification.Service
+}
+
+func (n *notificationService) Notify(ctx context.Context, event notification.Event) {}
+
+func newInfraService(infra dbChaosInfra.ChaosInfra) (chaos_infrastructure.Service, *infraOperator) {
+	operator := &infraOperator{infra: infra}
+	mongodb.Operator = operator
+	return chaos_infrastructure.NewChaosInfrastructureService(dbChaosInfra.NewInfrastructureOperator(operator), &notificationService{}), operator
+}
+
+// TestSweepInactiveInfras is used to test that the infras which missed their heartbeats are disconnected, so that the
+// live ones reconnect, and that only the active ones are marked inactive
+func TestSweepInactiveInfras(t *testing.T) {
+	tests := []struct {
+		name             string
+		isActive         bool
+		expectedInactive bool
+	}{
+		{
+			name:             "active infra",
+			isActive:         true,
+			expectedInactive: true,
+		},
+		{
+			name:     "infra already marked inactive by another replica",
+			isActive: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			service, operator := newInfraService(dbChaosInfra.ChaosInfra{
+				ProjectID:       "project-1",
+				InfraID:         "infra-1",
+				IsActive:        tc.isActive,
+				LastHeartbeatAt: time.Now().Add(-time.Hour).UnixMilli(),
+			})
+			state := store.NewStore()
+			infraAction := make(chan *model.InfraActionResponse, 1)
+			state.ConnectedInfra["infra-1"] = infraAction
+			infraEvents := make(chan *model.InfraEventResponse, 1)
+			state.InfraEventPublish["project-1"] = []chan *model.InfraEventResponse{infraEvents}
+
+			// when
+			err := service.SweepInactiveInfras(context.Background(), time.Minute, *state)
+
+			// then
+			assert.NoError(t, err)
+			assert.NotContains(t, state.ConnectedInfra, "infra-1")
+			_, open := <-infraAction
+			assert.False(t, open)
+			if tc.expectedInactive {
+				assert.Len(t, operator.updates, 1)
+				assert.Equal(t, bson.E{"is_active", false}, operator.updates[0].Map()["$set"].(bson.D)[0])
+				assert.Equal(t, "Infra Offline", (<-infraEvents).EventName)
+			} else {
+				assert.Empty(t, operator.updates)
+				assert.Empty(t, infraEvents)
+			}
+		})
+	}
+}
+
+// TestInfraHeartbeat is used to test that the heartbeats of the verified infras only record the time of the heartbeat
+func TestInfraHeartbeat(t *testing.T) {
+	tests := []struct {
+		name           string
+		accessKey      string
+		expectedResult bool
+	}{
+		{
+			name:           "verified infra",
+			accessKey:      "access-key",
+			expectedResult: true,
+		},
+		{
+			name:      "wrong access key",
+			accessKey: "other-key",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			utils.Config.Version = "3.0.0"
+			service, operator := newInfraService(dbChaosInfra.ChaosInfra{
+				InfraID:      "infra-1",
+				AccessKey:    "access-key",
+				IsRegistered: true,
+			})
+
+			// when
+			result, err := service.InfraHeartbeat(model.InfraIdentity{
+				InfraID:   "infra-1",
+				AccessKey: tc.accessKey,
+				Version:   "3.0.0",
+			})
+
+			// then
+			assert.Equal(t, tc.expectedResult, result)
+			if !tc.expectedResult {
+				assert.Error(t, err)
+				assert.Empty(t, operator.updates)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, operator.updates, 1)
+			set := operator.updates[0].Map()["$set"].(bson.D)
+			assert.Len(t, set, 1)
+			assert.Equal(t, "last_heartbeat", set[0].Key)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	chaosHubOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub/ops"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 	"go.mongodb.org/mongo-driver/mongo"
 
@@ -30,6 +31,8 @@ const (
 	DefaultPath                = "/tmp/"
 	DefaultHubID               = "6f39cea9-6264-4951-83a8-29976b614289"
 	DefaultHubSyncTimeInterval = 6 * time.Hour
+	// defaultHubType labels syncs of the default hub in the hub sync metrics
+	defaultHubType = "DEFAULT"
 )
 
 type Service interface {
@@ -50,6 +53,7 @@ type Service interface {
 	RecurringHubSync()
 	SyncDefaultChaosHubs()
 	GetChaosHubStats(ctx context.Context, projectID string) (*model.GetChaosHubStatsResponse, error)
+	ListHubFaults(ctx context.Context, projectID string) (map[string][]string, error)
 }
 
 type chaosHubService struct {
@@ -279,14 +283,12 @@ func (c *chaosHubService) SyncChaosHub(ctx context.Context, hubID string, projec
 
 	if chaosHub.HubType == string(model.HubTypeRemote) {
 		err = handler.SyncRemoteRepo(syncHubInput, projectID)
-		if err != nil {
-			return "", err
-		}
 	} else {
 		err = chaosHubOps.GitSyncHandlerForProjects(syncHubInput, projectID)
-		if err != nil {
-			return "", err
-		}
+	}
+	metrics.ObserveHubSync(chaosHub.HubType, err)
+	if err != nil {
+		return "", err
 	}
 	// Updating the last_synced_at time using hubID
 	err = c.chaosHubOperator.UpdateChaosHub(ctx, query, update)
@@ -930,16 +932,15 @@ func (c *chaosHubService) RecurringHubSync() {
 					SSHPrivateKey: chaosHub.SSHPrivateKey,
 					IsDefault:     false,
 				}
+				var err error
 				if chaosHub.HubType != model.HubTypeRemote {
-					err := chaosHubOps.GitSyncHandlerForProjects(chartsInput, chaosHub.ProjectID)
-					if err != nil {
-						log.Error(err)
-					}
+					err = chaosHubOps.GitSyncHandlerForProjects(chartsInput, chaosHub.ProjectID)
 				} else {
-					err := handler.SyncRemoteRepo(chartsInput, chaosHub.ProjectID)
-					if err != nil {
-						log.Error(err)
-					}
+					err = handler.SyncRemoteRepo(chartsInput, chaosHub.ProjectID)
+				}
+				metrics.ObserveHubSync(string(chaosHub.HubType), err)
+				if err != nil {
+					log.Error(err)
 				}
 			}
 		}
@@ -990,6 +991,41 @@ func (c *chaosHubService) GetChaosHubStats(ctx context.Context, projectID string
 
 }
 
+// ListHubFaults returns the faults available in the chaos hubs of the project, including the default hub,
+// mapped to the names of the hubs providing them
+func (c *chaosHubService) ListHubFaults(ctx context.Context, projectID string) (map[string][]string, error) {
+	hubs, err := c.chaosHubOperator.GetChaosHubByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultHub := c.listDefaultHubs()
+	hubNames := []string{defaultHub.Name}
+	chartPaths := []string{DefaultPath + "default/" + defaultHub.Name + "/faults/"}
+	for _, hub := range hubs {
+		hubNames = append(hubNames, hub.Name)
+		chartPaths = append(chartPaths, DefaultPath+projectID+"/"+hub.Name+"/faults/")
+	}
+
+	faults := make(map[string][]string)
+	for i, chartPath := range chartPaths {
+		chartsData, err := handler.GetChartsData(chartPath)
+		if err != nil {
+			// hubs which are yet to be synced don't provide any faults
+			continue
+		}
+		for _, chart := range chartsData {
+			if chart == nil || chart.Spec == nil {
+				continue
+			}
+			for _, fault := range chart.Spec.Faults {
+				faults[fault.Name] = append(faults[fault.Name], hubNames[i])
+			}
+		}
+	}
+	return faults, nil
+}
+
 func (c *chaosHubService) listDefaultHubs() *model.ChaosHub {
 	defaultHubs := &model.ChaosHub{
 		ID:         DefaultHubID,
@@ -1013,6 +1049,7 @@ func (c *chaosHubService) SyncDefaultChaosHubs() {
 			IsDefault:  true,
 		}
 		err := chaosHubOps.GitSyncDefaultHub(chartsInput)
+		metrics.ObserveHubSync(defaultHubType, err)
 		if err != nil {
 			log.WithFields(log.Fields{
 				"repoUrl":    defaultHub.RepoURL,
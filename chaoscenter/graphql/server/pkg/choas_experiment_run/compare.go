@@ -0,0 +1,162 @@
+package chaos_experiment_run
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// RevisionManifests maps the revision IDs of the compared experiment runs to their experiment manifests
+type RevisionManifests map[string]string
+
+// CompareExperimentRuns compares the experiment runs, in the given order, fault by fault and diffs the
+// manifests of the revisions used by consecutive runs
+func CompareExperimentRuns(experimentRuns []dbChaosExperimentRun.ChaosExperimentRun, manifests RevisionManifests) *model.ExperimentRunComparison {
+	comparison := &model.ExperimentRunComparison{
+		ExperimentRuns: []*model.ComparedExperimentRun{},
+		Faults:         []*model.FaultComparison{},
+		ManifestDiffs:  []*model.RevisionManifestDiff{},
+	}
+
+	faultResults := map[string][]*model.FaultRunResult{}
+	for i, experimentRun := range experimentRuns {
+		var executionData ExecutionData
+		// runs without execution data are compared as if none of their faults were executed
+		_ = json.Unmarshal([]byte(experimentRun.ExecutionData), &executionData)
+
+		comparison.ExperimentRuns = append(comparison.ExperimentRuns, &model.ComparedExperimentRun{
+			ExperimentRunID: experimentRun.ExperimentRunID,
+			ExperimentID:    experimentRun.ExperimentID,
+			RevisionID:      experimentRun.RevisionID,
+			Phase:           model.ExperimentRunStatus(experimentRun.Phase),
+			ResiliencyScore: experimentRun.ResiliencyScore,
+			Duration:        duration(executionData.StartedAt, executionData.FinishedAt),
+		})
+
+		for _, node := range executionData.Nodes {
+			if node.Type != "ChaosEngine" || node.ChaosExp == nil {
+				continue
+			}
+			// standalone chaos engines are identified by the name of their fault
+			faultName := node.FaultName
+			if faultName == "" {
+				faultName = node.ChaosExp.FaultName
+			}
+			if _, ok := faultResults[faultName]; !ok {
+				faultResults[faultName] = make([]*model.FaultRunResult, len(experimentRuns))
+			}
+
+			result := &model.FaultRunResult{
+				ExperimentRunID: experimentRun.ExperimentRunID,
+				Executed:        true,
+				Duration:        duration(node.StartedAt, node.FinishedAt),
+			}
+			if node.ChaosExp.FaultVerdict != "" {
+				verdict := node.ChaosExp.FaultVerdict
+				result.Verdict = &verdict
+			}
+			if probeSuccessPercentage, err := strconv.ParseFloat(node.ChaosExp.ProbeSuccessPercentage, 64); err == nil {
+				result.ProbeSuccessPercentage = &probeSuccessPercentage
+			}
+			faultResults[faultName][i] = result
+		}
+	}
+
+	faultNames := make([]string, 0, len(faultResults))
+	for faultName := range faultResults {
+		faultNames = append(faultNames, faultName)
+	}
+	sort.Strings(faultNames)
+
+	for _, faultName := range faultNames {
+		results := faultResults[faultName]
+		faultComparison := &model.FaultComparison{
+			FaultName: faultName,
+			Results:   results,
+		}
+
+		var (
+			verdicts    = map[string]bool{}
+			first, last *float64
+			notExecuted bool
+		)
+		for i, result := range results {
+			if result == nil {
+				results[i] = &model.FaultRunResult{ExperimentRunID: experimentRuns[i].ExperimentRunID}
+				notExecuted = true
+				continue
+			}
+			if result.Verdict != nil {
+				verdicts[*result.Verdict] = true
+			}
+			if result.ProbeSuccessPercentage != nil {
+				if first == nil {
+					first = result.ProbeSuccessPercentage
+				}
+				last = result.ProbeSuccessPercentage
+			}
+		}
+		faultComparison.VerdictChanged = notExecuted || len(verdicts) > 1
+		if first != nil && last != nil {
+			delta := *last - *first
+			faultComparison.ProbeSuccessPercentageDelta = &delta
+		}
+		comparison.Faults = append(comparison.Faults, faultComparison)
+	}
+
+	diffed := map[[2]string]bool{}
+	for i := 1; i < len(experimentRuns); i++ {
+		from, to := experimentRuns[i-1].RevisionID, experimentRuns[i].RevisionID
+		if from == to || diffed[[2]string{from, to}] {
+			continue
+		}
+		diffed[[2]string{from, to}] = true
+
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(manifestToYAML(manifests[from])),
+			B:        difflib.SplitLines(manifestToYAML(manifests[to])),
+			FromFile: from,
+			ToFile:   to,
+			Context:  3,
+		})
+		if err != nil {
+			continue
+		}
+		comparison.ManifestDiffs = append(comparison.ManifestDiffs, &model.RevisionManifestDiff{
+			FromRevisionID: from,
+			ToRevisionID:   to,
+			Diff:           diff,
+		})
+	}
+
+	return comparison
+}
+
+// duration returns the seconds elapsed between the unix timestamps, nil if the step has not finished
+func duration(startedAt string, finishedAt string) *int {
+	start, err := strconv.ParseInt(startedAt, 10, 64)
+	if err != nil {
+		return nil
+	}
+	finish, err := strconv.ParseInt(finishedAt, 10, 64)
+	if err != nil || finish < start {
+		return nil
+	}
+	seconds := int(finish - start)
+	return &seconds
+}
+
+// manifestToYAML formats the manifest as YAML so that its diff is line based, manifests which can't be
+// converted are diffed as is
+func manifestToYAML(manifest string) string {
+	formatted, err := yaml.JSONToYAML([]byte(manifest))
+	if err != nil {
+		return manifest
+	}
+	return string(formatted)
+}
@@ -0,0 +1,92 @@
+package chaos_experiment_run_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/stretchr/testify/assert"
+)
+
+func comparedExperimentRun(t *testing.T, experimentRunID string, revisionID string, nodes map[string]chaosExperimentRun.Node) dbChaosExperimentRun.ChaosExperimentRun {
+	execData, err := json.Marshal(chaosExperimentRun.ExecutionData{
+		StartedAt:  "1700000000",
+		FinishedAt: "1700000120",
+		Nodes:      nodes,
+	})
+	assert.NoError(t, err)
+
+	return dbChaosExperimentRun.ChaosExperimentRun{
+		ExperimentRunID: experimentRunID,
+		ExperimentID:    "experiment",
+		RevisionID:      revisionID,
+		Phase:           string(model.ExperimentRunStatusCompleted),
+		ExecutionData:   string(execData),
+	}
+}
+
+// TestCompareExperimentRuns is used to test the per fault comparison and the manifest diff of experiment runs
+func TestCompareExperimentRuns(t *testing.T) {
+	// given
+	podDelete := chaosEngineNode("pod-delete", "pod-delete9xk2l", "Pass", "100")
+	podDelete.StartedAt, podDelete.FinishedAt = "1700000010", "1700000070"
+	podDeleteFailed := chaosEngineNode("pod-delete", "pod-delete7hd2q", "Fail", "50")
+	cpuHog := chaosEngineNode("pod-cpu-hog", "pod-cpu-hog2kd8s", "Pass", "100")
+
+	runs := []dbChaosExperimentRun.ChaosExperimentRun{
+		comparedExperimentRun(t, "run-1", "revision-1", map[string]chaosExperimentRun.Node{"a": podDelete, "b": cpuHog}),
+		comparedExperimentRun(t, "run-2", "revision-2", map[string]chaosExperimentRun.Node{"a": podDeleteFailed}),
+	}
+	manifests := chaosExperimentRun.RevisionManifests{
+		"revision-1": `{"spec":{"duration":"30"}}`,
+		"revision-2": `{"spec":{"duration":"60"}}`,
+	}
+
+	// when
+	comparison := chaosExperimentRun.CompareExperimentRuns(runs, manifests)
+
+	// then
+	assert.Len(t, comparison.ExperimentRuns, 2)
+	assert.Equal(t, 120, *comparison.ExperimentRuns[0].Duration)
+
+	assert.Len(t, comparison.Faults, 2)
+	cpuHogComparison, podDeleteComparison := comparison.Faults[0], comparison.Faults[1]
+
+	assert.Equal(t, "pod-cpu-hog", cpuHogComparison.FaultName)
+	assert.True(t, cpuHogComparison.VerdictChanged)
+	assert.True(t, cpuHogComparison.Results[0].Executed)
+	assert.False(t, cpuHogComparison.Results[1].Executed)
+	assert.Equal(t, "run-2", cpuHogComparison.Results[1].ExperimentRunID)
+
+	assert.Equal(t, "pod-delete", podDeleteComparison.FaultName)
+	assert.True(t, podDeleteComparison.VerdictChanged)
+	assert.Equal(t, 60, *podDeleteComparison.Results[0].Duration)
+	assert.Nil(t, podDeleteComparison.Results[1].Duration)
+	assert.Equal(t, -50.0, *podDeleteComparison.ProbeSuccessPercentageDelta)
+
+	assert.Len(t, comparison.ManifestDiffs, 1)
+	assert.Equal(t, "revision-1", comparison.ManifestDiffs[0].FromRevisionID)
+	assert.Contains(t, comparison.ManifestDiffs[0].Diff, `-  duration: "30"`)
+	assert.Contains(t, comparison.ManifestDiffs[0].Diff, `+  duration: "60"`)
+}
+
+// TestCompareExperimentRunsSameRevision is used to test that runs of the same revision have no manifest diff
+func TestCompareExperimentRunsSameRevision(t *testing.T) {
+	// given
+	podDelete := chaosEngineNode("pod-delete", "pod-delete9xk2l", "Pass", "100")
+	runs := []dbChaosExperimentRun.ChaosExperimentRun{
+		comparedExperimentRun(t, "run-1", "revision-1", map[string]chaosExperimentRun.Node{"a": podDelete}),
+		comparedExperimentRun(t, "run-2", "revision-1", map[string]chaosExperimentRun.Node{"a": podDelete}),
+	}
+
+	// when
+	comparison := chaosExperimentRun.CompareExperimentRuns(runs, chaosExperimentRun.RevisionManifests{})
+
+	// then
+	assert.Empty(t, comparison.ManifestDiffs)
+	assert.Len(t, comparison.Faults, 1)
+	assert.False(t, comparison.Faults[0].VerdictChanged)
+	assert.Equal(t, 0.0, *comparison.Faults[0].ProbeSuccessPercentageDelta)
+}
@@ -0,0 +1,76 @@
+package chaos_experiment_run
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+)
+
+// EvaluateExperimentRunGate evaluates a completed experiment run against the gate and returns the verdict along
+// with the reasons it failed. Without a gate the run passes if none of its faults failed
+func EvaluateExperimentRunGate(experimentRun dbChaosExperimentRun.ChaosExperimentRun, gate *model.ExperimentRunGateInput) (model.GateVerdict, []string) {
+	reasons := []string{}
+
+	if experimentRun.Phase != string(model.ExperimentRunStatusCompleted) {
+		reasons = append(reasons, fmt.Sprintf("experiment run finished with phase %s", experimentRun.Phase))
+	}
+
+	if gate == nil {
+		maxFaultsFailed := 0
+		gate = &model.ExperimentRunGateInput{MaxFaultsFailed: &maxFaultsFailed}
+	}
+
+	if gate.MinResiliencyScore != nil {
+		var resiliencyScore float64
+		if experimentRun.ResiliencyScore != nil {
+			resiliencyScore = *experimentRun.ResiliencyScore
+		}
+		if resiliencyScore < *gate.MinResiliencyScore {
+			reasons = append(reasons, fmt.Sprintf("resiliency score %v is below %v", resiliencyScore, *gate.MinResiliencyScore))
+		}
+	}
+
+	if gate.MaxFaultsFailed != nil {
+		var faultsFailed int
+		if experimentRun.FaultsFailed != nil {
+			faultsFailed = *experimentRun.FaultsFailed
+		}
+		if faultsFailed > *gate.MaxFaultsFailed {
+			reasons = append(reasons, fmt.Sprintf("%d faults failed, at most %d allowed", faultsFailed, *gate.MaxFaultsFailed))
+		}
+	}
+
+	if len(gate.RequiredFaults) > 0 {
+		var executionData ExecutionData
+		if err := json.Unmarshal([]byte(experimentRun.ExecutionData), &executionData); err != nil {
+			reasons = append(reasons, "failed to parse execution data of the experiment run")
+		}
+
+		verdicts := map[string]string{}
+		for _, node := range executionData.Nodes {
+			if node.Type != "ChaosEngine" || node.ChaosExp == nil {
+				continue
+			}
+			verdicts[node.FaultName] = node.ChaosExp.FaultVerdict
+			// standalone chaos engines are identified by the name of their fault
+			if node.FaultName == "" {
+				verdicts[node.ChaosExp.FaultName] = node.ChaosExp.FaultVerdict
+			}
+		}
+		for _, faultName := range gate.RequiredFaults {
+			verdict, ok := verdicts[faultName]
+			if !ok {
+				reasons = append(reasons, fmt.Sprintf("fault %s was not executed", faultName))
+			} else if verdict != "Pass" {
+				reasons = append(reasons, fmt.Sprintf("fault %s finished with verdict %s", faultName, verdict))
+			}
+		}
+	}
+
+	if len(reasons) > 0 {
+		return model.GateVerdictFail, reasons
+	}
+	return model.GateVerdictPass, reasons
+}
@@ -0,0 +1,77 @@
+package chaos_experiment_run_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/stretchr/testify/assert"
+)
+
+func completedExperimentRun(t *testing.T, resiliencyScore float64, faultsFailed int) dbChaosExperimentRun.ChaosExperimentRun {
+	execData, err := json.Marshal(chaosExperimentRun.ExecutionData{
+		Nodes: map[string]chaosExperimentRun.Node{
+			"a": chaosEngineNode("pod-delete", "pod-delete9xk2l", "Pass", "100"),
+			"b": chaosEngineNode("pod-delete-abc", "pod-delete-abcq8w3e", "Fail", "0"),
+		},
+	})
+	assert.NoError(t, err)
+
+	return dbChaosExperimentRun.ChaosExperimentRun{
+		Phase:           string(model.ExperimentRunStatusCompleted),
+		Completed:       true,
+		ResiliencyScore: &resiliencyScore,
+		FaultsFailed:    &faultsFailed,
+		ExecutionData:   string(execData),
+	}
+}
+
+// TestEvaluateExperimentRunGate is used to test the verdicts of the gate conditions
+func TestEvaluateExperimentRunGate(t *testing.T) {
+	minResiliencyScore := 50.0
+	maxFaultsFailed := 1
+
+	tests := []struct {
+		name            string
+		gate            *model.ExperimentRunGateInput
+		expectedVerdict model.GateVerdict
+		expectedReasons int
+	}{
+		{
+			name:            "no gate fails on failed faults",
+			expectedVerdict: model.GateVerdictFail,
+			expectedReasons: 1,
+		},
+		{
+			name:            "resiliency score and failed faults within limits",
+			gate:            &model.ExperimentRunGateInput{MinResiliencyScore: &minResiliencyScore, MaxFaultsFailed: &maxFaultsFailed},
+			expectedVerdict: model.GateVerdictPass,
+		},
+		{
+			name:            "required fault passed",
+			gate:            &model.ExperimentRunGateInput{RequiredFaults: []string{"pod-delete"}},
+			expectedVerdict: model.GateVerdictPass,
+		},
+		{
+			name:            "required faults failed or missing",
+			gate:            &model.ExperimentRunGateInput{RequiredFaults: []string{"pod-delete-abc", "pod-cpu-hog"}},
+			expectedVerdict: model.GateVerdictFail,
+			expectedReasons: 2,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			experimentRun := completedExperimentRun(t, 75, 1)
+
+			// when
+			verdict, reasons := chaosExperimentRun.EvaluateExperimentRunGate(experimentRun, tc.gate)
+
+			// then
+			assert.Equal(t, tc.expectedVerdict, verdict)
+			assert.Len(t, reasons, tc.expectedReasons)
+		})
+	}
+}
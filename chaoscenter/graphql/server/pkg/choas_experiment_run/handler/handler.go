@@ -12,8 +12,10 @@ import (
 	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/blackout_window"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readconcern"
 	"go.mongodb.org/mongo-driver/mongo/writeconcern"
@@ -41,11 +43,32 @@ import (
 	"github.com/google/uuid"
 )
 
+const (
+	// defaultGateTimeout is how long a gate waits for the experiment run to complete when no timeout is requested
+	defaultGateTimeout = 5 * time.Minute
+	// maxGateTimeout caps the requested timeout so that a gate can't hold a request indefinitely
+	maxGateTimeout = 30 * time.Minute
+	// gatePollInterval is the interval at which a waiting gate re-reads the experiment run
+	gatePollInterval = 10 * time.Second
+	// maxComparedExperimentRuns caps the number of experiment runs compared at once
+	maxComparedExperimentRuns = 10
+)
+
+const (
+	// EventTrackerUsername is the user recorded on the experiment runs triggered by event-tracker policies
+	EventTrackerUsername = "event-tracker"
+
+	ReasonCronExperiment = "cron experiments run on their schedule"
+	ReasonRunInProgress  = "run in progress"
+)
+
 // ChaosExperimentRunHandler is the handler for chaos experiment
 type ChaosExperimentRunHandler struct {
 	chaosExperimentRunService  types.Service
 	infrastructureService      chaos_infrastructure.Service
 	gitOpsService              gitops.Service
+	blackoutWindowService      blackout_window.Service
+	notificationService        notification.Service
 	chaosExperimentOperator    *dbChaosExperiment.Operator
 	chaosExperimentRunOperator *dbChaosExperimentRun.Operator
 	mongodbOperator            mongodb.MongoOperator
@@ -56,6 +79,8 @@ func NewChaosExperimentRunHandler(
 	chaosExperimentRunService types.Service,
 	infrastructureService chaos_infrastructure.Service,
 	gitOpsService gitops.Service,
+	blackoutWindowService blackout_window.Service,
+	notificationService notification.Service,
 	chaosExperimentOperator *dbChaosExperiment.Operator,
 	chaosExperimentRunOperator *dbChaosExperimentRun.Operator,
 	mongodbOperator mongodb.MongoOperator,
@@ -64,6 +89,8 @@ func NewChaosExperimentRunHandler(
 		chaosExperimentRunService:  chaosExperimentRunService,
 		infrastructureService:      infrastructureService,
 		gitOpsService:              gitOpsService,
+		blackoutWindowService:      blackoutWindowService,
+		notificationService:        notificationService,
 		chaosExperimentOperator:    chaosExperimentOperator,
 		chaosExperimentRunOperator: chaosExperimentRunOperator,
 		mongodbOperator:            mongodbOperator,
@@ -228,8 +255,9 @@ func (c *ChaosExperimentRunHandler) GetExperimentRun(ctx context.Context, projec
 			UpdatedBy: &model.UserDetails{
 				Username: wfRun.UpdatedBy,
 			},
-			UpdatedAt: strconv.FormatInt(wfRun.UpdatedAt, 10),
-			CreatedAt: strconv.FormatInt(wfRun.CreatedAt, 10),
+			UpdatedAt:    strconv.FormatInt(wfRun.UpdatedAt, 10),
+			CreatedAt:    strconv.FormatInt(wfRun.CreatedAt, 10),
+			EventContext: eventContextResponse(wfRun.EventContext),
 		}
 	}
 
@@ -598,8 +626,9 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 			UpdatedBy: &model.UserDetails{
 				Username: workflow.UpdatedBy,
 			},
-			UpdatedAt: strconv.FormatInt(workflow.UpdatedAt, 10),
-			CreatedAt: strconv.FormatInt(workflow.CreatedAt, 10),
+			UpdatedAt:    strconv.FormatInt(workflow.UpdatedAt, 10),
+			CreatedAt:    strconv.FormatInt(workflow.CreatedAt, 10),
+			EventContext: eventContextResponse(workflow.EventContext),
 		}
 		result = append(result, &newExperimentRun)
 	}
@@ -617,8 +646,108 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 	return &output, nil
 }
 
+// eventContextResponse returns the event context of an experiment run triggered by an event-tracker policy
+func eventContextResponse(eventContext *dbChaosExperimentRun.EventContext) *model.EventContext {
+	if eventContext == nil {
+		return nil
+	}
+
+	response := &model.EventContext{
+		PolicyName:   eventContext.PolicyName,
+		ResourceKind: eventContext.ResourceKind,
+		ResourceName: eventContext.ResourceName,
+	}
+	if eventContext.ResourceNamespace != "" {
+		response.ResourceNamespace = &eventContext.ResourceNamespace
+	}
+	if eventContext.Diff != "" {
+		response.Diff = &eventContext.Diff
+	}
+
+	return response
+}
+
 // RunChaosWorkFlow sends workflow run request(single run workflow only) to chaos_infra on workflow re-run request
 func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, r *store.StateData) (*model.RunChaosExperimentResponse, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.runExperiment(ctx, projectID, workflow, username, nil, r)
+}
+
+// TriggerExperimentOnEvent runs the experiment of the infra on a resource event passing the conditions of an
+// event-tracker policy, the resource event is stored on the experiment run. Unlike the gitops notifications, the
+// experiment is run regardless of the gitops settings of the project
+func (c *ChaosExperimentRunHandler) TriggerExperimentOnEvent(ctx context.Context, infra dbChaosInfra.ChaosInfra, request model.ExperimentEventTriggerRequest, r *store.StateData) (*model.ExperimentEventTriggerResponse, error) {
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", request.ExperimentID},
+		{"infra_id", infra.InfraID},
+		{"project_id", infra.ProjectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, errors.New("could not get experiment, error: " + err.Error())
+	}
+	if experiment.ExperimentID == "" {
+		return nil, errors.New("no such experiment found")
+	}
+
+	// cron experiments keep running on their schedule
+	if experiment.ExperimentType == dbChaosExperiment.CronExperiment {
+		return skippedEventTrigger(ReasonCronExperiment), nil
+	}
+
+	if request.SkipIfRunning != nil && *request.SkipIfRunning {
+		runs, err := c.chaosExperimentRunOperator.CountExperimentRuns(ctx, bson.D{
+			{"infra_id", infra.InfraID},
+			{"experiment_id", request.ExperimentID},
+			{"completed", false},
+			{"is_removed", false},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if runs > 0 {
+			return skippedEventTrigger(ReasonRunInProgress), nil
+		}
+	}
+
+	eventContext := &dbChaosExperimentRun.EventContext{
+		PolicyName:   request.PolicyName,
+		ResourceKind: request.ResourceKind,
+		ResourceName: request.ResourceName,
+	}
+	if request.ResourceNamespace != nil {
+		eventContext.ResourceNamespace = *request.ResourceNamespace
+	}
+	if request.Diff != nil {
+		eventContext.Diff = *request.Diff
+	}
+
+	response, err := c.runExperiment(ctx, infra.ProjectID, experiment, EventTrackerUsername, eventContext, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.ExperimentEventTriggerResponse{
+		NotifyID: &response.NotifyID,
+		Skipped:  false,
+	}, nil
+}
+
+func skippedEventTrigger(reason string) *model.ExperimentEventTriggerResponse {
+	return &model.ExperimentEventTriggerResponse{
+		Skipped: true,
+		Reason:  &reason,
+	}
+}
+
+// runExperiment creates a queued run of the experiment on behalf of the given user and sends the experiment to the
+// chaos_infra, the event context is set for the runs triggered by event-tracker policies
+func (c *ChaosExperimentRunHandler) runExperiment(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, username string, eventContext *dbChaosExperimentRun.EventContext, r *store.StateData) (*model.RunChaosExperimentResponse, error) {
 	var notifyID string
 	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(workflow.InfraID)
 	if err != nil {
@@ -647,6 +776,14 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 		//return nil, errors.New("cron-workflows cannot be re-run")
 		return &model.RunChaosExperimentResponse{NotifyID: notifyID}, c.RunCronExperiment(ctx, projectID, workflow, r)
 	}
+
+	window, err := c.blackoutWindowService.GetActiveBlackoutWindow(ctx, projectID, infra.EnvironmentID)
+	if err != nil {
+		return nil, err
+	}
+	if window != nil {
+		return nil, errors.New("experiment can't be run during the blackout window " + window.Name)
+	}
 	notifyID = uuid.New().String()
 
 	err = json.Unmarshal([]byte(workflow.Revision[0].ExperimentManifest), &workflowManifest)
@@ -736,8 +873,6 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 		return nil, err
 	}
 
-	tkn := ctx.Value(authorization.AuthKey).(string)
-	username, err := authorization.GetUsername(tkn)
 	var (
 		wc      = writeconcern.New(writeconcern.WMajority())
 		rc      = readconcern.Snapshot()
@@ -815,6 +950,7 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 			Completed:       false,
 			ResiliencyScore: &resScore,
 			ExecutionData:   string(parsedData),
+			EventContext:    eventContext,
 		})
 		if err != nil {
 			logrus.Error("Failed to create run operation in db")
@@ -843,7 +979,7 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 		return nil, err
 	}
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, &model.ChaosExperimentRequest{
 			ExperimentID:       &workflow.ExperimentID,
 			ExperimentManifest: string(manifest),
 			InfraID:            workflow.InfraID,
@@ -913,6 +1049,23 @@ func (c *ChaosExperimentRunHandler) RunCronExperiment(ctx context.Context, proje
 		}
 	}
 
+	// the cron experiment is created suspended during a blackout window, the subscriber resumes it once the window ends
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(workflow.InfraID)
+	if err != nil {
+		return err
+	}
+	window, err := c.blackoutWindowService.GetActiveBlackoutWindow(ctx, projectID, infra.EnvironmentID)
+	if err != nil {
+		return err
+	}
+	if window != nil && !cronExperimentManifest.Spec.Suspend {
+		if cronExperimentManifest.Annotations == nil {
+			cronExperimentManifest.Annotations = map[string]string{}
+		}
+		cronExperimentManifest.Annotations[blackout_window.SuspendedAnnotation] = window.WindowID
+		cronExperimentManifest.Spec.Suspend = true
+	}
+
 	manifest, err := yaml.Marshal(cronExperimentManifest)
 	if err != nil {
 		return err
@@ -922,7 +1075,7 @@ func (c *ChaosExperimentRunHandler) RunCronExperiment(ctx context.Context, proje
 	username, err := authorization.GetUsername(tkn)
 
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, &model.ChaosExperimentRequest{
 			ExperimentID:       &workflow.ExperimentID,
 			ExperimentManifest: string(manifest),
 			InfraID:            workflow.InfraID,
@@ -932,6 +1085,58 @@ func (c *ChaosExperimentRunHandler) RunCronExperiment(ctx context.Context, proje
 	return nil
 }
 
+// StopExperimentRuns halts the in-flight runs of an experiment, or only the given run if experimentRunID is provided
+func (c *ChaosExperimentRunHandler) StopExperimentRuns(ctx context.Context, projectID string, experimentID string, experimentRunID *string, r *store.StateData) (bool, error) {
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return false, errors.New("no experiment found with experimentID: " + experimentID)
+		}
+		return false, err
+	}
+
+	query := bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"completed", false},
+		{"is_removed", false},
+	}
+	var experimentRunIDs []string
+	if experimentRunID != nil && *experimentRunID != "" {
+		query = append(query, bson.E{Key: "experiment_run_id", Value: *experimentRunID})
+		experimentRunIDs = append(experimentRunIDs, *experimentRunID)
+	}
+
+	experimentRuns, err := c.chaosExperimentRunOperator.GetExperimentRuns(query)
+	if err != nil {
+		return false, err
+	}
+	if len(experimentRuns) == 0 {
+		return false, errors.New("no running experiment runs found for experimentID: " + experimentID)
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return false, err
+	}
+
+	err = c.chaosExperimentRunService.ProcessExperimentRunStop(ctx, experimentRuns, experimentRunIDs, experiment, username, r)
+	if err != nil {
+		return false, err
+	}
+
+	for _, run := range experimentRuns {
+		c.PublishExperimentRunEvent(ctx, projectID, experimentID, run.ExperimentRunID, r)
+	}
+
+	return true, nil
+}
+
 func (c *ChaosExperimentRunHandler) GetExperimentRunStats(ctx context.Context, projectID string) (*model.GetExperimentRunStatsResponse, error) {
 	var pipeline mongo.Pipeline
 	// Match with identifiers
@@ -991,7 +1196,7 @@ func (c *ChaosExperimentRunHandler) GetExperimentRunStats(ctx context.Context, p
 	}, nil
 }
 
-func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.ExperimentRunRequest) (string, error) {
+func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.ExperimentRunRequest, r *store.StateData) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -1015,6 +1220,27 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 
 	logrus.WithFields(logFields).Info("new workflow event received")
 
+	// subscribers redeliver events until they are acknowledged, so replays of processed events are acknowledged
+	// without updating the experiment run again
+	runQuery := bson.D{
+		{"experiment_id", event.ExperimentID},
+		{"experiment_run_id", event.ExperimentRunID},
+	}
+	if event.NotifyID != nil {
+		runQuery = bson.D{
+			{"experiment_id", event.ExperimentID},
+			{"notify_id", event.NotifyID},
+		}
+	}
+	experimentRun, err := c.chaosExperimentRunOperator.GetExperimentRun(runQuery)
+	if err != nil && err != mongo.ErrNoDocuments {
+		return "", err
+	}
+	if err == nil && isProcessedEvent(experimentRun, event) {
+		logrus.WithFields(logFields).Info("workflow event already processed, acknowledging")
+		return fmt.Sprintf("Experiment run event already processed for ExperimentID: %s, ExperimentRunID: %s", event.ExperimentID, event.ExperimentRunID), nil
+	}
+
 	var (
 		executionData types.ExecutionData
 		exeData       []byte
@@ -1064,7 +1290,12 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 	var (
 		isRemoved   = false
 		currentTime = time.Now()
+		lastEventID string
+		isNewRun    bool
 	)
+	if event.EventID != nil {
+		lastEventID = *event.EventID
+	}
 
 	err = mongo.WithSession(ctx, session, func(sessionContext mongo.SessionContext) error {
 		if err = session.StartTransaction(txnOpts); err != nil {
@@ -1088,6 +1319,7 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 		if err != nil {
 			return err
 		}
+		isNewRun = experimentRunCount == 0
 		fmt.Println("event", event.UpdatedBy)
 		updatedBy, err := base64.RawURLEncoding.DecodeString(event.UpdatedBy)
 		if err != nil {
@@ -1185,6 +1417,7 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 			ExecutionData:   string(exeData),
 			RevisionID:      event.RevisionID,
 			Completed:       event.Completed,
+			LastEventID:     lastEventID,
 			Audit: mongodb.Audit{
 				IsRemoved: isRemoved,
 				UpdatedAt: currentTime.UnixMilli(),
@@ -1219,5 +1452,217 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 
 	session.EndSession(ctx)
 
+	c.PublishExperimentRunEvent(ctx, experiment.ProjectID, event.ExperimentID, event.ExperimentRunID, r)
+	c.notifyExperimentRunEvent(ctx, experiment, event, executionData.Phase, workflowRunMetrics.ResiliencyScore, isNewRun)
+
 	return fmt.Sprintf("Experiment run received for for ExperimentID: %s, ExperimentRunID: %s", event.ExperimentID, event.ExperimentRunID), nil
 }
+
+// notifyExperimentRunEvent notifies the start of new experiment runs and the outcome of completed ones
+func (c *ChaosExperimentRunHandler) notifyExperimentRunEvent(ctx context.Context, experiment dbChaosExperiment.ChaosExperimentRequest, event model.ExperimentRunRequest, phase string, resiliencyScore float64, isNewRun bool) {
+	var eventTypes []model.NotificationEventType
+	if isNewRun {
+		eventTypes = append(eventTypes, model.NotificationEventTypeExperimentRunStarted)
+	}
+	if event.Completed {
+		if phase == string(model.ExperimentRunStatusCompleted) {
+			eventTypes = append(eventTypes, model.NotificationEventTypeExperimentRunCompleted)
+		} else {
+			eventTypes = append(eventTypes, model.NotificationEventTypeExperimentRunFailed)
+		}
+	}
+	if len(eventTypes) == 0 {
+		return
+	}
+
+	notificationEvent := notification.Event{
+		ProjectID:       experiment.ProjectID,
+		ExperimentID:    experiment.ExperimentID,
+		ExperimentName:  experiment.Name,
+		ExperimentRunID: event.ExperimentRunID,
+		Phase:           phase,
+		Tags:            experiment.Tags,
+		InfraID:         experiment.InfraID,
+		Message:         fmt.Sprintf("Experiment run %s of %s is in phase %s", event.ExperimentRunID, experiment.Name, phase),
+	}
+	if infra, err := c.infrastructureService.GetDBInfra(experiment.InfraID); err == nil {
+		notificationEvent.InfraName = infra.Name
+		notificationEvent.EnvironmentID = infra.EnvironmentID
+	}
+
+	for _, eventType := range eventTypes {
+		notificationEvent.Type = eventType
+		notificationEvent.EventID = event.ExperimentRunID + "-" + strings.ToLower(eventType.String())
+		notificationEvent.ResiliencyScore = nil
+		if eventType != model.NotificationEventTypeExperimentRunStarted {
+			notificationEvent.ResiliencyScore = &resiliencyScore
+		}
+		c.notificationService.Notify(ctx, notificationEvent)
+	}
+}
+
+// isProcessedEvent checks if the event replays an event which was already applied to the experiment run,
+// completed runs don't accept any further event
+func isProcessedEvent(experimentRun dbChaosExperimentRun.ChaosExperimentRun, event model.ExperimentRunRequest) bool {
+	if experimentRun.Completed {
+		return true
+	}
+	return event.EventID != nil && *event.EventID != "" && experimentRun.LastEventID == *event.EventID
+}
+
+// GetExperimentRunGate waits for the experiment run triggered with the notifyID to complete and evaluates it against the gate
+func (c *ChaosExperimentRunHandler) GetExperimentRunGate(ctx context.Context, projectID string, notifyID string, gate *model.ExperimentRunGateInput, timeout *int, r *store.StateData) (*model.ExperimentRunGateResponse, error) {
+	waitFor := defaultGateTimeout
+	if timeout != nil {
+		if *timeout < 0 {
+			return nil, errors.New("timeout can not be negative")
+		}
+		waitFor = time.Duration(*timeout) * time.Second
+		if waitFor > maxGateTimeout {
+			waitFor = maxGateTimeout
+		}
+	}
+
+	query := bson.D{
+		{"project_id", projectID},
+		{"notify_id", notifyID},
+		{"is_removed", false},
+	}
+	experimentRun, err := c.chaosExperimentRunOperator.GetExperimentRun(query)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no experiment run found for notifyID: " + notifyID)
+		}
+		return nil, err
+	}
+
+	if !experimentRun.Completed {
+		// the run is re-read whenever an event is published for the experiment, polling covers
+		// the events handled by other replicas when the state is not shared
+		events := make(chan *model.ExperimentRun, 10)
+		observerKey := store.ExperimentRunObserverKey(experimentRun.ProjectID, experimentRun.ExperimentID)
+		r.AddExperimentRunObserver(observerKey, events)
+		defer r.RemoveExperimentRunObserver(observerKey, events)
+
+		deadline := time.NewTimer(waitFor)
+		defer deadline.Stop()
+		ticker := time.NewTicker(gatePollInterval)
+		defer ticker.Stop()
+
+		timedOut := false
+		for !experimentRun.Completed && !timedOut {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-deadline.C:
+				timedOut = true
+			case <-events:
+			case <-ticker.C:
+			}
+
+			experimentRun, err = c.chaosExperimentRunOperator.GetExperimentRun(query)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	response := &model.ExperimentRunGateResponse{
+		Verdict: model.GateVerdictTimeout,
+		Reasons: []string{fmt.Sprintf("experiment run did not complete within %v", waitFor)},
+	}
+	if experimentRun.Completed {
+		response.Verdict, response.Reasons = types.EvaluateExperimentRunGate(experimentRun, gate)
+	}
+
+	// queued runs are only assigned an ID once the infra starts executing them
+	if experimentRun.ExperimentRunID != "" {
+		response.ExperimentRun, err = c.GetExperimentRun(ctx, projectID, experimentRun.ExperimentRunID)
+		if err != nil {
+			if experimentRun.Completed {
+				return nil, err
+			}
+			// the verdict of a timed out gate doesn't depend on the run, so it is still returned
+			logrus.WithField("notifyID", notifyID).Warnf("failed to fetch the experiment run of the timed out gate, error: %v", err)
+		}
+	}
+
+	return response, nil
+}
+
+// CompareExperimentRuns compares the experiment runs of the project, in the requested order, along with the
+// manifests of the experiment revisions they used
+func (c *ChaosExperimentRunHandler) CompareExperimentRuns(ctx context.Context, projectID string, experimentRunIDs []string) (*model.ExperimentRunComparison, error) {
+	if len(experimentRunIDs) < 2 {
+		return nil, errors.New("at least two experiment runs are required for a comparison")
+	}
+	if len(experimentRunIDs) > maxComparedExperimentRuns {
+		return nil, fmt.Errorf("at most %d experiment runs can be compared", maxComparedExperimentRuns)
+	}
+
+	query := bson.D{
+		{"project_id", projectID},
+		{"experiment_run_id", bson.D{{"$in", experimentRunIDs}}},
+		{"is_removed", false},
+	}
+	runs, err := c.chaosExperimentRunOperator.GetExperimentRuns(query)
+	if err != nil {
+		return nil, err
+	}
+	runsByID := map[string]dbChaosExperimentRun.ChaosExperimentRun{}
+	for _, run := range runs {
+		runsByID[run.ExperimentRunID] = run
+	}
+
+	var (
+		experimentRuns []dbChaosExperimentRun.ChaosExperimentRun
+		experimentIDs  []string
+	)
+	for _, experimentRunID := range experimentRunIDs {
+		run, ok := runsByID[experimentRunID]
+		if !ok {
+			return nil, errors.New("no experiment run found for experimentRunID: " + experimentRunID)
+		}
+		experimentRuns = append(experimentRuns, run)
+		experimentIDs = append(experimentIDs, run.ExperimentID)
+	}
+
+	experiments, err := c.chaosExperimentOperator.GetExperiments(bson.D{
+		{"project_id", projectID},
+		{"experiment_id", bson.D{{"$in", experimentIDs}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	manifests := types.RevisionManifests{}
+	for _, experiment := range experiments {
+		for _, revision := range experiment.Revision {
+			manifests[revision.RevisionID] = revision.ExperimentManifest
+		}
+	}
+
+	return types.CompareExperimentRuns(experimentRuns, manifests), nil
+}
+
+// PublishExperimentRunEvent sends the latest state of an experiment run to the users subscribed to its project or experiment
+func (c *ChaosExperimentRunHandler) PublishExperimentRunEvent(ctx context.Context, projectID string, experimentID string, experimentRunID string, r *store.StateData) {
+	if r == nil || experimentRunID == "" {
+		return
+	}
+
+	r.Mutex.Lock()
+	observers := len(r.ExperimentEventPublish[projectID]) + len(r.ExperimentEventPublish[store.ExperimentRunObserverKey(projectID, experimentID)])
+	r.Mutex.Unlock()
+	// subscribers held by the other replicas are unknown, so the event is always published when the state is shared
+	if observers == 0 && !r.IsDistributed() {
+		return
+	}
+
+	experimentRun, err := c.GetExperimentRun(ctx, projectID, experimentRunID)
+	if err != nil {
+		logrus.WithField("experimentRunID", experimentRunID).Warnf("failed to fetch experiment run for publishing, error: %v", err)
+		return
+	}
+
+	r.PublishExperimentRun(experimentRun)
+}
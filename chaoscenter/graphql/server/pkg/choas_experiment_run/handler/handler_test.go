@@ -0,0 +1,73 @@
+package handler_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run/handler"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// experimentRunOperator returns the experiment run, completed once completed is set
+type experimentRunOperator struct {
+	mongodb.MongoOperator
+	completed atomic.Bool
+}
+
+func (o *experimentRunOperator) Get(ctx context.Context, collectionType int, query bson.D) (*mongo.SingleResult, error) {
+	return mongo.NewSingleResultFromDocument(dbChaosExperimentRun.ChaosExperimentRun{
+		ProjectID:    "project-1",
+		ExperimentID: "experiment-1",
+		Phase:        string(model.ExperimentRunStatusCompleted),
+		Completed:    o.completed.Load(),
+	}, nil, nil), nil
+}
+
+// TestGetExperimentRunGateWakesOnPublish is used to test that the gate re-reads the run as soon as an event of its
+// experiment is published, instead of waiting for the next poll
+func TestGetExperimentRunGateWakesOnPublish(t *testing.T) {
+	// given
+	operator := &experimentRunOperator{}
+	mongodb.Operator = operator
+	runHandler := handler.NewChaosExperimentRunHandler(nil, nil, nil, nil, nil, nil,
+		dbChaosExperimentRun.NewChaosExperimentRunOperator(operator), operator)
+	state := store.NewStore()
+	observerKey := store.ExperimentRunObserverKey("project-1", "experiment-1")
+
+	type result struct {
+		response *model.ExperimentRunGateResponse
+		err      error
+	}
+	results := make(chan result, 1)
+	timeout := 60
+
+	// when
+	go func() {
+		response, err := runHandler.GetExperimentRunGate(context.Background(), "project-1", "notify-1", nil, &timeout, state)
+		results <- result{response, err}
+	}()
+	assert.Eventually(t, func() bool {
+		state.Mutex.Lock()
+		defer state.Mutex.Unlock()
+		return len(state.ExperimentEventPublish[observerKey]) == 1
+	}, time.Second, 10*time.Millisecond)
+	operator.completed.Store(true)
+	state.PublishExperimentRun(&model.ExperimentRun{ProjectID: "project-1", ExperimentID: "experiment-1"})
+
+	// then
+	select {
+	case res := <-results:
+		assert.NoError(t, res.err)
+		assert.Equal(t, model.GateVerdictPass, res.response.Verdict)
+	case <-time.After(5 * time.Second):
+		t.Fatal("gate didn't wake up on the published experiment run")
+	}
+}
@@ -0,0 +1,135 @@
+package chaos_experiment_run
+
+import (
+	"strconv"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+)
+
+// generatedNameSuffixLength is the length of the random suffix kubernetes appends to a generateName
+const generatedNameSuffixLength = 5
+
+// CalculateExperimentRunMetrics aggregates the fault results of a run into its metrics following the scoring policy,
+// the weighted mean of the probe success percentages is used when no policy is set
+func CalculateExperimentRunMetrics(execData ExecutionData, weightages []*dbChaosExperiment.WeightagesInput, policy *dbChaosExperiment.ScoringPolicy) ExperimentRunMetrics {
+	var (
+		result      ExperimentRunMetrics
+		weightMap   = map[string]int{}
+		thresholds  = map[string]int{}
+		strategy    = model.ScoringStrategyWeightedMean
+		probeResult = map[string]int{}
+		faultPassed = map[string]bool{}
+	)
+
+	for _, weight := range weightages {
+		weightMap[weight.FaultName] = weight.Weightage
+	}
+	if policy != nil {
+		if policy.Strategy != "" {
+			strategy = model.ScoringStrategy(policy.Strategy)
+		}
+		for _, threshold := range policy.FaultThresholds {
+			thresholds[threshold.FaultName] = threshold.ProbeSuccessPercentage
+		}
+	}
+
+	result.TotalExperiments = len(weightMap)
+
+	for _, node := range execData.Nodes {
+		if node.Type != "ChaosEngine" || node.ChaosExp == nil {
+			continue
+		}
+
+		verdict := node.ChaosExp.FaultVerdict
+		// probeSuccessPercentage will be included only if chaosData is present
+		if faultName, ok := getNodeFaultName(node, weightMap); ok {
+			probeSuccessPercentage, _ := strconv.Atoi(node.ChaosExp.ProbeSuccessPercentage)
+			if threshold, ok := thresholds[faultName]; ok && probeSuccessPercentage < threshold {
+				probeSuccessPercentage = 0
+				if verdict == "Pass" {
+					verdict = "Fail"
+				}
+			}
+			probeResult[faultName] = probeSuccessPercentage
+			faultPassed[faultName] = verdict == "Pass"
+		}
+
+		switch verdict {
+		case "Pass":
+			result.FaultsPassed += 1
+		case "Fail":
+			result.FaultsFailed += 1
+		case "Awaited":
+			result.FaultsAwaited += 1
+		case "Stopped":
+			result.FaultsStopped += 1
+		case "N/A", "":
+			result.FaultsNA += 1
+		}
+	}
+
+	switch strategy {
+	case model.ScoringStrategyMinimum:
+		// faults which were not executed are considered to have a probe success percentage of 0
+		minimum := -1
+		for faultName, weight := range weightMap {
+			if weight == 0 {
+				continue
+			}
+			if minimum == -1 || probeResult[faultName] < minimum {
+				minimum = probeResult[faultName]
+			}
+		}
+		if minimum > 0 {
+			result.ResiliencyScore = float64(minimum)
+		}
+	case model.ScoringStrategyAllMustPass:
+		passed := false
+		for faultName, weight := range weightMap {
+			if weight == 0 {
+				continue
+			}
+			if passed = faultPassed[faultName]; !passed {
+				break
+			}
+		}
+		if passed {
+			result.ResiliencyScore = 100
+		}
+	default:
+		var weightSum, totalTestResult = 0, 0
+		for faultName, weight := range weightMap {
+			// Total weight calculated for all faults
+			weightSum += weight
+			totalTestResult += weight * probeResult[faultName]
+		}
+		if weightSum != 0 {
+			result.ResiliencyScore = utils.Truncate(float64(totalTestResult) / float64(weightSum))
+		}
+	}
+
+	return result
+}
+
+// getNodeFaultName returns the fault of the weightages the node was executed for. Nodes reported by older
+// subscribers don't carry the fault name, in which case it is derived from the name of the chaos engine
+func getNodeFaultName(node Node, weightMap map[string]int) (string, bool) {
+	candidates := []string{node.FaultName, node.ChaosExp.EngineName}
+	if len(node.ChaosExp.EngineName) > generatedNameSuffixLength {
+		candidates = append(candidates, node.ChaosExp.EngineName[:len(node.ChaosExp.EngineName)-generatedNameSuffixLength])
+	}
+	// standalone chaos engines are weighted by the name of their fault
+	candidates = append(candidates, node.ChaosExp.FaultName)
+
+	for _, candidate := range candidates {
+		if candidate == "" {
+			continue
+		}
+		if _, ok := weightMap[candidate]; ok {
+			return candidate, true
+		}
+	}
+	return "", false
+}
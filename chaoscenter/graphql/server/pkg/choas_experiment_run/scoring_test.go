@@ -0,0 +1,119 @@
+package chaos_experiment_run_test
+
+import (
+	"testing"
+
+	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	"github.com/stretchr/testify/assert"
+)
+
+func chaosEngineNode(faultName, engineName, verdict, probeSuccessPercentage string) chaosExperimentRun.Node {
+	return chaosExperimentRun.Node{
+		Type:      "ChaosEngine",
+		FaultName: faultName,
+		ChaosExp: &chaosExperimentRun.ChaosData{
+			EngineName:             engineName,
+			FaultVerdict:           verdict,
+			ProbeSuccessPercentage: probeSuccessPercentage,
+		},
+	}
+}
+
+var weightages = []*dbChaosExperiment.WeightagesInput{
+	{FaultName: "pod-delete", Weightage: 10},
+	{FaultName: "pod-delete-abc", Weightage: 30},
+}
+
+// TestCalculateExperimentRunMetricsWeightedMean is used to test that faults sharing a name prefix are weighted separately
+func TestCalculateExperimentRunMetricsWeightedMean(t *testing.T) {
+	// given
+	execData := chaosExperimentRun.ExecutionData{
+		Nodes: map[string]chaosExperimentRun.Node{
+			"a": chaosEngineNode("pod-delete", "pod-delete9xk2l", "Pass", "100"),
+			"b": chaosEngineNode("pod-delete-abc", "pod-delete-abcq8w3e", "Fail", "0"),
+		},
+	}
+
+	// when
+	metrics := chaosExperimentRun.CalculateExperimentRunMetrics(execData, weightages, nil)
+
+	// then
+	assert.Equal(t, 25.0, metrics.ResiliencyScore)
+	assert.Equal(t, 1, metrics.FaultsPassed)
+	assert.Equal(t, 1, metrics.FaultsFailed)
+	assert.Equal(t, 2, metrics.TotalExperiments)
+}
+
+// TestCalculateExperimentRunMetricsLegacyNodes is used to test the fault matching of nodes without a fault name
+func TestCalculateExperimentRunMetricsLegacyNodes(t *testing.T) {
+	// given
+	execData := chaosExperimentRun.ExecutionData{
+		Nodes: map[string]chaosExperimentRun.Node{
+			"a": chaosEngineNode("", "pod-delete9xk2l", "Fail", "0"),
+			"b": chaosEngineNode("", "pod-delete-abcq8w3e", "Pass", "100"),
+		},
+	}
+
+	// when
+	metrics := chaosExperimentRun.CalculateExperimentRunMetrics(execData, weightages, nil)
+
+	// then
+	assert.Equal(t, 75.0, metrics.ResiliencyScore)
+}
+
+// TestCalculateExperimentRunMetricsPolicies is used to test the aggregation strategies and fault thresholds
+func TestCalculateExperimentRunMetricsPolicies(t *testing.T) {
+	execData := chaosExperimentRun.ExecutionData{
+		Nodes: map[string]chaosExperimentRun.Node{
+			"a": chaosEngineNode("pod-delete", "pod-delete9xk2l", "Pass", "60"),
+			"b": chaosEngineNode("pod-delete-abc", "pod-delete-abcq8w3e", "Pass", "80"),
+		},
+	}
+
+	tests := []struct {
+		name          string
+		policy        *dbChaosExperiment.ScoringPolicy
+		expectedScore float64
+		expectedFail  int
+	}{
+		{
+			name:          "minimum",
+			policy:        &dbChaosExperiment.ScoringPolicy{Strategy: "MINIMUM"},
+			expectedScore: 60,
+		},
+		{
+			name:          "all must pass",
+			policy:        &dbChaosExperiment.ScoringPolicy{Strategy: "ALL_MUST_PASS"},
+			expectedScore: 100,
+		},
+		{
+			name: "all must pass with a threshold",
+			policy: &dbChaosExperiment.ScoringPolicy{
+				Strategy:        "ALL_MUST_PASS",
+				FaultThresholds: []*dbChaosExperiment.FaultThreshold{{FaultName: "pod-delete", ProbeSuccessPercentage: 75}},
+			},
+			expectedScore: 0,
+			expectedFail:  1,
+		},
+		{
+			name: "weighted mean with a threshold",
+			policy: &dbChaosExperiment.ScoringPolicy{
+				Strategy:        "WEIGHTED_MEAN",
+				FaultThresholds: []*dbChaosExperiment.FaultThreshold{{FaultName: "pod-delete", ProbeSuccessPercentage: 75}},
+			},
+			expectedScore: 60,
+			expectedFail:  1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			metrics := chaosExperimentRun.CalculateExperimentRunMetrics(execData, weightages, tc.policy)
+
+			// then
+			assert.Equal(t, tc.expectedScore, metrics.ResiliencyScore)
+			assert.Equal(t, tc.expectedFail, metrics.FaultsFailed)
+		})
+	}
+}
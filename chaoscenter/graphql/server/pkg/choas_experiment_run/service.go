@@ -2,9 +2,8 @@ package chaos_experiment_run
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
@@ -17,7 +16,8 @@ import (
 
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
 
-	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/tidwall/sjson"
 
 	"go.mongodb.org/mongo-driver/bson"
 )
@@ -25,6 +25,9 @@ import (
 type Service interface {
 	ProcessExperimentRunDelete(ctx context.Context, query bson.D, workflowRunID *string, experimentRun dbChaosExperimentRun.ChaosExperimentRun, workflow dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error
 	ProcessCompletedExperimentRun(execData ExecutionData, wfID string, runID string) (ExperimentRunMetrics, error)
+	ProcessExperimentRunsRescore(ctx context.Context, experiment dbChaosExperiment.ChaosExperimentRequest) (int, error)
+	ProcessExperimentRunStop(ctx context.Context, experimentRuns []dbChaosExperimentRun.ChaosExperimentRun, experimentRunIDs []string, experiment dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error
+	SendPendingStops(ctx context.Context, infraID string, r *store.StateData) error
 }
 
 // chaosWorkflowService is the implementation of the chaos workflow service
@@ -58,7 +61,7 @@ func (c *chaosExperimentRunService) ProcessExperimentRunDelete(ctx context.Conte
 		return err
 	}
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(experimentRun.ProjectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, experimentRun.ProjectID, &model.ChaosExperimentRequest{
 			InfraID: workflow.InfraID,
 		}, &username, workflowRunID, "workflow_run_delete", r)
 	}
@@ -66,68 +69,265 @@ func (c *chaosExperimentRunService) ProcessExperimentRunDelete(ctx context.Conte
 	return nil
 }
 
+// ProcessExperimentRunStop asks the subscriber to halt the given experiment runs on the infra and marks them as stopped,
+// or records the stop as pending if the infra isn't connected. experimentRunIDs are the IDs of the runs sent to the
+// subscriber, every in-flight run of the experiment is stopped if empty
+func (c *chaosExperimentRunService) ProcessExperimentRunStop(ctx context.Context, experimentRuns []dbChaosExperimentRun.ChaosExperimentRun, experimentRunIDs []string, experiment dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error {
+	var (
+		currentTime = time.Now().UnixMilli()
+		phase       = string(model.ExperimentRunStatusStopped)
+	)
+
+	stopInputs := StopExperimentInputs{
+		ExperimentName:   experiment.Name,
+		ExperimentID:     experiment.ExperimentID,
+		ExperimentRunIDs: append([]string{}, experimentRunIDs...),
+	}
+	data, err := json.Marshal(stopInputs)
+	if err != nil {
+		return err
+	}
+	externalData := string(data)
+
+	infra, err := c.chaosInfrastructureOperator.GetInfra(experiment.InfraID)
+	if err != nil {
+		return err
+	}
+
+	// the runs keep running until the infra receives the stop, so they are only marked stopped once it is sent. The
+	// actions relayed to the other replicas are sent even if no replica is connected to the infra, so its state is
+	// checked as well
+	sent := r != nil && infra.IsActive && chaos_infrastructure.SendExperimentToSubscriber(ctx, experiment.ProjectID, &model.ChaosExperimentRequest{
+		InfraID: experiment.InfraID,
+	}, &username, &externalData, "workflow_run_stop", r)
+	if !sent {
+		logrus.Info("infra ", experiment.InfraID, " isn't connected, the stop of experiment ", experiment.ExperimentID, " is pending")
+		for _, run := range experimentRuns {
+			runQuery, _ := stopQueries(run)
+			update := bson.D{
+				{"$set", bson.D{
+					{"stop_pending", true},
+					{"updated_at", currentTime},
+					{"updated_by", username},
+				}},
+			}
+			err := c.chaosExperimentRunOperator.UpdateExperimentRunWithQuery(ctx, runQuery, update)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for _, run := range experimentRuns {
+		runQuery, detailsQuery := stopQueries(run)
+
+		executionData := run.ExecutionData
+		if executionData != "" {
+			updatedData, err := sjson.Set(executionData, "phase", phase)
+			if err != nil {
+				logrus.Warnf("failed to update the phase in execution data of experiment run %s, error: %v", run.ExperimentRunID, err)
+			} else {
+				executionData = updatedData
+			}
+		}
+
+		update := bson.D{
+			{"$set", bson.D{
+				{"phase", phase},
+				{"completed", true},
+				{"execution_data", executionData},
+				{"updated_at", currentTime},
+				{"updated_by", username},
+			}},
+			{"$unset", bson.D{{"stop_pending", ""}}},
+		}
+		err := c.chaosExperimentRunOperator.UpdateExperimentRunWithQuery(ctx, runQuery, update)
+		if err != nil {
+			return err
+		}
+
+		update = bson.D{
+			{"$set", bson.D{
+				{"recent_experiment_run_details.$.phase", phase},
+				{"recent_experiment_run_details.$.completed", true},
+				{"recent_experiment_run_details.$.updated_at", currentTime},
+				{"recent_experiment_run_details.$.updated_by", username},
+			}},
+		}
+		err = c.chaosExperimentOperator.UpdateChaosExperiment(ctx, detailsQuery, update)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SendPendingStops sends the stops of the experiment runs which were requested while the infra was offline
+func (c *chaosExperimentRunService) SendPendingStops(ctx context.Context, infraID string, r *store.StateData) error {
+	experimentRuns, err := c.chaosExperimentRunOperator.GetExperimentRuns(bson.D{
+		{"infra_id", infraID},
+		{"stop_pending", true},
+		{"completed", false},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return err
+	}
+
+	var experimentIDs []string
+	pendingRuns := make(map[string][]dbChaosExperimentRun.ChaosExperimentRun)
+	for _, run := range experimentRuns {
+		if _, ok := pendingRuns[run.ExperimentID]; !ok {
+			experimentIDs = append(experimentIDs, run.ExperimentID)
+		}
+		pendingRuns[run.ExperimentID] = append(pendingRuns[run.ExperimentID], run)
+	}
+
+	for _, experimentID := range experimentIDs {
+		runs := pendingRuns[experimentID]
+		experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+			{"experiment_id", experimentID},
+		})
+		if err != nil {
+			return err
+		}
+
+		// the queued runs aren't known by the subscriber under their ID yet, so every run of the experiment is stopped
+		var experimentRunIDs []string
+		for _, run := range runs {
+			if run.ExperimentRunID == "" {
+				experimentRunIDs = nil
+				break
+			}
+			experimentRunIDs = append(experimentRunIDs, run.ExperimentRunID)
+		}
+
+		err = c.ProcessExperimentRunStop(ctx, runs, experimentRunIDs, experiment, runs[0].UpdatedBy, r)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// stopQueries returns the queries of the experiment run and of its details in the experiment, the queued runs are
+// identified only by their notifyID until the subscriber reports the run ID
+func stopQueries(run dbChaosExperimentRun.ChaosExperimentRun) (bson.D, bson.D) {
+	if run.ExperimentRunID == "" && run.NotifyID != nil {
+		return bson.D{
+			{"experiment_id", run.ExperimentID},
+			{"notify_id", run.NotifyID},
+			{"completed", false},
+		}, bson.D{
+			{"experiment_id", run.ExperimentID},
+			{"recent_experiment_run_details.notify_id", run.NotifyID},
+		}
+	}
+
+	return bson.D{
+		{"experiment_id", run.ExperimentID},
+		{"experiment_run_id", run.ExperimentRunID},
+		{"completed", false},
+	}, bson.D{
+		{"experiment_id", run.ExperimentID},
+		{"recent_experiment_run_details.experiment_run_id", run.ExperimentRunID},
+	}
+}
+
 // ProcessCompletedExperimentRun calculates the Resiliency Score and returns the updated ExecutionData
 func (c *chaosExperimentRunService) ProcessCompletedExperimentRun(execData ExecutionData, wfID string, runID string) (ExperimentRunMetrics, error) {
-	var weightSum, totalTestResult = 0, 0
-	var result ExperimentRunMetrics
-	weightMap := map[string]int{}
-
 	chaosExperiments, err := c.chaosExperimentOperator.GetExperiment(context.TODO(), bson.D{
 		{"experiment_id", wfID},
 	})
 	if err != nil {
-		return result, fmt.Errorf("failed to get experiment from db on complete, error: %w", err)
+		return ExperimentRunMetrics{}, fmt.Errorf("failed to get experiment from db on complete, error: %w", err)
 	}
+
+	var (
+		weightages []*dbChaosExperiment.WeightagesInput
+		policy     *dbChaosExperiment.ScoringPolicy
+	)
 	for _, rev := range chaosExperiments.Revision {
 		if rev.RevisionID == execData.RevisionID {
-			for _, weights := range rev.Weightages {
-				weightMap[weights.FaultName] = weights.Weightage
-				// Total weight calculated for all experiments
-				weightSum = weightSum + weights.Weightage
-			}
+			weightages = rev.Weightages
+			policy = rev.ScoringPolicy
 		}
 	}
 
-	result.TotalExperiments = len(weightMap)
+	return CalculateExperimentRunMetrics(execData, weightages, policy), nil
+}
 
-	for _, value := range execData.Nodes {
-		if value.Type == "ChaosEngine" {
-			experimentName := ""
-			if value.ChaosExp == nil {
-				continue
-			}
+// ProcessExperimentRunsRescore recalculates the metrics of the completed runs of the experiment using the scoring policy
+// of its latest revision and the weightages of the revision each run was executed with
+func (c *chaosExperimentRunService) ProcessExperimentRunsRescore(ctx context.Context, experiment dbChaosExperiment.ChaosExperimentRequest) (int, error) {
+	if len(experiment.Revision) == 0 {
+		return 0, nil
+	}
 
-			for expName := range weightMap {
-				if strings.Contains(value.ChaosExp.EngineName, expName) {
-					experimentName = expName
-				}
-			}
-			weight, ok := weightMap[experimentName]
-			// probeSuccessPercentage will be included only if chaosData is present
-			if ok {
-				x, _ := strconv.Atoi(value.ChaosExp.ProbeSuccessPercentage)
-				totalTestResult += weight * x
-			}
-			if value.ChaosExp.FaultVerdict == "Pass" {
-				result.FaultsPassed += 1
-			}
-			if value.ChaosExp.FaultVerdict == "Fail" {
-				result.FaultsFailed += 1
-			}
-			if value.ChaosExp.FaultVerdict == "Awaited" {
-				result.FaultsAwaited += 1
-			}
-			if value.ChaosExp.FaultVerdict == "Stopped" {
-				result.FaultsStopped += 1
-			}
-			if value.ChaosExp.FaultVerdict == "N/A" || value.ChaosExp.FaultVerdict == "" {
-				result.FaultsNA += 1
-			}
-		}
+	var (
+		latestRevision = experiment.Revision[len(experiment.Revision)-1]
+		weightages     = make(map[string][]*dbChaosExperiment.WeightagesInput)
+		updated        = 0
+	)
+	for _, rev := range experiment.Revision {
+		weightages[rev.RevisionID] = rev.Weightages
+	}
+
+	experimentRuns, err := c.chaosExperimentRunOperator.GetExperimentRuns(bson.D{
+		{"experiment_id", experiment.ExperimentID},
+		{"completed", true},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return 0, err
 	}
-	if weightSum != 0 {
-		result.ResiliencyScore = utils.Truncate(float64(totalTestResult) / float64(weightSum))
+
+	for _, experimentRun := range experimentRuns {
+		var executionData ExecutionData
+		if err := json.Unmarshal([]byte(experimentRun.ExecutionData), &executionData); err != nil {
+			logrus.WithField("experimentRunID", experimentRun.ExperimentRunID).Warnf("failed to parse execution data, skipping run: %v", err)
+			continue
+		}
+
+		revisionWeightages, ok := weightages[experimentRun.RevisionID]
+		if !ok {
+			revisionWeightages = latestRevision.Weightages
+		}
+		metrics := CalculateExperimentRunMetrics(executionData, revisionWeightages, latestRevision.ScoringPolicy)
+
+		err = c.chaosExperimentRunOperator.UpdateExperimentRunWithQuery(ctx, bson.D{
+			{"experiment_run_id", experimentRun.ExperimentRunID},
+		}, bson.D{
+			{"$set", bson.D{
+				{"resiliency_score", metrics.ResiliencyScore},
+				{"faults_passed", metrics.FaultsPassed},
+				{"faults_failed", metrics.FaultsFailed},
+				{"faults_awaited", metrics.FaultsAwaited},
+				{"faults_stopped", metrics.FaultsStopped},
+				{"faults_na", metrics.FaultsNA},
+				{"total_faults", metrics.TotalExperiments},
+			}},
+		})
+		if err != nil {
+			return updated, err
+		}
+
+		err = c.chaosExperimentOperator.UpdateChaosExperiment(ctx, bson.D{
+			{"experiment_id", experiment.ExperimentID},
+			{"recent_experiment_run_details.experiment_run_id", experimentRun.ExperimentRunID},
+		}, bson.D{
+			{"$set", bson.D{
+				{"recent_experiment_run_details.$.resiliency_score", metrics.ResiliencyScore},
+			}},
+		})
+		if err != nil {
+			return updated, err
+		}
+		updated++
 	}
 
-	return result, nil
+	return updated, nil
 }
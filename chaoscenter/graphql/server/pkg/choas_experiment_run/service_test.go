@@ -0,0 +1,149 @@
+package chaos_experiment_run_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/choas_experiment_run"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// stopOperator returns the infra, the experiment and its runs, and records the updates of the runs
+type stopOperator struct {
+	mongodb.MongoOperator
+	infra      dbChaosInfra.ChaosInfra
+	experiment dbChaosExperiment.ChaosExperimentRequest
+	runs       []dbChaosExperimentRun.ChaosExperimentRun
+	runUpdates []bson.D
+}
+
+func (o *stopOperator) Get(ctx context.Context, collectionType int, query bson.D) (*mongo.SingleResult, error) {
+	if collectionType == mongodb.ChaosInfraCollection {
+		return mongo.NewSingleResultFromDocument(o.infra, nil, nil), nil
+	}
+	return mongo.NewSingleResultFromDocument(o.experiment, nil, nil), nil
+}
+
+func (o *stopOperator) List(ctx context.Context, collectionType int, query bson.D, opts ...*options.FindOptions) (*mongo.Cursor, error) {
+	var runs []interface{}
+	for _, run := range o.runs {
+		runs = append(runs, run)
+	}
+	return mongo.NewCursorFromDocuments(runs, nil, nil)
+}
+
+func (o *stopOperator) Update(ctx context.Context, collectionType int, query, update bson.D, opts ...*options.UpdateOptions) (*mongo.UpdateResult, error) {
+	if collectionType == mongodb.ChaosExperimentRunsCollection {
+		o.runUpdates = append(o.runUpdates, update)
+	}
+	return &mongo.UpdateResult{}, nil
+}
+
+func newStopOperator(infraActive bool) *stopOperator {
+	operator := &stopOperator{
+		infra: dbChaosInfra.ChaosInfra{InfraID: "infra-1", IsActive: infraActive},
+		experiment: dbChaosExperiment.ChaosExperimentRequest{
+			ResourceDetails: mongodb.ResourceDetails{Name: "experiment"},
+			ProjectID:       "project-1",
+			ExperimentID:    "experiment-1",
+			InfraID:         "infra-1",
+		},
+		runs: []dbChaosExperimentRun.ChaosExperimentRun{{
+			InfraID:         "infra-1",
+			ExperimentID:    "experiment-1",
+			ExperimentRunID: "run-1",
+			Phase:           string(model.ExperimentRunStatusRunning),
+			Audit:           mongodb.Audit{UpdatedBy: "admin"},
+		}},
+	}
+	mongodb.Operator = operator
+	return operator
+}
+
+func newRunService(operator *stopOperator) chaosExperimentRun.Service {
+	return chaosExperimentRun.NewChaosExperimentRunService(dbChaosExperiment.NewChaosExperimentOperator(operator),
+		dbChaosInfra.NewInfrastructureOperator(operator), dbChaosExperimentRun.NewChaosExperimentRunOperator(operator))
+}
+
+// TestProcessExperimentRunStop is used to test that the runs are only marked stopped once the stop is sent to the infra,
+// the stop is pending otherwise
+func TestProcessExperimentRunStop(t *testing.T) {
+	tests := []struct {
+		name            string
+		infraActive     bool
+		infraConnected  bool
+		expectedStopped bool
+	}{
+		{
+			name:            "connected infra",
+			infraActive:     true,
+			infraConnected:  true,
+			expectedStopped: true,
+		},
+		{
+			name: "offline infra",
+		},
+		{
+			name:        "infra active without connection",
+			infraActive: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			operator := newStopOperator(tc.infraActive)
+			state := store.NewStore()
+			infraAction := make(chan *model.InfraActionResponse, 1)
+			if tc.infraConnected {
+				state.ConnectedInfra["infra-1"] = infraAction
+			}
+
+			// when
+			err := newRunService(operator).ProcessExperimentRunStop(context.Background(), operator.runs, []string{"run-1"}, operator.experiment, "admin", state)
+
+			// then
+			assert.NoError(t, err)
+			assert.Len(t, operator.runUpdates, 1)
+			set := operator.runUpdates[0].Map()["$set"].(bson.D).Map()
+			if tc.expectedStopped {
+				assert.Equal(t, string(model.ExperimentRunStatusStopped), set["phase"])
+				assert.Equal(t, true, set["completed"])
+				assert.Equal(t, "workflow_run_stop", (<-infraAction).Action.RequestType)
+			} else {
+				assert.Equal(t, true, set["stop_pending"])
+				assert.NotContains(t, set, "completed")
+				assert.Empty(t, infraAction)
+			}
+		})
+	}
+}
+
+// TestSendPendingStops is used to test that the stops requested while the infra was offline are sent once it reconnects
+func TestSendPendingStops(t *testing.T) {
+	// given
+	operator := newStopOperator(true)
+	state := store.NewStore()
+	infraAction := make(chan *model.InfraActionResponse, 1)
+	state.ConnectedInfra["infra-1"] = infraAction
+
+	// when
+	err := newRunService(operator).SendPendingStops(context.Background(), "infra-1", state)
+
+	// then
+	assert.NoError(t, err)
+	action := <-infraAction
+	assert.Equal(t, "workflow_run_stop", action.Action.RequestType)
+	assert.JSONEq(t, `{"experiment_name":"experiment","experiment_id":"experiment-1","experiment_run_ids":["run-1"]}`, *action.Action.ExternalData)
+	assert.Equal(t, "admin", *action.Action.Username)
+	assert.Len(t, operator.runUpdates, 1)
+	assert.Equal(t, string(model.ExperimentRunStatusStopped), operator.runUpdates[0].Map()["$set"].(bson.D).Map()["phase"])
+}
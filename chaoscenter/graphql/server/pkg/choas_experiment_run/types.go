@@ -39,7 +39,8 @@ type Node struct {
 	FinishedAt string     `json:"finishedAt"`
 	Children   []string   `json:"children"`
 	Type       string     `json:"type"`
-	ChaosExp   *ChaosData `json:"chaos_data,omitempty"`
+	FaultName  string     `json:"faultName,omitempty"`
+	ChaosExp   *ChaosData `json:"chaosData,omitempty"`
 }
 
 // ChaosData is the data we get from chaos exporter
@@ -52,7 +53,7 @@ type ChaosData struct {
 	FaultStatus            string                  `json:"faultStatus"`
 	LastUpdatedAt          string                  `json:"lastUpdatedAt"`
 	FaultVerdict           string                  `json:"faultVerdict"`
-	ExperimentPod          string                  `json:"experimentPod"`
+	FaultPod               string                  `json:"faultPod"`
 	RunnerPod              string                  `json:"runnerPod"`
 	ProbeSuccessPercentage string                  `json:"probeSuccessPercentage"`
 	FailStep               string                  `json:"failStep"`
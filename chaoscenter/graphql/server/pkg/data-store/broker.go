@@ -0,0 +1,340 @@
+package data_store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/pubsub"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Topics of the messages exchanged between server replicas
+const (
+	InfraActionTopic        = "infra_action"
+	InfraEventTopic         = "infra_event"
+	ExperimentRunEventTopic = "experiment_run_event"
+	PodLogTopic             = "pod_log"
+	KubeObjectTopic         = "kube_object"
+	DryRunResultTopic       = "dry_run_result"
+)
+
+// Supported brokers
+const (
+	LocalBroker   = "local"
+	MongoDBBroker = "mongodb"
+)
+
+// changeStreamHistoryLost is the error code returned when the resume token is no longer in the oplog
+const changeStreamHistoryLost = 286
+
+// BrokerMessage is a state update exchanged between server replicas
+type BrokerMessage struct {
+	ReplicaID string
+	Topic     string
+	Key       string
+	Payload   []byte
+}
+
+// Broker relays the state updates handled by a server replica to the other replicas, so that a
+// subscription held by one replica receives the updates received by another
+type Broker interface {
+	Publish(ctx context.Context, message BrokerMessage) error
+	Subscribe(ctx context.Context, handler func(BrokerMessage)) error
+}
+
+type mongoBroker struct {
+	pubSubOperator *pubsub.Operator
+}
+
+// NewMongoBroker returns a Broker backed by a change stream on the pubsub collection
+func NewMongoBroker(pubSubOperator *pubsub.Operator) Broker {
+	return &mongoBroker{
+		pubSubOperator: pubSubOperator,
+	}
+}
+
+// Publish inserts the message in the pubsub collection
+func (b *mongoBroker) Publish(ctx context.Context, message BrokerMessage) error {
+	return b.pubSubOperator.InsertMessage(ctx, pubsub.Message{
+		ReplicaID: message.ReplicaID,
+		Topic:     message.Topic,
+		Key:       message.Key,
+		Payload:   string(message.Payload),
+		CreatedAt: time.Now(),
+	})
+}
+
+// Subscribe watches the pubsub collection and calls the handler for every published message until the context is done,
+// reopening the change stream from the last seen message whenever it gets interrupted
+func (b *mongoBroker) Subscribe(ctx context.Context, handler func(BrokerMessage)) error {
+	var (
+		resumeToken bson.Raw
+		backoff     = time.Second
+	)
+
+	for {
+		stream, err := b.pubSubOperator.WatchMessages(ctx, resumeToken)
+		if err == nil {
+			backoff = time.Second
+			for stream.Next(ctx) {
+				resumeToken = stream.ResumeToken()
+
+				var event pubsub.MessageEvent
+				if err := stream.Decode(&event); err != nil {
+					logrus.WithError(err).Error("failed to decode pubsub message")
+					continue
+				}
+				handler(BrokerMessage{
+					ReplicaID: event.FullDocument.ReplicaID,
+					Topic:     event.FullDocument.Topic,
+					Key:       event.FullDocument.Key,
+					Payload:   []byte(event.FullDocument.Payload),
+				})
+			}
+			err = stream.Err()
+			stream.Close(context.Background())
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		var cmdErr mongo.CommandError
+		if errors.As(err, &cmdErr) && cmdErr.Code == changeStreamHistoryLost {
+			resumeToken = nil
+		}
+
+		logrus.WithError(err).Warnf("pubsub change stream interrupted, reconnecting in %v", backoff)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff < 30*time.Second {
+			backoff *= 2
+		}
+	}
+}
+
+// ListenBroker delivers the messages published by the other replicas to the subscriptions held by this replica
+func (r *StateData) ListenBroker(ctx context.Context) error {
+	if r.Broker == nil {
+		return nil
+	}
+	return r.Broker.Subscribe(ctx, r.handleBrokerMessage)
+}
+
+// IsDistributed returns true if the state updates are shared with other server replicas
+func (r *StateData) IsDistributed() bool {
+	return r.Broker != nil
+}
+
+// SendInfraAction sends an action to the subscriber of the infra, relaying it to the other replicas when
+// the infra is not connected to this one. It returns false if the action could not be sent.
+func (r *StateData) SendInfraAction(infraID string, action *model.InfraActionResponse) bool {
+	if r.deliverInfraAction(infraID, action) {
+		return true
+	}
+	return r.publish(InfraActionTopic, infraID, action)
+}
+
+// PublishInfraEvent sends an infra event to all the users listening for the events of the project
+func (r *StateData) PublishInfraEvent(projectID string, event *model.InfraEventResponse) {
+	r.deliverInfraEvent(projectID, event)
+	r.publish(InfraEventTopic, projectID, event)
+}
+
+// PublishExperimentRun sends the experiment run to all the users subscribed to its project or experiment
+func (r *StateData) PublishExperimentRun(experimentRun *model.ExperimentRun) {
+	r.deliverExperimentRun(experimentRun)
+	r.publish(ExperimentRunEventTopic, experimentRun.ProjectID, experimentRun)
+}
+
+// SendPodLog sends the pod logs to the user who requested them. It returns false if the request is no longer active.
+func (r *StateData) SendPodLog(requestID string, podLog *model.PodLogResponse) bool {
+	if r.deliverPodLog(requestID, podLog) {
+		return true
+	}
+	return r.publish(PodLogTopic, requestID, podLog)
+}
+
+// SendKubeObject sends the kubernetes objects to the user who requested them. It returns false if the request is no longer active.
+func (r *StateData) SendKubeObject(requestID string, kubeObject *model.KubeObjectResponse) bool {
+	if r.deliverKubeObject(requestID, kubeObject) {
+		return true
+	}
+	return r.publish(KubeObjectTopic, requestID, kubeObject)
+}
+
+// SendDryRunResult sends the dry-run results to the user who requested them. It returns false if the request is no longer active.
+func (r *StateData) SendDryRunResult(requestID string, result *model.DryRunResultRequest) bool {
+	if r.deliverDryRunResult(requestID, result) {
+		return true
+	}
+	return r.publish(DryRunResultTopic, requestID, result)
+}
+
+func (r *StateData) publish(topic string, key string, payload interface{}) bool {
+	if r.Broker == nil {
+		return false
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logrus.WithField("topic", topic).WithError(err).Error("failed to marshal pubsub message")
+		return false
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err = r.Broker.Publish(ctx, BrokerMessage{
+		ReplicaID: r.ReplicaID,
+		Topic:     topic,
+		Key:       key,
+		Payload:   data,
+	})
+	if err != nil {
+		logrus.WithField("topic", topic).WithError(err).Error("failed to publish pubsub message")
+		return false
+	}
+	return true
+}
+
+func (r *StateData) handleBrokerMessage(message BrokerMessage) {
+	if message.ReplicaID == r.ReplicaID {
+		return
+	}
+
+	var err error
+	switch message.Topic {
+	case InfraActionTopic:
+		var action model.InfraActionResponse
+		if err = json.Unmarshal(message.Payload, &action); err == nil {
+			r.deliverInfraAction(message.Key, &action)
+		}
+	case InfraEventTopic:
+		var event model.InfraEventResponse
+		if err = json.Unmarshal(message.Payload, &event); err == nil {
+			r.deliverInfraEvent(message.Key, &event)
+		}
+	case ExperimentRunEventTopic:
+		var experimentRun model.ExperimentRun
+		if err = json.Unmarshal(message.Payload, &experimentRun); err == nil {
+			r.deliverExperimentRun(&experimentRun)
+		}
+	case PodLogTopic:
+		var podLog model.PodLogResponse
+		if err = json.Unmarshal(message.Payload, &podLog); err == nil {
+			r.deliverPodLog(message.Key, &podLog)
+		}
+	case KubeObjectTopic:
+		var kubeObject model.KubeObjectResponse
+		if err = json.Unmarshal(message.Payload, &kubeObject); err == nil {
+			r.deliverKubeObject(message.Key, &kubeObject)
+		}
+	case DryRunResultTopic:
+		var result model.DryRunResultRequest
+		if err = json.Unmarshal(message.Payload, &result); err == nil {
+			r.deliverDryRunResult(message.Key, &result)
+		}
+	default:
+		logrus.WithField("topic", message.Topic).Warn("received pubsub message for unknown topic")
+	}
+	if err != nil {
+		logrus.WithField("topic", message.Topic).WithError(err).Error("failed to unmarshal pubsub message")
+	}
+}
+
+func (r *StateData) deliverInfraAction(infraID string, action *model.InfraActionResponse) bool {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	if observer, ok := r.ConnectedInfra[infraID]; ok {
+		observer <- action
+		return true
+	}
+	return false
+}
+
+func (r *StateData) deliverInfraEvent(projectID string, event *model.InfraEventResponse) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	for _, observer := range r.InfraEventPublish[projectID] {
+		observer <- event
+	}
+}
+
+func (r *StateData) deliverExperimentRun(experimentRun *model.ExperimentRun) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	// subscribers are registered against the project, or against the experiment of the project when they are scoped to an experiment
+	keys := []string{experimentRun.ProjectID}
+	if experimentRun.ExperimentID != "" {
+		keys = append(keys, ExperimentRunObserverKey(experimentRun.ProjectID, experimentRun.ExperimentID))
+	}
+	for _, key := range keys {
+		for _, observer := range r.ExperimentEventPublish[key] {
+			select {
+			case observer <- experimentRun:
+			default:
+				logrus.WithField("experimentRunID", experimentRun.ExperimentRunID).Warn("experiment run event dropped as the subscriber is not keeping up")
+			}
+		}
+	}
+}
+
+// deliverPodLog sends the log chunk to the request, the request is removed and its channel closed with its last chunk.
+// Chunks are sent without blocking while holding the lock, so that they can't race with the closing of the channel
+// nor block once the user is gone.
+func (r *StateData) deliverPodLog(requestID string, podLog *model.PodLogResponse) bool {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	reqChan, ok := r.ExperimentLog[requestID]
+	if !ok {
+		return false
+	}
+	select {
+	case reqChan <- podLog:
+	default:
+		logrus.WithField("requestID", requestID).Warn("pod log chunk dropped as the user is not keeping up")
+	}
+	if podLog.IsFinal {
+		delete(r.ExperimentLog, requestID)
+		close(reqChan)
+	}
+	return true
+}
+
+func (r *StateData) deliverKubeObject(requestID string, kubeObject *model.KubeObjectResponse) bool {
+	r.Mutex.Lock()
+	reqChan, ok := r.KubeObjectData[requestID]
+	if ok {
+		delete(r.KubeObjectData, requestID)
+	}
+	r.Mutex.Unlock()
+	if !ok {
+		return false
+	}
+	reqChan <- kubeObject
+	close(reqChan)
+	return true
+}
+
+func (r *StateData) deliverDryRunResult(requestID string, result *model.DryRunResultRequest) bool {
+	r.Mutex.Lock()
+	reqChan, ok := r.DryRunResults[requestID]
+	if ok {
+		delete(r.DryRunResults, requestID)
+	}
+	r.Mutex.Unlock()
+	if !ok {
+		return false
+	}
+	reqChan <- result
+	close(reqChan)
+	return true
+}
@@ -0,0 +1,124 @@
+package data_store_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/stretchr/testify/assert"
+)
+
+// memoryBroker delivers the published messages to every subscriber synchronously
+type memoryBroker struct {
+	mutex    sync.Mutex
+	handlers []func(data_store.BrokerMessage)
+}
+
+func (b *memoryBroker) Publish(ctx context.Context, message data_store.BrokerMessage) error {
+	b.mutex.Lock()
+	handlers := append([]func(data_store.BrokerMessage){}, b.handlers...)
+	b.mutex.Unlock()
+	for _, handler := range handlers {
+		handler(message)
+	}
+	return nil
+}
+
+func (b *memoryBroker) Subscribe(ctx context.Context, handler func(data_store.BrokerMessage)) error {
+	b.mutex.Lock()
+	b.handlers = append(b.handlers, handler)
+	b.mutex.Unlock()
+	return nil
+}
+
+func newReplicas(t *testing.T) (*data_store.StateData, *data_store.StateData) {
+	broker := &memoryBroker{}
+	replicaA, replicaB := data_store.NewStore(), data_store.NewStore()
+	for _, replica := range []*data_store.StateData{replicaA, replicaB} {
+		replica.Broker = broker
+		assert.NoError(t, replica.ListenBroker(context.Background()))
+	}
+	return replicaA, replicaB
+}
+
+// TestSendInfraActionAcrossReplicas is used to test that an action reaches an infra connected to another replica
+func TestSendInfraActionAcrossReplicas(t *testing.T) {
+	// given
+	replicaA, replicaB := newReplicas(t)
+	infraAction := make(chan *model.InfraActionResponse, 1)
+	replicaB.ConnectedInfra["infra-1"] = infraAction
+
+	// when
+	sent := replicaA.SendInfraAction("infra-1", &model.InfraActionResponse{ProjectID: "project-1"})
+
+	// then
+	assert.True(t, sent)
+	assert.Equal(t, "project-1", (<-infraAction).ProjectID)
+}
+
+// TestSendInfraActionStandalone is used to test that an action for an unknown infra is not sent without a broker
+func TestSendInfraActionStandalone(t *testing.T) {
+	// given
+	store := data_store.NewStore()
+
+	// when
+	sent := store.SendInfraAction("infra-1", &model.InfraActionResponse{})
+
+	// then
+	assert.False(t, sent)
+}
+
+// TestPublishExperimentRunAcrossReplicas is used to test that experiment run events are fanned out once to every replica
+func TestPublishExperimentRunAcrossReplicas(t *testing.T) {
+	// given
+	replicaA, replicaB := newReplicas(t)
+	localEvents := make(chan *model.ExperimentRun, 10)
+	remoteEvents := make(chan *model.ExperimentRun, 10)
+	replicaA.ExperimentEventPublish["project-1"] = []chan *model.ExperimentRun{localEvents}
+	replicaB.ExperimentEventPublish[data_store.ExperimentRunObserverKey("project-1", "experiment-1")] = []chan *model.ExperimentRun{remoteEvents}
+
+	// when
+	replicaA.PublishExperimentRun(&model.ExperimentRun{ProjectID: "project-1", ExperimentID: "experiment-1", ExperimentRunID: "run-1"})
+
+	// then
+	assert.Len(t, localEvents, 1)
+	assert.Len(t, remoteEvents, 1)
+	assert.Equal(t, "run-1", (<-remoteEvents).ExperimentRunID)
+}
+
+// TestPublishExperimentRunOfAnotherProject is used to test that the runs of an experiment aren't sent to the observers
+// of the experiment registered for another project
+func TestPublishExperimentRunOfAnotherProject(t *testing.T) {
+	// given
+	store := data_store.NewStore()
+	projectEvents := make(chan *model.ExperimentRun, 10)
+	otherProjectEvents := make(chan *model.ExperimentRun, 10)
+	store.AddExperimentRunObserver(data_store.ExperimentRunObserverKey("project-1", "experiment-1"), projectEvents)
+	store.AddExperimentRunObserver(data_store.ExperimentRunObserverKey("project-2", "experiment-1"), otherProjectEvents)
+
+	// when
+	store.PublishExperimentRun(&model.ExperimentRun{ProjectID: "project-1", ExperimentID: "experiment-1", ExperimentRunID: "run-1"})
+
+	// then
+	assert.Len(t, projectEvents, 1)
+	assert.Len(t, otherProjectEvents, 0)
+}
+
+// TestSendPodLogAcrossReplicas is used to test that pod logs reach the replica holding the log request
+func TestSendPodLogAcrossReplicas(t *testing.T) {
+	// given
+	replicaA, replicaB := newReplicas(t)
+	podLog := make(chan *model.PodLogResponse, 1)
+	replicaB.ExperimentLog["request-1"] = podLog
+
+	// when
+	sent := replicaA.SendPodLog("request-1", &model.PodLogResponse{Log: "logs", IsFinal: true})
+
+	// then
+	assert.True(t, sent)
+	assert.Equal(t, "logs", (<-podLog).Log)
+	_, ok := replicaB.ExperimentLog["request-1"]
+	assert.False(t, ok)
+}
@@ -1,8 +1,10 @@
 package data_store
 
 import (
+	"context"
 	"sync"
 
+	"github.com/google/uuid"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 )
 
@@ -13,9 +15,25 @@ type StateData struct {
 	ExperimentEventPublish map[string][]chan *model.ExperimentRun
 	ExperimentLog          map[string]chan *model.PodLogResponse
 	KubeObjectData         map[string]chan *model.KubeObjectResponse
+	DryRunResults          map[string]chan *model.DryRunResultRequest
 	Mutex                  *sync.Mutex
+	// Broker relays the state updates to the other server replicas, it is nil when the server runs standalone
+	Broker Broker
+	// ReplicaID identifies the messages published by this server replica
+	ReplicaID string
+	// PodLogMaxBytes is the number of bytes of logs sent to the user for a single pod log request
+	PodLogMaxBytes int
 }
 
+const (
+	// DefaultPodLogMaxBytes is the default byte budget of a pod log request
+	DefaultPodLogMaxBytes = 10 * 1024 * 1024
+	// podLogBufferSize is the number of log chunks buffered for a pod log request
+	podLogBufferSize = 100
+	// PodLogLimitExceeded is sent as the last chunk of the logs when the request exceeds its byte budget
+	PodLogLimitExceeded = "LOG LIMIT EXCEEDED : NARROW DOWN THE REQUEST USING TAIL LINES OR SINCE TIME"
+)
+
 func NewStore() *StateData {
 	return &StateData{
 		InfraEventPublish:      make(map[string][]chan *model.InfraEventResponse),
@@ -23,8 +41,112 @@ func NewStore() *StateData {
 		ExperimentEventPublish: make(map[string][]chan *model.ExperimentRun),
 		ExperimentLog:          make(map[string]chan *model.PodLogResponse),
 		KubeObjectData:         make(map[string]chan *model.KubeObjectResponse),
+		DryRunResults:          make(map[string]chan *model.DryRunResultRequest),
 		Mutex:                  &sync.Mutex{},
+		ReplicaID:              uuid.New().String(),
+		PodLogMaxBytes:         DefaultPodLogMaxBytes,
 	}
 }
 
 var Store = NewStore()
+
+// ExperimentRunObserverKey returns the key of the observers of the experiment runs of the project, or of the experiment
+// when experimentID isn't empty. The experiment keys are scoped to the project, so that the runs of an experiment can
+// only be observed by the members of its project
+func ExperimentRunObserverKey(projectID string, experimentID string) string {
+	if experimentID == "" {
+		return projectID
+	}
+
+	return projectID + "/" + experimentID
+}
+
+// DisconnectInfra closes the connection of the infra to this server replica, which completes its subscription so that
+// the subscriber reconnects. It returns false when the infra isn't connected to this replica
+func (r *StateData) DisconnectInfra(infraID string) bool {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	infraAction, ok := r.ConnectedInfra[infraID]
+	if !ok {
+		return false
+	}
+	delete(r.ConnectedInfra, infraID)
+	close(infraAction)
+	return true
+}
+
+// AddExperimentRunObserver registers a channel to receive the experiment runs published for the key returned by
+// ExperimentRunObserverKey
+func (r *StateData) AddExperimentRunObserver(key string, observer chan *model.ExperimentRun) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	r.ExperimentEventPublish[key] = append(r.ExperimentEventPublish[key], observer)
+}
+
+// RemoveExperimentRunObserver unregisters a channel added with AddExperimentRunObserver
+func (r *StateData) RemoveExperimentRunObserver(key string, observer chan *model.ExperimentRun) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	observers := r.ExperimentEventPublish[key]
+	for i, o := range observers {
+		if o == observer {
+			observers = append(observers[:i], observers[i+1:]...)
+			break
+		}
+	}
+	if len(observers) == 0 {
+		delete(r.ExperimentEventPublish, key)
+	} else {
+		r.ExperimentEventPublish[key] = observers
+	}
+}
+
+// AddPodLogRequest registers the pod log request and returns the channel on which its logs are sent to the user.
+// The channel is closed once the last chunk of the logs is sent, the request exceeds its byte budget or ctx is done.
+func (r *StateData) AddPodLogRequest(ctx context.Context, requestID string) <-chan *model.PodLogResponse {
+	podLogs := make(chan *model.PodLogResponse, podLogBufferSize)
+	r.Mutex.Lock()
+	r.ExperimentLog[requestID] = podLogs
+	r.Mutex.Unlock()
+
+	response := make(chan *model.PodLogResponse, 1)
+	go func() {
+		forwardPodLogs(ctx, podLogs, response, r.PodLogMaxBytes)
+		r.Mutex.Lock()
+		delete(r.ExperimentLog, requestID)
+		r.Mutex.Unlock()
+	}()
+	return response
+}
+
+// forwardPodLogs sends the log chunks to the user until the last chunk is sent or the byte budget is exceeded,
+// in which case the chunk is replaced by PodLogLimitExceeded
+func forwardPodLogs(ctx context.Context, podLogs <-chan *model.PodLogResponse, response chan<- *model.PodLogResponse, maxBytes int) {
+	defer close(response)
+	sent := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case podLog, ok := <-podLogs:
+			if !ok {
+				return
+			}
+			sent += len(podLog.Log)
+			if sent > maxBytes {
+				limited := *podLog
+				limited.Log = PodLogLimitExceeded
+				limited.IsFinal = true
+				podLog = &limited
+			}
+			select {
+			case response <- podLog:
+			case <-ctx.Done():
+				return
+			}
+			if podLog.IsFinal {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package data_store_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAddPodLogRequestStreamsChunks is used to test that the log chunks are sent until the last chunk
+func TestAddPodLogRequestStreamsChunks(t *testing.T) {
+	// given
+	store := data_store.NewStore()
+	podLogs := store.AddPodLogRequest(context.Background(), "request-1")
+
+	// when
+	store.SendPodLog("request-1", &model.PodLogResponse{Log: "first"})
+	store.SendPodLog("request-1", &model.PodLogResponse{Log: "second"})
+	store.SendPodLog("request-1", &model.PodLogResponse{Log: "last", IsFinal: true})
+
+	// then
+	var logs []string
+	for podLog := range podLogs {
+		logs = append(logs, podLog.Log)
+	}
+	assert.Equal(t, []string{"first", "second", "last"}, logs)
+	assert.False(t, store.SendPodLog("request-1", &model.PodLogResponse{Log: "late"}))
+}
+
+// TestAddPodLogRequestExceedsBudget is used to test that the logs are cut off once the request exceeds its byte budget
+func TestAddPodLogRequestExceedsBudget(t *testing.T) {
+	// given
+	store := data_store.NewStore()
+	store.PodLogMaxBytes = 8
+	podLogs := store.AddPodLogRequest(context.Background(), "request-1")
+
+	// when
+	store.SendPodLog("request-1", &model.PodLogResponse{Log: "12345"})
+	store.SendPodLog("request-1", &model.PodLogResponse{Log: "67890"})
+
+	// then
+	assert.Equal(t, "12345", (<-podLogs).Log)
+	limited := <-podLogs
+	assert.Equal(t, data_store.PodLogLimitExceeded, limited.Log)
+	assert.True(t, limited.IsFinal)
+	_, ok := <-podLogs
+	assert.False(t, ok)
+}
+
+// TestAddPodLogRequestCancelled is used to test that the request is removed once the user stops listening
+func TestAddPodLogRequestCancelled(t *testing.T) {
+	// given
+	store := data_store.NewStore()
+	ctx, cancel := context.WithCancel(context.Background())
+	podLogs := store.AddPodLogRequest(ctx, "request-1")
+
+	// when
+	cancel()
+
+	// then
+	_, ok := <-podLogs
+	assert.False(t, ok)
+	assert.Eventually(t, func() bool {
+		return !store.SendPodLog("request-1", &model.PodLogResponse{Log: "late"})
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestSendPodLogFinalChunkDoesNotBlock is used to test that the last chunk doesn't block when the user stopped reading
+func TestSendPodLogFinalChunkDoesNotBlock(t *testing.T) {
+	// given
+	store := data_store.NewStore()
+	podLogs := store.AddPodLogRequest(context.Background(), "request-1")
+	store.SendPodLog("request-1", &model.PodLogResponse{Log: "chunk"})
+	assert.Eventually(t, func() bool { return len(podLogs) == 1 }, time.Second, time.Millisecond)
+	for i := 0; i < 200; i++ {
+		store.SendPodLog("request-1", &model.PodLogResponse{Log: "chunk"})
+	}
+
+	// when
+	sent := make(chan bool, 1)
+	go func() {
+		sent <- store.SendPodLog("request-1", &model.PodLogResponse{Log: "last", IsFinal: true})
+	}()
+
+	// then
+	select {
+	case ok := <-sent:
+		assert.True(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("the last pod log chunk blocked")
+	}
+	for range podLogs {
+	}
+	assert.False(t, store.SendPodLog("request-1", &model.PodLogResponse{Log: "late"}))
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for audit event collection, events are append only
+// so the operator doesn't allow to update or delete them
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewAuditOperator returns a new instance of Operator
+func NewAuditOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertEvent inserts a new audit event in the audit event collection
+func (a *Operator) InsertEvent(ctx context.Context, event Event) error {
+	return a.operator.Create(ctx, mongodb.AuditEventCollection, event)
+}
+
+// ListEvents returns a cursor over the audit events matching the query, latest first
+func (a *Operator) ListEvents(ctx context.Context, query bson.D, skip int64, limit int64) (*mongo.Cursor, error) {
+	opts := options.Find().SetSort(bson.D{{"timestamp", -1}})
+	if skip > 0 {
+		opts.SetSkip(skip)
+	}
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return a.operator.List(ctx, mongodb.AuditEventCollection, query, opts)
+}
+
+// CountEvents returns the number of audit events matching the query
+func (a *Operator) CountEvents(ctx context.Context, query bson.D) (int64, error) {
+	return a.operator.CountDocuments(ctx, mongodb.AuditEventCollection, query)
+}
@@ -0,0 +1,28 @@
+package audit
+
+// Outcome of an audited operation
+const (
+	OutcomeSuccess = "SUCCESS"
+	OutcomeFailure = "FAILURE"
+)
+
+// Event contains the required fields to be stored in the database for an audited operation,
+// events are shared with the authentication service which records the operations it serves
+type Event struct {
+	EventID      string `bson:"event_id"`
+	ProjectID    string `bson:"project_id,omitempty"`
+	Timestamp    int64  `bson:"timestamp"`
+	Actor        Actor  `bson:"actor"`
+	Action       string `bson:"action"`
+	ResourceType string `bson:"resource_type"`
+	ResourceID   string `bson:"resource_id,omitempty"`
+	Outcome      string `bson:"outcome"`
+	Error        string `bson:"error,omitempty"`
+	Source       string `bson:"source"`
+}
+
+// Actor is the user who performed an audited operation, or the infra for the mutations sent by the chaos infrastructures
+type Actor struct {
+	UserID   string `bson:"user_id"`
+	Username string `bson:"username"`
+}
@@ -0,0 +1,63 @@
+package blackout_window
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Operator is used to perform operations on the database
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewBlackoutWindowOperator returns a new instance of Operator
+func NewBlackoutWindowOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertBlackoutWindow inserts a new blackout window in the database
+func (b *Operator) InsertBlackoutWindow(ctx context.Context, window BlackoutWindow) error {
+	return b.operator.Create(ctx, mongodb.BlackoutWindowCollection, window)
+}
+
+// UpdateBlackoutWindow updates the blackout window matching the query
+func (b *Operator) UpdateBlackoutWindow(ctx context.Context, query bson.D, update bson.D) error {
+	_, err := b.operator.Update(ctx, mongodb.BlackoutWindowCollection, query, update)
+	return err
+}
+
+// GetBlackoutWindow returns the blackout window matching the query
+func (b *Operator) GetBlackoutWindow(ctx context.Context, query bson.D) (BlackoutWindow, error) {
+	result, err := b.operator.Get(ctx, mongodb.BlackoutWindowCollection, query)
+	if err != nil {
+		return BlackoutWindow{}, err
+	}
+
+	var window BlackoutWindow
+	err = result.Decode(&window)
+	if err != nil {
+		return BlackoutWindow{}, err
+	}
+
+	return window, nil
+}
+
+// ListBlackoutWindows returns the blackout windows matching the query
+func (b *Operator) ListBlackoutWindows(ctx context.Context, query bson.D) ([]BlackoutWindow, error) {
+	results, err := b.operator.List(ctx, mongodb.BlackoutWindowCollection, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var windows []BlackoutWindow
+	err = results.All(ctx, &windows)
+	if err != nil {
+		return nil, err
+	}
+
+	return windows, nil
+}
@@ -0,0 +1,20 @@
+package blackout_window
+
+import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+
+// BlackoutWindow is a period during which no fault is injected in the project or in one of its environments
+type BlackoutWindow struct {
+	mongodb.Audit  `bson:",inline"`
+	WindowID       string   `bson:"window_id"`
+	ProjectID      string   `bson:"project_id"`
+	EnvironmentID  string   `bson:"environment_id,omitempty"`
+	Name           string   `bson:"name"`
+	Description    string   `bson:"description"`
+	Timezone       string   `bson:"timezone"`
+	Type           string   `bson:"type"`
+	StartTime      int64    `bson:"start_time,omitempty"`
+	EndTime        int64    `bson:"end_time,omitempty"`
+	Weekdays       []string `bson:"weekdays,omitempty"`
+	DailyStartTime string   `bson:"daily_start_time,omitempty"`
+	DailyEndTime   string   `bson:"daily_end_time,omitempty"`
+}
@@ -37,6 +37,27 @@ type ChaosExperimentRequest struct {
 	IsCustomExperiment         bool                  `bson:"is_custom_experiment"`
 	RecentExperimentRunDetails []ExperimentRunDetail `bson:"recent_experiment_run_details"` // stores the details of last 10 experiment runs
 	TotalExperimentRuns        int                   `bson:"total_experiment_runs"`
+	PendingReview              *PendingReview        `bson:"pending_review,omitempty"`
+	GitSync                    *GitSync              `bson:"git_sync,omitempty"`
+	GitSyncError               string                `bson:"git_sync_error,omitempty"` // error of the last write of the experiment to git
+}
+
+// PendingReview contains the details of the pull request opened for the last change of an experiment in the pull
+// request gitops mode, it is unset once the pull request is merged or closed
+type PendingReview struct {
+	Number   int    `bson:"number"`
+	URL      string `bson:"url"`
+	Branch   string `bson:"branch"`
+	Head     string `bson:"head"`
+	OpenedAt int64  `bson:"opened_at"`
+}
+
+// GitSync contains the checksums of the manifests of an experiment in the DB and in the gitops repository when it was
+// last synced, they are the common base used to find which side changed the experiment
+type GitSync struct {
+	DBChecksum  string `bson:"db_checksum"`
+	GitChecksum string `bson:"git_checksum"`
+	SyncedAt    int64  `bson:"synced_at"`
 }
 
 // ChaosExperimentsWithRunDetails contains the required fields to be stored in the database for a chaos experiment input
@@ -55,6 +76,8 @@ type ChaosExperimentsWithRunDetails struct {
 	AvgResScore                float64                                   `bson:"avg_resiliency_score"`
 	IsCustomExperiment         bool                                      `bson:"is_custom_experiment"`
 	IsRemoved                  bool                                      `bson:"is_removed"`
+	PendingReview              *PendingReview                            `bson:"pending_review,omitempty"`
+	GitSyncError               string                                    `bson:"git_sync_error,omitempty"`
 }
 
 // AvgResScore contains average resiliency score
@@ -74,6 +97,19 @@ type ExperimentRevision struct {
 	ExperimentManifest string             `bson:"experiment_manifest"`
 	UpdatedAt          int64              `bson:"updated_at"`
 	Weightages         []*WeightagesInput `bson:"weightages"`
+	ScoringPolicy      *ScoringPolicy     `bson:"scoring_policy,omitempty"`
+}
+
+// ScoringPolicy contains the required fields to be stored in the database for the resiliency score policy of a revision
+type ScoringPolicy struct {
+	Strategy        string            `bson:"strategy"`
+	FaultThresholds []*FaultThreshold `bson:"fault_thresholds"`
+}
+
+// FaultThreshold contains the minimum probe success percentage for a fault to be considered as passed
+type FaultThreshold struct {
+	FaultName              string `bson:"fault_name"`
+	ProbeSuccessPercentage int    `bson:"probe_success_percentage"`
 }
 
 // WeightagesInput contains the required fields to be stored in the database for a weightages input
@@ -99,26 +135,27 @@ type TotalFilteredData struct {
 
 type FlattenedExperimentRun struct {
 	mongodb.Audit          `bson:",inline"`
-	ProjectID              string                            `bson:"project_id"`
-	ExperimentID           string                            `bson:"experiment_id"`
-	ExperimentRunID        string                            `bson:"experiment_run_id"`
-	CronSyntax             string                            `bson:"cron_syntax"`
-	ExecutionData          string                            `bson:"execution_data"`
-	RevisionID             string                            `bson:"revision_id"`
-	InfraID                string                            `bson:"infra_id"`
-	Phase                  string                            `bson:"phase"`
-	KubernetesInfraDetails []chaos_infrastructure.ChaosInfra `bson:"kubernetesInfraDetails,omitempty"`
-	ExperimentDetails      []ExperimentDetails               `bson:"experiment"`
-	ResiliencyScore        *float64                          `bson:"resiliency_score,string,omitempty"`
-	FaultsPassed           *int                              `bson:"faults_passed,string,omitempty"`
-	FaultsFailed           *int                              `bson:"faults_failed,string,omitempty"`
-	FaultsAwaited          *int                              `bson:"faults_awaited,string,omitempty"`
-	FaultsStopped          *int                              `bson:"faults_stopped,string,omitempty"`
-	FaultsNA               *int                              `bson:"faults_na,string,omitempty"`
-	TotalFaults            *int                              `bson:"total_faults,string,omitempty"`
-	IsCustomExperiment     bool                              `bson:"is_custom_experiment"`
-	Completed              bool                              `bson:"completed"`
-	IsRemoved              bool                              `bson:"is_removed"`
+	ProjectID              string                             `bson:"project_id"`
+	ExperimentID           string                             `bson:"experiment_id"`
+	ExperimentRunID        string                             `bson:"experiment_run_id"`
+	CronSyntax             string                             `bson:"cron_syntax"`
+	ExecutionData          string                             `bson:"execution_data"`
+	RevisionID             string                             `bson:"revision_id"`
+	InfraID                string                             `bson:"infra_id"`
+	Phase                  string                             `bson:"phase"`
+	KubernetesInfraDetails []chaos_infrastructure.ChaosInfra  `bson:"kubernetesInfraDetails,omitempty"`
+	ExperimentDetails      []ExperimentDetails                `bson:"experiment"`
+	ResiliencyScore        *float64                           `bson:"resiliency_score,string,omitempty"`
+	FaultsPassed           *int                               `bson:"faults_passed,string,omitempty"`
+	FaultsFailed           *int                               `bson:"faults_failed,string,omitempty"`
+	FaultsAwaited          *int                               `bson:"faults_awaited,string,omitempty"`
+	FaultsStopped          *int                               `bson:"faults_stopped,string,omitempty"`
+	FaultsNA               *int                               `bson:"faults_na,string,omitempty"`
+	TotalFaults            *int                               `bson:"total_faults,string,omitempty"`
+	IsCustomExperiment     bool                               `bson:"is_custom_experiment"`
+	Completed              bool                               `bson:"completed"`
+	IsRemoved              bool                               `bson:"is_removed"`
+	EventContext           *chaos_experiment_run.EventContext `bson:"event_context,omitempty"`
 }
 
 type ExperimentDetails struct {
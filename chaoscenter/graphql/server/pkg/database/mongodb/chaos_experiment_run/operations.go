@@ -14,6 +14,22 @@ var (
 	backgroundContext = context.Background()
 )
 
+// ActiveRunTimeout is the duration after which a run which hasn't been updated isn't considered in progress anymore
+const ActiveRunTimeout = time.Hour
+
+// InProgressRunsQuery returns the query matching the queued and running runs of the experiment on the infra which
+// have been updated within ActiveRunTimeout, so that a run stuck without completing doesn't block the new runs
+func InProgressRunsQuery(infraID string, experimentID string, now time.Time) bson.D {
+	return bson.D{
+		{"infra_id", infraID},
+		{"experiment_id", experimentID},
+		{"completed", false},
+		{"is_removed", false},
+		{"phase", bson.D{{"$in", bson.A{"Queued", "Running"}}}},
+		{"updated_at", bson.D{{"$gte", now.Add(-ActiveRunTimeout).UnixMilli()}}},
+	}
+}
+
 type Operator struct {
 	operator mongodb.MongoOperator
 }
@@ -132,6 +148,7 @@ func (c *Operator) UpdateExperimentRun(ctx context.Context, wfRun ChaosExperimen
 				{"updated_by", wfRun.UpdatedBy},
 				{"updated_at", wfRun.UpdatedAt},
 				{"is_removed", wfRun.IsRemoved},
+				{"last_event_id", wfRun.LastEventID},
 			}}}
 
 		result, err := mongodb.Operator.Update(ctx, mongodb.ChaosExperimentRunsCollection, updateQuery, update)
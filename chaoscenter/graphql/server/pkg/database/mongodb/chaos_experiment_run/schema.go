@@ -6,21 +6,34 @@ import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mo
 type ChaosExperimentRun struct {
 	ProjectID       string `bson:"project_id"`
 	mongodb.Audit   `bson:",inline"`
-	InfraID         string   `bson:"infra_id"`
-	ExperimentRunID string   `bson:"experiment_run_id"`
-	ExperimentID    string   `bson:"experiment_id"`
-	Phase           string   `bson:"phase"`
-	ExecutionData   string   `bson:"execution_data"`
-	RevisionID      string   `bson:"revision_id"`
-	NotifyID        *string  `bson:"notify_id"`
-	ResiliencyScore *float64 `bson:"resiliency_score,omitempty"`
-	FaultsPassed    *int     `bson:"faults_passed,omitempty"`
-	FaultsFailed    *int     `bson:"faults_failed,omitempty"`
-	FaultsAwaited   *int     `bson:"faults_awaited,omitempty"`
-	FaultsStopped   *int     `bson:"faults_stopped,omitempty"`
-	FaultsNA        *int     `bson:"faults_na,omitempty"`
-	TotalFaults     *int     `bson:"total_faults,omitempty"`
-	Completed       bool     `bson:"completed"`
+	InfraID         string        `bson:"infra_id"`
+	ExperimentRunID string        `bson:"experiment_run_id"`
+	ExperimentID    string        `bson:"experiment_id"`
+	Phase           string        `bson:"phase"`
+	ExecutionData   string        `bson:"execution_data"`
+	RevisionID      string        `bson:"revision_id"`
+	NotifyID        *string       `bson:"notify_id"`
+	ResiliencyScore *float64      `bson:"resiliency_score,omitempty"`
+	FaultsPassed    *int          `bson:"faults_passed,omitempty"`
+	FaultsFailed    *int          `bson:"faults_failed,omitempty"`
+	FaultsAwaited   *int          `bson:"faults_awaited,omitempty"`
+	FaultsStopped   *int          `bson:"faults_stopped,omitempty"`
+	FaultsNA        *int          `bson:"faults_na,omitempty"`
+	TotalFaults     *int          `bson:"total_faults,omitempty"`
+	Completed       bool          `bson:"completed"`
+	LastEventID     string        `bson:"last_event_id,omitempty"`
+	EventContext    *EventContext `bson:"event_context,omitempty"`
+	// StopPending is set when the run is stopped while its infra is offline, the stop is sent once the infra reconnects
+	StopPending bool `bson:"stop_pending,omitempty"`
+}
+
+// EventContext contains the details of the resource event for which an event-tracker policy triggered the experiment run
+type EventContext struct {
+	PolicyName        string `bson:"policy_name"`
+	ResourceKind      string `bson:"resource_kind"`
+	ResourceName      string `bson:"resource_name"`
+	ResourceNamespace string `bson:"resource_namespace,omitempty"`
+	Diff              string `bson:"diff,omitempty"`
 }
 
 type TotalFilteredData struct {
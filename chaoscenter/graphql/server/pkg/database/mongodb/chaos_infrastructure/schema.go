@@ -28,6 +28,7 @@ type ChaosInfra struct {
 	Tolerations             []*Toleration `bson:"tolerations,omitempty"`
 	StartTime               string        `bson:"start_time"`
 	Version                 string        `bson:"version"`
+	LastHeartbeatAt         int64         `bson:"last_heartbeat,omitempty"`
 }
 
 type TotalFilteredData struct {
@@ -59,6 +60,7 @@ type ChaosInfraDetails struct {
 	SkipSSL                 *bool            `bson:"skip_ssl"`
 	InfraNsExists           *bool            `bson:"infra_ns_exists"`
 	InfraSaExists           *bool            `bson:"infra_sa_exists"`
+	LastHeartbeatAt         int64            `bson:"last_heartbeat,omitempty"`
 }
 
 type AggregatedGetInfras struct {
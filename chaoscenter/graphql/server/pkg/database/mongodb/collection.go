@@ -38,6 +38,16 @@ func (g *GetCollectionStruct) getCollection(collectionType int) (*mongo.Collecti
 		return mongoClient.(*MongoClient).GitOpsCollection, nil
 	case EnvironmentCollection:
 		return mongoClient.(*MongoClient).EnvironmentCollection, nil
+	case PubSubCollection:
+		return mongoClient.(*MongoClient).PubSubCollection, nil
+	case AuditEventCollection:
+		return mongoClient.(*MongoClient).AuditEventCollection, nil
+	case BlackoutWindowCollection:
+		return mongoClient.(*MongoClient).BlackoutWindowCollection, nil
+	case NotificationRuleCollection:
+		return mongoClient.(*MongoClient).NotificationRuleCollection, nil
+	case NotificationDeliveryCollection:
+		return mongoClient.(*MongoClient).NotificationDeliveryCollection, nil
 	default:
 		return nil, errors.New("unknown collection name")
 	}
@@ -31,9 +31,19 @@ func (g *Operator) AddGitConfig(ctx context.Context, config *GitConfigDB) error
 	return nil
 }
 
-// GetGitConfig retrieves git config using project id
-func (g *Operator) GetGitConfig(ctx context.Context, projectID string) (*GitConfigDB, error) {
-	query := bson.D{{"project_id", projectID}}
+// GitConfigQuery returns the query matching the git config of the project, the configs added before a project
+// could have multiple configs have no config id and are matched by an empty one
+func GitConfigQuery(projectID string, configID string) bson.D {
+	if configID == "" {
+		return bson.D{{"project_id", projectID}, {"config_id", bson.D{{"$in", bson.A{nil, ""}}}}}
+	}
+
+	return bson.D{{"project_id", projectID}, {"config_id", configID}}
+}
+
+// GetGitConfig retrieves git config using project id and config id
+func (g *Operator) GetGitConfig(ctx context.Context, projectID string, configID string) (*GitConfigDB, error) {
+	query := GitConfigQuery(projectID, configID)
 	var res GitConfigDB
 	result, err := g.operator.Get(ctx, mongodb.GitOpsCollection, query)
 	err = result.Decode(&res)
@@ -47,6 +57,21 @@ func (g *Operator) GetGitConfig(ctx context.Context, projectID string) (*GitConf
 	return &res, nil
 }
 
+// GetGitConfigs retrieves all git configs of the project
+func (g *Operator) GetGitConfigs(ctx context.Context, projectID string) ([]GitConfigDB, error) {
+	query := bson.D{{"project_id", projectID}}
+	results, err := g.operator.List(ctx, mongodb.GitOpsCollection, query)
+	if err != nil {
+		return nil, err
+	}
+	var configs []GitConfigDB
+	err = results.All(ctx, &configs)
+	if err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
 // GetAllGitConfig retrieves all git configs from db
 func (g *Operator) GetAllGitConfig(ctx context.Context) ([]GitConfigDB, error) {
 	query := bson.D{{}}
@@ -62,6 +87,25 @@ func (g *Operator) GetAllGitConfig(ctx context.Context) ([]GitConfigDB, error) {
 	return configs, nil
 }
 
+// GetGitConfigsByRepository retrieves the git configs syncing the branch of the repositories whose URL matches the
+// case-insensitive pattern
+func (g *Operator) GetGitConfigsByRepository(ctx context.Context, branch string, repositoryURLPattern string) ([]GitConfigDB, error) {
+	query := bson.D{
+		{"branch", branch},
+		{"repo_url", bson.D{{"$regex", repositoryURLPattern}, {"$options", "i"}}},
+	}
+	results, err := g.operator.List(ctx, mongodb.GitOpsCollection, query)
+	if err != nil {
+		return nil, err
+	}
+	var configs []GitConfigDB
+	err = results.All(ctx, &configs)
+	if err != nil {
+		return nil, err
+	}
+	return configs, nil
+}
+
 // ReplaceGitConfig updates git config matching the query
 func (g *Operator) ReplaceGitConfig(ctx context.Context, query bson.D, update *GitConfigDB) error {
 	updateResult, err := g.operator.Replace(ctx, mongodb.GitOpsCollection, query, update)
@@ -90,9 +134,9 @@ func (g *Operator) UpdateGitConfig(ctx context.Context, query bson.D, update bso
 	return nil
 }
 
-// DeleteGitConfig removes git config corresponding to the given project id
-func (g *Operator) DeleteGitConfig(ctx context.Context, projectID string) error {
-	query := bson.D{{"project_id", projectID}}
+// DeleteGitConfig removes git config corresponding to the given project id and config id
+func (g *Operator) DeleteGitConfig(ctx context.Context, projectID string, configID string) error {
+	query := GitConfigQuery(projectID, configID)
 	_, err := g.operator.Delete(ctx, mongodb.GitOpsCollection, query)
 
 	if err != nil {
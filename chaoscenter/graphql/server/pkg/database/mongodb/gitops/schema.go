@@ -4,21 +4,54 @@ import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 
 // GitConfigDB ...
 type GitConfigDB struct {
-	ProjectID     string         `bson:"project_id"`
-	RepositoryURL string         `bson:"repo_url"`
-	Branch        string         `bson:"branch"`
-	LatestCommit  string         `bson:"latest_commit"`
-	AuthType      model.AuthType `bson:"auth_type"`
-	UserName      *string        `bson:"username"`
-	Password      *string        `bson:"password"`
-	Token         *string        `bson:"token"`
-	SSHPrivateKey *string        `bson:"ssh_private_key"`
+	ProjectID     string             `bson:"project_id"`
+	ConfigID      string             `bson:"config_id"`
+	Name          string             `bson:"name,omitempty"`
+	Path          string             `bson:"path,omitempty"`
+	EnvironmentID *string            `bson:"environment_id,omitempty"`
+	InfraID       *string            `bson:"infra_id,omitempty"`
+	RepositoryURL string             `bson:"repo_url"`
+	Branch        string             `bson:"branch"`
+	LatestCommit  string             `bson:"latest_commit"`
+	AuthType      model.AuthType     `bson:"auth_type"`
+	UserName      *string            `bson:"username"`
+	Password      *string            `bson:"password"`
+	Token         *string            `bson:"token"`
+	SSHPrivateKey *string            `bson:"ssh_private_key"`
+	WriteMode     model.GitWriteMode `bson:"write_mode,omitempty"`
+	Provider      model.GitProvider  `bson:"provider,omitempty"`
+	ProviderURL   *string            `bson:"provider_url,omitempty"`
+	WebhookSecret *string            `bson:"webhook_secret,omitempty"`
 }
 
 // GetGitConfigDB ...
 func GetGitConfigDB(config model.GitConfig) GitConfigDB {
+	writeMode := model.GitWriteModePush
+	if config.WriteMode != nil {
+		writeMode = *config.WriteMode
+	}
+	var provider model.GitProvider
+	if config.Provider != nil {
+		provider = *config.Provider
+	}
+	var configID, name, path string
+	if config.ConfigID != nil {
+		configID = *config.ConfigID
+	}
+	if config.Name != nil {
+		name = *config.Name
+	}
+	if config.Path != nil {
+		path = *config.Path
+	}
+
 	return GitConfigDB{
 		ProjectID:     config.ProjectID,
+		ConfigID:      configID,
+		Name:          name,
+		Path:          path,
+		EnvironmentID: config.EnvironmentID,
+		InfraID:       config.InfraID,
 		RepositoryURL: config.RepoURL,
 		Branch:        config.Branch,
 		LatestCommit:  "",
@@ -27,5 +60,9 @@ func GetGitConfigDB(config model.GitConfig) GitConfigDB {
 		Password:      config.Password,
 		Token:         config.Token,
 		SSHPrivateKey: config.SSHPrivateKey,
+		WriteMode:     writeMode,
+		Provider:      provider,
+		ProviderURL:   config.ProviderURL,
+		WebhookSecret: config.WebhookSecret,
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 
 	"github.com/sirupsen/logrus"
@@ -25,6 +26,11 @@ const (
 	UserCollection
 	ProjectCollection
 	EnvironmentCollection
+	PubSubCollection
+	AuditEventCollection
+	BlackoutWindowCollection
+	NotificationRuleCollection
+	NotificationDeliveryCollection
 )
 
 // MongoInterface requires a MongoClient that implements the Initialize method to create the Mongo DB client
@@ -36,34 +42,44 @@ type MongoInterface interface {
 
 // MongoClient structure contains all the Database collections and the instance of the Database
 type MongoClient struct {
-	Database                      *mongo.Database
-	ChaosInfraCollection          *mongo.Collection
-	ChaosExperimentCollection     *mongo.Collection
-	ChaosExperimentRunsCollection *mongo.Collection
-	ChaosHubCollection            *mongo.Collection
-	ChaosServerConfigCollection   *mongo.Collection
-	ImageRegistryCollection       *mongo.Collection
-	ServerConfigCollection        *mongo.Collection
-	GitOpsCollection              *mongo.Collection
-	UserCollection                *mongo.Collection
-	ProjectCollection             *mongo.Collection
-	EnvironmentCollection         *mongo.Collection
+	Database                       *mongo.Database
+	ChaosInfraCollection           *mongo.Collection
+	ChaosExperimentCollection      *mongo.Collection
+	ChaosExperimentRunsCollection  *mongo.Collection
+	ChaosHubCollection             *mongo.Collection
+	ChaosServerConfigCollection    *mongo.Collection
+	ImageRegistryCollection        *mongo.Collection
+	ServerConfigCollection         *mongo.Collection
+	GitOpsCollection               *mongo.Collection
+	UserCollection                 *mongo.Collection
+	ProjectCollection              *mongo.Collection
+	EnvironmentCollection          *mongo.Collection
+	PubSubCollection               *mongo.Collection
+	AuditEventCollection           *mongo.Collection
+	BlackoutWindowCollection       *mongo.Collection
+	NotificationRuleCollection     *mongo.Collection
+	NotificationDeliveryCollection *mongo.Collection
 }
 
 var (
 	Client      MongoInterface = &MongoClient{}
 	MgoClient   *mongo.Client
 	Collections = map[int]string{
-		ChaosInfraCollection:          "chaosInfrastructures",
-		ChaosExperimentCollection:     "chaosExperiments",
-		ChaosExperimentRunsCollection: "chaosExperimentRuns",
-		ChaosHubCollection:            "chaosHubs",
-		ImageRegistryCollection:       "image-registry",
-		ServerConfigCollection:        "server-config",
-		GitOpsCollection:              "gitops",
-		UserCollection:                "user",
-		ProjectCollection:             "project",
-		EnvironmentCollection:         "environment",
+		ChaosInfraCollection:           "chaosInfrastructures",
+		ChaosExperimentCollection:      "chaosExperiments",
+		ChaosExperimentRunsCollection:  "chaosExperimentRuns",
+		ChaosHubCollection:             "chaosHubs",
+		ImageRegistryCollection:        "image-registry",
+		ServerConfigCollection:         "server-config",
+		GitOpsCollection:               "gitops",
+		UserCollection:                 "user",
+		ProjectCollection:              "project",
+		EnvironmentCollection:          "environment",
+		PubSubCollection:               "pubsubMessages",
+		AuditEventCollection:           "auditEvents",
+		BlackoutWindowCollection:       "blackoutWindows",
+		NotificationRuleCollection:     "notificationRules",
+		NotificationDeliveryCollection: "notificationDeliveries",
 	}
 
 	DbName            = "litmus"
@@ -71,6 +87,9 @@ var (
 	backgroundContext = context.Background()
 )
 
+// PubSubMessageTTL is the number of seconds after which messages exchanged between server replicas expire
+const PubSubMessageTTL = 300
+
 func MongoConnection() (*mongo.Client, error) {
 	var (
 		dbServer   = utils.Config.DbServer
@@ -87,7 +106,7 @@ func MongoConnection() (*mongo.Client, error) {
 		Password: dbPassword,
 	}
 
-	clientOptions := options.Client().ApplyURI(dbServer).SetAuth(credential)
+	clientOptions := options.Client().ApplyURI(dbServer).SetAuth(credential).SetMonitor(tracing.NewMongoMonitor())
 
 	client, err := mongo.Connect(backgroundContext, clientOptions)
 	if err != nil {
@@ -212,10 +231,16 @@ func (m *MongoClient) initAllCollection() {
 	}
 
 	m.GitOpsCollection = m.Database.Collection(Collections[GitOpsCollection])
+	// git configs used to be unique per project, the index is dropped as a project can now have multiple configs
+	_, err = m.GitOpsCollection.Indexes().DropOne(backgroundContext, "project_id_1")
+	if err != nil {
+		logrus.WithError(err).Debug("no project index to drop for GitOps Collection")
+	}
 	_, err = m.GitOpsCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
 		{
-			Keys: bson.M{
-				"project_id": 1,
+			Keys: bson.D{
+				{"project_id", 1},
+				{"config_id", 1},
 			},
 			Options: options.Index().SetUnique(true),
 		},
@@ -253,4 +278,106 @@ func (m *MongoClient) initAllCollection() {
 	if err != nil {
 		logrus.WithError(err).Fatal("failed to create indexes for environments collection")
 	}
+
+	// Initialize pubsub collection, messages are only relevant to replicas listening at the time of publishing
+	m.PubSubCollection = m.Database.Collection(Collections[PubSubCollection])
+	_, err = m.PubSubCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"created_at": 1,
+			},
+			Options: options.Index().SetExpireAfterSeconds(PubSubMessageTTL),
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to create indexes for pubsubMessages collection")
+	}
+
+	// Initialize audit event collection
+	m.AuditEventCollection = m.Database.Collection(Collections[AuditEventCollection])
+	_, err = m.AuditEventCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"timestamp", -1},
+			},
+		},
+		{
+			Keys: bson.M{
+				"resource_id": 1,
+			},
+		},
+		{
+			Keys: bson.M{
+				"actor.username": 1,
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to create indexes for auditEvents collection")
+	}
+
+	// Initialize blackout window collection
+	m.BlackoutWindowCollection = m.Database.Collection(Collections[BlackoutWindowCollection])
+	_, err = m.BlackoutWindowCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"window_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.M{
+				"project_id": 1,
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to create indexes for blackoutWindows collection")
+	}
+
+	// Initialize notification rule collection
+	m.NotificationRuleCollection = m.Database.Collection(Collections[NotificationRuleCollection])
+	_, err = m.NotificationRuleCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"rule_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.M{
+				"project_id": 1,
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to create indexes for notificationRules collection")
+	}
+
+	// Initialize notification delivery collection
+	m.NotificationDeliveryCollection = m.Database.Collection(Collections[NotificationDeliveryCollection])
+	_, err = m.NotificationDeliveryCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"delivery_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"created_at", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"status", 1},
+				{"next_attempt_at", 1},
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Fatal("failed to create indexes for notificationDeliveries collection")
+	}
 }
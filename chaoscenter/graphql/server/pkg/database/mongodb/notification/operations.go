@@ -0,0 +1,126 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is used to perform operations on the database
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewNotificationOperator returns a new instance of Operator
+func NewNotificationOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertRule inserts a new notification rule in the database
+func (n *Operator) InsertRule(ctx context.Context, rule Rule) error {
+	return n.operator.Create(ctx, mongodb.NotificationRuleCollection, rule)
+}
+
+// UpdateRule updates the notification rule matching the query
+func (n *Operator) UpdateRule(ctx context.Context, query bson.D, update bson.D) error {
+	_, err := n.operator.Update(ctx, mongodb.NotificationRuleCollection, query, update)
+	return err
+}
+
+// GetRule returns the notification rule matching the query
+func (n *Operator) GetRule(ctx context.Context, query bson.D) (Rule, error) {
+	result, err := n.operator.Get(ctx, mongodb.NotificationRuleCollection, query)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	var rule Rule
+	err = result.Decode(&rule)
+	if err != nil {
+		return Rule{}, err
+	}
+
+	return rule, nil
+}
+
+// ListRules returns the notification rules matching the query
+func (n *Operator) ListRules(ctx context.Context, query bson.D) ([]Rule, error) {
+	results, err := n.operator.List(ctx, mongodb.NotificationRuleCollection, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var rules []Rule
+	err = results.All(ctx, &rules)
+	if err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// InsertDeliveries inserts new notification deliveries in the database
+func (n *Operator) InsertDeliveries(ctx context.Context, deliveries []Delivery) error {
+	documents := make([]interface{}, len(deliveries))
+	for i, delivery := range deliveries {
+		documents[i] = delivery
+	}
+	return n.operator.CreateMany(ctx, mongodb.NotificationDeliveryCollection, documents)
+}
+
+// UpdateDelivery updates the notification delivery matching the query and reports whether it was modified
+func (n *Operator) UpdateDelivery(ctx context.Context, query bson.D, update bson.D) (bool, error) {
+	result, err := n.operator.Update(ctx, mongodb.NotificationDeliveryCollection, query, update)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
+
+// GetDelivery returns the notification delivery matching the query
+func (n *Operator) GetDelivery(ctx context.Context, query bson.D) (Delivery, error) {
+	result, err := n.operator.Get(ctx, mongodb.NotificationDeliveryCollection, query)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	var delivery Delivery
+	err = result.Decode(&delivery)
+	if err != nil {
+		return Delivery{}, err
+	}
+
+	return delivery, nil
+}
+
+// ListDeliveries returns the notification deliveries matching the query, latest first
+func (n *Operator) ListDeliveries(ctx context.Context, query bson.D, skip int64, limit int64) ([]Delivery, error) {
+	opts := options.Find().SetSort(bson.D{{"created_at", -1}})
+	if skip > 0 {
+		opts.SetSkip(skip)
+	}
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	results, err := n.operator.List(ctx, mongodb.NotificationDeliveryCollection, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var deliveries []Delivery
+	err = results.All(ctx, &deliveries)
+	if err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// CountDeliveries returns the number of notification deliveries matching the query
+func (n *Operator) CountDeliveries(ctx context.Context, query bson.D) (int64, error) {
+	return n.operator.CountDocuments(ctx, mongodb.NotificationDeliveryCollection, query)
+}
@@ -0,0 +1,50 @@
+package notification
+
+import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+
+// Delivery statuses of the notifications
+const (
+	DeliveryPending   = "PENDING"
+	DeliveryDelivered = "DELIVERED"
+	DeliveryFailed    = "FAILED"
+)
+
+// Rule is a project scoped rule sending the matching run lifecycle events to a channel
+type Rule struct {
+	mongodb.Audit  `bson:",inline"`
+	RuleID         string   `bson:"rule_id"`
+	ProjectID      string   `bson:"project_id"`
+	Name           string   `bson:"name"`
+	Enabled        bool     `bson:"enabled"`
+	EventTypes     []string `bson:"event_types"`
+	ExperimentIDs  []string `bson:"experiment_ids,omitempty"`
+	EnvironmentIDs []string `bson:"environment_ids,omitempty"`
+	Tags           []string `bson:"tags,omitempty"`
+	ScoreThreshold *float64 `bson:"score_threshold,omitempty"`
+	Channel        Channel  `bson:"channel"`
+}
+
+// Channel is the destination of the notifications of a rule
+type Channel struct {
+	Type       string   `bson:"type"`
+	URL        string   `bson:"url,omitempty"`
+	Secret     string   `bson:"secret,omitempty"`
+	Recipients []string `bson:"recipients,omitempty"`
+}
+
+// Delivery is the log entry of a notification sent, or to be sent, to the channel of a rule
+type Delivery struct {
+	DeliveryID    string `bson:"delivery_id"`
+	ProjectID     string `bson:"project_id"`
+	RuleID        string `bson:"rule_id"`
+	EventID       string `bson:"event_id"`
+	EventType     string `bson:"event_type"`
+	ChannelType   string `bson:"channel_type"`
+	Payload       string `bson:"payload"`
+	Status        string `bson:"status"`
+	Attempts      int    `bson:"attempts"`
+	LastError     string `bson:"last_error,omitempty"`
+	NextAttemptAt int64  `bson:"next_attempt_at"`
+	CreatedAt     int64  `bson:"created_at"`
+	UpdatedAt     int64  `bson:"updated_at"`
+}
@@ -0,0 +1,46 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for pubsub collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewPubSubOperator returns a new instance of Operator
+func NewPubSubOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertMessage inserts a new message in the pubsub collection
+func (p *Operator) InsertMessage(ctx context.Context, message Message) error {
+	return p.operator.Create(ctx, mongodb.PubSubCollection, message)
+}
+
+// WatchMessages opens a change stream on the messages inserted in the pubsub collection,
+// resuming after the given token if it is not nil
+func (p *Operator) WatchMessages(ctx context.Context, resumeToken bson.Raw) (*mongo.ChangeStream, error) {
+	collection, err := p.operator.GetCollection(mongodb.PubSubCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		bson.D{{"$match", bson.D{{"operationType", "insert"}}}},
+	}
+	opts := options.ChangeStream()
+	if resumeToken != nil {
+		opts.SetResumeAfter(resumeToken)
+	}
+
+	return collection.Watch(ctx, pipeline, opts)
+}
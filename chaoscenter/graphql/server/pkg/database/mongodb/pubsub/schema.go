@@ -0,0 +1,18 @@
+package pubsub
+
+import "time"
+
+// Message contains the required fields to be stored in the database for a message exchanged between server replicas
+type Message struct {
+	ReplicaID string    `bson:"replica_id"`
+	Topic     string    `bson:"topic"`
+	Key       string    `bson:"key"`
+	Payload   string    `bson:"payload"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MessageEvent is the change stream event received when a message is published
+type MessageEvent struct {
+	OperationType string  `bson:"operationType"`
+	FullDocument  Message `bson:"fullDocument"`
+}
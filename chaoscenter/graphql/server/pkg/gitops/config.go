@@ -0,0 +1,102 @@
+package gitops
+
+import (
+	"errors"
+	"path"
+	"strings"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
+)
+
+// SelectGitConfig returns the git config syncing the experiments of the infra, a config bound to the infra takes
+// precedence over a config bound to the environment of the infra and over the config bound to neither of them
+func SelectGitConfig(configs []gitops.GitConfigDB, infraID string, environmentID string) *gitops.GitConfigDB {
+	var environmentConfig, defaultConfig *gitops.GitConfigDB
+	for i := range configs {
+		config := &configs[i]
+		switch {
+		case bindingID(config.InfraID) != "":
+			if *config.InfraID == infraID {
+				return config
+			}
+		case bindingID(config.EnvironmentID) != "":
+			if environmentID != "" && *config.EnvironmentID == environmentID {
+				environmentConfig = config
+			}
+		default:
+			defaultConfig = config
+		}
+	}
+
+	if environmentConfig != nil {
+		return environmentConfig
+	}
+	return defaultConfig
+}
+
+// SyncedByGitConfig returns true if the experiments of the infra are synced by the git config with the given id
+func SyncedByGitConfig(configs []gitops.GitConfigDB, configID string, infraID string, environmentID string) bool {
+	config := SelectGitConfig(configs, infraID, environmentID)
+
+	return config != nil && config.ConfigID == configID
+}
+
+// DefaultGitConfig returns the git config bound to neither an environment nor an infra, the first config of the project
+// otherwise
+func DefaultGitConfig(configs []gitops.GitConfigDB) *gitops.GitConfigDB {
+	if len(configs) == 0 {
+		return nil
+	}
+
+	for i := range configs {
+		if bindingID(configs[i].InfraID) == "" && bindingID(configs[i].EnvironmentID) == "" {
+			return &configs[i]
+		}
+	}
+	return &configs[0]
+}
+
+// ValidateGitConfigScope cleans the path of the git config and checks that it doesn't share its binding or experiments
+// directory with the other configs of the project
+func ValidateGitConfigScope(config *gitops.GitConfigDB, configs []gitops.GitConfigDB) error {
+	if config.Path != "" {
+		cleanPath := path.Clean(strings.TrimSuffix(config.Path, "/"))
+		if path.IsAbs(cleanPath) || cleanPath == "." || cleanPath == ".." || strings.HasPrefix(cleanPath, "../") ||
+			cleanPath == ".git" || strings.HasPrefix(cleanPath, ".git/") {
+			return errors.New("path must be a directory inside the repository: " + config.Path)
+		}
+		config.Path = cleanPath
+	}
+	if bindingID(config.InfraID) != "" && bindingID(config.EnvironmentID) != "" {
+		return errors.New("git config can be bound to either an environment or an infra")
+	}
+
+	experimentsPath := GetGitOpsConfig(*config).ExperimentsPath()
+	for _, existing := range configs {
+		if existing.ConfigID == config.ConfigID {
+			continue
+		}
+		if bindingID(existing.InfraID) == bindingID(config.InfraID) && bindingID(existing.EnvironmentID) == bindingID(config.EnvironmentID) {
+			return errors.New("a git config of the project is already bound to the same environment or infra")
+		}
+		if existing.RepositoryURL == config.RepositoryURL && existing.Branch == config.Branch &&
+			overlappingPaths(GetGitOpsConfig(existing).ExperimentsPath(), experimentsPath) {
+			return errors.New("a git config of the project already syncs the experiments of " + experimentsPath + " in the same branch")
+		}
+	}
+
+	return nil
+}
+
+func bindingID(id *string) string {
+	if id == nil {
+		return ""
+	}
+
+	return *id
+}
+
+// overlappingPaths returns true if one of the directories contains the other one
+func overlappingPaths(a string, b string) bool {
+	return strings.HasPrefix(a+"/", b+"/") || strings.HasPrefix(b+"/", a+"/")
+}
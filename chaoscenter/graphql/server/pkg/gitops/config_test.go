@@ -0,0 +1,204 @@
+package gitops_test
+
+import (
+	"testing"
+
+	dbGitOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestGitConfig(configID string, path string, environmentID string, infraID string) dbGitOps.GitConfigDB {
+	config := dbGitOps.GitConfigDB{
+		ProjectID:     testProjectID,
+		ConfigID:      configID,
+		Path:          path,
+		RepositoryURL: "https://github.com/litmuschaos/chaos-charts.git",
+		Branch:        testBranch,
+	}
+	if environmentID != "" {
+		config.EnvironmentID = &environmentID
+	}
+	if infraID != "" {
+		config.InfraID = &infraID
+	}
+	return config
+}
+
+// TestSelectGitConfig is used to test the selection of the git config syncing the experiments of an infra
+func TestSelectGitConfig(t *testing.T) {
+	configs := []dbGitOps.GitConfigDB{
+		newTestGitConfig("default", "chaos", "", ""),
+		newTestGitConfig("staging", "staging", "staging-env", ""),
+		newTestGitConfig("infra", "infra", "", "infra-1"),
+	}
+	tests := []struct {
+		name          string
+		configs       []dbGitOps.GitConfigDB
+		infraID       string
+		environmentID string
+		expected      string
+	}{
+		{
+			name:          "infra binding takes precedence",
+			configs:       configs,
+			infraID:       "infra-1",
+			environmentID: "staging-env",
+			expected:      "infra",
+		},
+		{
+			name:          "environment binding",
+			configs:       configs,
+			infraID:       "infra-2",
+			environmentID: "staging-env",
+			expected:      "staging",
+		},
+		{
+			name:          "default config",
+			configs:       configs,
+			infraID:       "infra-2",
+			environmentID: "production-env",
+			expected:      "default",
+		},
+		{
+			name:          "no config syncs the infra",
+			configs:       configs[1:],
+			infraID:       "infra-2",
+			environmentID: "production-env",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			config := gitops.SelectGitConfig(tc.configs, tc.infraID, tc.environmentID)
+
+			// then
+			if tc.expected == "" {
+				assert.Nil(t, config)
+				return
+			}
+			assert.NotNil(t, config)
+			assert.Equal(t, tc.expected, config.ConfigID)
+		})
+	}
+}
+
+// TestSyncedByGitConfig is used to test that the experiments of an infra are only synced, and deleted, by the git config
+// selected for the infra
+func TestSyncedByGitConfig(t *testing.T) {
+	// given
+	configs := []dbGitOps.GitConfigDB{
+		newTestGitConfig("default", "chaos", "", ""),
+		newTestGitConfig("infra", "infra", "", "infra-1"),
+	}
+
+	// then
+	assert.True(t, gitops.SyncedByGitConfig(configs, "infra", "infra-1", ""))
+	assert.False(t, gitops.SyncedByGitConfig(configs, "default", "infra-1", ""))
+	assert.True(t, gitops.SyncedByGitConfig(configs, "default", "infra-2", ""))
+	assert.False(t, gitops.SyncedByGitConfig(configs[1:], "default", "infra-2", ""))
+}
+
+// TestDefaultGitConfig is used to test the git config returned when no config id is provided
+func TestDefaultGitConfig(t *testing.T) {
+	t.Run("config bound to neither an environment nor an infra", func(t *testing.T) {
+		// given
+		configs := []dbGitOps.GitConfigDB{
+			newTestGitConfig("staging", "staging", "staging-env", ""),
+			newTestGitConfig("default", "chaos", "", ""),
+		}
+
+		// when
+		config := gitops.DefaultGitConfig(configs)
+
+		// then
+		assert.Equal(t, "default", config.ConfigID)
+	})
+	t.Run("first config when every config is bound", func(t *testing.T) {
+		// given
+		configs := []dbGitOps.GitConfigDB{
+			newTestGitConfig("staging", "staging", "staging-env", ""),
+			newTestGitConfig("infra", "infra", "", "infra-1"),
+		}
+
+		// when
+		config := gitops.DefaultGitConfig(configs)
+
+		// then
+		assert.Equal(t, "staging", config.ConfigID)
+	})
+	t.Run("no config", func(t *testing.T) {
+		// when
+		config := gitops.DefaultGitConfig(nil)
+
+		// then
+		assert.Nil(t, config)
+	})
+}
+
+// TestValidateGitConfigScope is used to test the validation of the path and binding of a git config
+func TestValidateGitConfigScope(t *testing.T) {
+	existing := []dbGitOps.GitConfigDB{
+		newTestGitConfig("default", "chaos", "", ""),
+		newTestGitConfig("staging", "envs/staging", "staging-env", ""),
+	}
+	tests := []struct {
+		name          string
+		config        dbGitOps.GitConfigDB
+		expectedPath  string
+		expectedError bool
+	}{
+		{
+			name:         "distinct binding and path",
+			config:       newTestGitConfig("production", "envs/production/", "production-env", ""),
+			expectedPath: "envs/production",
+		},
+		{
+			name:         "update of an existing config",
+			config:       newTestGitConfig("staging", "./envs/staging", "staging-env", ""),
+			expectedPath: "envs/staging",
+		},
+		{
+			name:          "binding already used",
+			config:        newTestGitConfig("other", "other", "", ""),
+			expectedError: true,
+		},
+		{
+			name:          "path inside the path of another config",
+			config:        newTestGitConfig("infra", "chaos/infra", "", "infra-1"),
+			expectedError: true,
+		},
+		{
+			name:          "path outside the repository",
+			config:        newTestGitConfig("infra", "../chaos", "", "infra-1"),
+			expectedError: true,
+		},
+		{
+			name:          "repository root",
+			config:        newTestGitConfig("infra", ".", "", "infra-1"),
+			expectedError: true,
+		},
+		{
+			name:          "bound to an environment and an infra",
+			config:        newTestGitConfig("infra", "infra", "production-env", "infra-1"),
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			config := tc.config
+
+			// when
+			err := gitops.ValidateGitConfigScope(&config, existing)
+
+			// then
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedPath, config.Path)
+		})
+	}
+}
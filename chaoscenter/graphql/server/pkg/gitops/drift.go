@@ -0,0 +1,156 @@
+package gitops
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// maxDriftDiffLength caps the length of the diff returned in the drift report
+const maxDriftDiffLength = 4096
+
+// ErrDriftConflict is returned when an experiment has been changed in both ChaosCenter and git since the last sync
+var ErrDriftConflict = errors.New("experiment has been changed in both ChaosCenter and git since the last sync, resolve the drift of the experiment to continue")
+
+// normalizeManifest parses a yaml or json manifest, so that the files of the repository and the manifests of the DB
+// can be compared
+func normalizeManifest(manifest []byte) (map[string]interface{}, error) {
+	data, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var normalized map[string]interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// ManifestChecksum returns the sha256 checksum of the manifest normalized to json with sorted keys
+func ManifestChecksum(manifest []byte) (string, error) {
+	normalized, err := normalizeManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ManifestChecksums contains the checksums of the manifest of an experiment in the DB and in the repository, an empty
+// git checksum stands for an experiment missing in the repository
+type ManifestChecksums struct {
+	DB  string
+	Git string
+}
+
+// GetDriftStatus compares the current checksums of an experiment with the checksums of its last sync, the manifests may
+// differ while in sync as the server adds its own labels to the manifest of the DB, an experiment which has never been
+// synced has an empty base and is in conflict unless both manifests are the same
+func GetDriftStatus(base ManifestChecksums, current ManifestChecksums) model.GitOpsDriftStatus {
+	if current.Git == "" {
+		return model.GitOpsDriftStatusMissingInGit
+	}
+	if current.DB == current.Git {
+		return model.GitOpsDriftStatusInSync
+	}
+
+	dbChanged := current.DB != base.DB
+	gitChanged := current.Git != base.Git
+	switch {
+	case dbChanged && gitChanged:
+		return model.GitOpsDriftStatusConflict
+	case dbChanged:
+		return model.GitOpsDriftStatusDbChanged
+	case gitChanged:
+		return model.GitOpsDriftStatusGitChanged
+	default:
+		return model.GitOpsDriftStatusInSync
+	}
+}
+
+// ManifestDiff lists the fields which differ between the manifest of the DB and the repository as path: db -> git,
+// the diff is truncated beyond maxDriftDiffLength. The format is the one of the resource diffs sent by the
+// event-tracker (utils.ResourceDiff), which is a separate module, keep both in sync
+func ManifestDiff(dbManifest []byte, gitManifest []byte) (string, error) {
+	dbData, err := normalizeManifest(dbManifest)
+	if err != nil {
+		return "", err
+	}
+	gitData, err := normalizeManifest(gitManifest)
+	if err != nil {
+		return "", err
+	}
+
+	changes := appendManifestChanges(nil, "", dbData, gitData)
+	sort.Strings(changes)
+
+	diff := strings.Join(changes, "\n")
+	if len(diff) > maxDriftDiffLength {
+		end := strings.LastIndex(diff[:maxDriftDiffLength], "\n")
+		if end < 0 {
+			end = maxDriftDiffLength
+		}
+		diff = diff[:end] + "\n..."
+	}
+
+	return diff, nil
+}
+
+func appendManifestChanges(changes []string, path string, dbValue interface{}, gitValue interface{}) []string {
+	if reflect.DeepEqual(dbValue, gitValue) {
+		return changes
+	}
+
+	dbMap, dbIsMap := dbValue.(map[string]interface{})
+	gitMap, gitIsMap := gitValue.(map[string]interface{})
+	if dbIsMap && gitIsMap {
+		for key := range dbMap {
+			changes = appendManifestChanges(changes, joinManifestPath(path, key), dbMap[key], gitMap[key])
+		}
+		for key := range gitMap {
+			if _, ok := dbMap[key]; !ok {
+				changes = appendManifestChanges(changes, joinManifestPath(path, key), nil, gitMap[key])
+			}
+		}
+		return changes
+	}
+
+	return append(changes, fmt.Sprintf("%s: %s -> %s", path, manifestValue(dbValue), manifestValue(gitValue)))
+}
+
+func joinManifestPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+func manifestValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(data)
+}
@@ -0,0 +1,137 @@
+package gitops_test
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestManifestChecksum is used to test the checksum of the manifests of the DB and the repository
+func TestManifestChecksum(t *testing.T) {
+	t.Run("json and yaml manifests have the same checksum", func(t *testing.T) {
+		// given
+		jsonManifest := []byte(`{"kind":"Workflow","metadata":{"name":"experiment","labels":{"infra_id":"infra"}}}`)
+		yamlManifest := []byte("metadata:\n  labels:\n    infra_id: infra\n  name: experiment\nkind: Workflow\n")
+
+		// when
+		jsonChecksum, jsonErr := gitops.ManifestChecksum(jsonManifest)
+		yamlChecksum, yamlErr := gitops.ManifestChecksum(yamlManifest)
+
+		// then
+		assert.NoError(t, jsonErr)
+		assert.NoError(t, yamlErr)
+		assert.Equal(t, jsonChecksum, yamlChecksum)
+	})
+	t.Run("changed manifest has a different checksum", func(t *testing.T) {
+		// given
+		manifest := []byte(`{"kind":"Workflow","spec":{"entrypoint":"a"}}`)
+		changedManifest := []byte(`{"kind":"Workflow","spec":{"entrypoint":"b"}}`)
+
+		// when
+		checksum, err := gitops.ManifestChecksum(manifest)
+		changedChecksum, changedErr := gitops.ManifestChecksum(changedManifest)
+
+		// then
+		assert.NoError(t, err)
+		assert.NoError(t, changedErr)
+		assert.NotEqual(t, checksum, changedChecksum)
+	})
+	t.Run("invalid manifest", func(t *testing.T) {
+		// when
+		_, err := gitops.ManifestChecksum([]byte("kind: [Workflow"))
+
+		// then
+		assert.Error(t, err)
+	})
+}
+
+// TestGetDriftStatus is used to test the drift status of an experiment from the checksums of its last sync
+func TestGetDriftStatus(t *testing.T) {
+	base := gitops.ManifestChecksums{DB: "db", Git: "git"}
+	tests := []struct {
+		name     string
+		base     gitops.ManifestChecksums
+		current  gitops.ManifestChecksums
+		expected model.GitOpsDriftStatus
+	}{
+		{
+			name:     "unchanged since the last sync",
+			base:     base,
+			current:  base,
+			expected: model.GitOpsDriftStatusInSync,
+		},
+		{
+			name:     "same manifest on both sides",
+			base:     base,
+			current:  gitops.ManifestChecksums{DB: "new", Git: "new"},
+			expected: model.GitOpsDriftStatusInSync,
+		},
+		{
+			name:     "changed in the DB",
+			base:     base,
+			current:  gitops.ManifestChecksums{DB: "new", Git: "git"},
+			expected: model.GitOpsDriftStatusDbChanged,
+		},
+		{
+			name:     "changed in git",
+			base:     base,
+			current:  gitops.ManifestChecksums{DB: "db", Git: "new"},
+			expected: model.GitOpsDriftStatusGitChanged,
+		},
+		{
+			name:     "changed on both sides",
+			base:     base,
+			current:  gitops.ManifestChecksums{DB: "new-db", Git: "new-git"},
+			expected: model.GitOpsDriftStatusConflict,
+		},
+		{
+			name:     "never synced",
+			current:  gitops.ManifestChecksums{DB: "db", Git: "git"},
+			expected: model.GitOpsDriftStatusConflict,
+		},
+		{
+			name:     "missing in git",
+			base:     base,
+			current:  gitops.ManifestChecksums{DB: "db"},
+			expected: model.GitOpsDriftStatusMissingInGit,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			status := gitops.GetDriftStatus(tc.base, tc.current)
+
+			// then
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
+
+// TestManifestDiff is used to test the diff between the manifests of the DB and the repository
+func TestManifestDiff(t *testing.T) {
+	t.Run("changed, added and removed fields", func(t *testing.T) {
+		// given
+		dbManifest := []byte(`{"kind":"Workflow","metadata":{"name":"experiment"},"spec":{"entrypoint":"a","suspend":true}}`)
+		gitManifest := []byte("kind: Workflow\nmetadata:\n  name: experiment\nspec:\n  entrypoint: b\n  parallelism: 1\n")
+
+		// when
+		diff, err := gitops.ManifestDiff(dbManifest, gitManifest)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "spec.entrypoint: \"a\" -> \"b\"\nspec.parallelism: <none> -> 1\nspec.suspend: true -> <none>", diff)
+	})
+	t.Run("same manifests", func(t *testing.T) {
+		// given
+		manifest := []byte(`{"kind":"Workflow","metadata":{"name":"experiment"}}`)
+
+		// when
+		diff, err := gitops.ManifestDiff(manifest, manifest)
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
 
 	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport"
@@ -30,6 +31,10 @@ import (
 // GitConfig structure for the GitOps settings
 type GitConfig struct {
 	ProjectID     string
+	ConfigID      string
+	Path          string
+	EnvironmentID *string
+	InfraID       *string
 	RepositoryURL string
 	LocalPath     string
 	RemoteName    string
@@ -40,6 +45,9 @@ type GitConfig struct {
 	AuthType      model.AuthType
 	Token         *string
 	SSHPrivateKey *string
+	WriteMode     model.GitWriteMode
+	Provider      model.GitProvider
+	ProviderURL   *string
 }
 
 type GitUser struct {
@@ -80,24 +88,55 @@ func GitUserFromContext(ctx context.Context) GitUser {
 func GetGitOpsConfig(repoData gitops.GitConfigDB) GitConfig {
 	gitConfig := GitConfig{
 		ProjectID:     repoData.ProjectID,
+		ConfigID:      repoData.ConfigID,
+		Path:          repoData.Path,
+		EnvironmentID: repoData.EnvironmentID,
+		InfraID:       repoData.InfraID,
 		RepositoryURL: repoData.RepositoryURL,
 		RemoteName:    "origin",
 		Branch:        repoData.Branch,
-		LocalPath:     DefaultPath + repoData.ProjectID,
+		LocalPath:     localPath(repoData.ProjectID, repoData.ConfigID),
 		LatestCommit:  repoData.LatestCommit,
 		UserName:      repoData.UserName,
 		Password:      repoData.Password,
 		AuthType:      model.AuthType(repoData.AuthType),
 		Token:         repoData.Token,
 		SSHPrivateKey: repoData.SSHPrivateKey,
+		WriteMode:     repoData.WriteMode,
+		Provider:      repoData.Provider,
+		ProviderURL:   repoData.ProviderURL,
 	}
 
 	return gitConfig
 }
 
+// localPath returns the path of the local repository of the git config, the configs without config id keep the
+// path of the project
+func localPath(projectID string, configID string) string {
+	if configID == "" {
+		return DefaultPath + projectID
+	}
+
+	return DefaultPath + projectID + "-" + configID
+}
+
+// ExperimentsPath returns the directory of the repository holding the experiments of the git config
+func (c GitConfig) ExperimentsPath() string {
+	if c.Path == "" {
+		return ProjectDataPath + "/" + c.ProjectID
+	}
+
+	return c.Path
+}
+
+// PullRequestMode returns true if the changes are written to git through pull requests instead of direct pushes
+func (c GitConfig) PullRequestMode() bool {
+	return c.WriteMode == model.GitWriteModePullRequest
+}
+
 // setupGitRepo helps clones and sets up the repo for gitops
 func (c GitConfig) setupGitRepo(user GitUser) error {
-	projectPath := c.LocalPath + "/" + ProjectDataPath + "/" + c.ProjectID
+	projectPath := c.LocalPath + "/" + c.ExperimentsPath()
 
 	// clone repo
 	_, err := c.GitClone()
@@ -111,6 +150,12 @@ func (c GitConfig) setupGitRepo(user GitUser) error {
 		return err
 	}
 
+	// the configured branch can't be pushed to in the pull request mode, the project dir is added to the branch
+	// by the first merged pull request
+	if c.PullRequestMode() {
+		return os.MkdirAll(projectPath, 0755)
+	}
+
 	gitInfo := map[string]string{"projectID": c.ProjectID, "revision": "1"}
 	if exists {
 		data, err := ioutil.ReadFile(projectPath + "/.info")
@@ -150,6 +195,20 @@ func (c GitConfig) setupGitRepo(user GitUser) error {
 	return c.GitPush()
 }
 
+// refreshGitRepo clones the repo if it isn't present locally or pulls its latest changes, without committing or pushing
+func (c GitConfig) refreshGitRepo() error {
+	exists, err := PathExists(c.LocalPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		_, err = c.GitClone()
+		return err
+	}
+
+	return c.GitPull()
+}
+
 // GitClone clones the repo
 func (c GitConfig) GitClone() (*git.Repository, error) {
 	// clean the local path
@@ -331,6 +390,79 @@ func (c GitConfig) GitPush() error {
 	return err
 }
 
+// GitPushBranch commits the changes on a new branch created from the current HEAD and pushes the branch to the
+// remote set in GitConfig, the configured branch is checked out again and the commit hash of the new branch is returned
+func (c GitConfig) GitPushBranch(user GitUser, branch string, message string, deleteFile *string) (string, error) {
+	if c.AuthType == model.AuthTypeNone && c.Provider != model.GitProviderLocal {
+		return "", errors.New("cannot write/push without credentials, auth type = none")
+	}
+
+	r, w, err := c.getRepositoryWorktreeReference()
+	if err != nil {
+		return "", err
+	}
+	auth, err := c.getAuthMethod()
+	if err != nil {
+		return "", err
+	}
+	head, err := r.Head()
+	if err != nil {
+		return "", err
+	}
+
+	branchRef := plumbing.NewBranchReferenceName(branch)
+	err = w.Checkout(&git.CheckoutOptions{
+		Hash:   head.Hash(),
+		Branch: branchRef,
+		Create: true,
+		Keep:   true,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := c.GitCommit(user, message, deleteFile)
+	if err == nil {
+		err = r.Push(&git.PushOptions{
+			RemoteName: c.RemoteName,
+			Auth:       auth,
+			RefSpecs:   []gitconfig.RefSpec{gitconfig.RefSpec(branchRef + ":" + branchRef)},
+		})
+	}
+
+	// the local branch is dropped once pushed, the changes only reach the configured branch through the pull request
+	if checkoutErr := w.Checkout(&git.CheckoutOptions{Branch: plumbing.NewBranchReferenceName(c.Branch), Force: true}); checkoutErr != nil && err == nil {
+		err = checkoutErr
+	}
+	if removeErr := r.Storer.RemoveReference(branchRef); removeErr != nil && err == nil {
+		err = removeErr
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return hash, nil
+}
+
+// GitPullRequest pushes the changes to a new branch and opens a pull request against the branch set in GitConfig
+func (c GitConfig) GitPullRequest(ctx context.Context, user GitUser, branch string, message string, deleteFile *string) (*PullRequest, error) {
+	provider, err := NewProvider(c)
+	if err != nil {
+		return nil, err
+	}
+
+	head, err := c.GitPushBranch(user, branch, message, deleteFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.OpenPullRequest(ctx, PullRequest{
+		Branch: branch,
+		Base:   c.Branch,
+		Head:   head,
+	}, message, "Opened by Litmus ChaosCenter GitOps for project "+c.ProjectID)
+}
+
 // GitCommit saves the changes in the repo and commits them with the message provided
 func (c GitConfig) GitCommit(user GitUser, message string, deleteFile *string) (string, error) {
 	_, w, err := c.getRepositoryWorktreeReference()
@@ -362,9 +494,9 @@ func (c GitConfig) GitCommit(user GitUser, message string, deleteFile *string) (
 	return hash.String(), nil
 }
 
-// GetChanges returns the LatestCommit and list of files changed(since previous LatestCommit) in the project directory mentioned in GitConfig
+// GetChanges returns the LatestCommit and list of files changed(since previous LatestCommit) in the experiments directory mentioned in GitConfig
 func (c GitConfig) GetChanges() (string, map[string]int, error) {
-	path := ProjectDataPath + "/" + c.ProjectID + "/"
+	path := c.ExperimentsPath() + "/"
 
 	r, _, err := c.getRepositoryWorktreeReference()
 	if err != nil {
@@ -420,9 +552,9 @@ func (c GitConfig) GetChanges() (string, map[string]int, error) {
 	return c.LatestCommit, visited, nil
 }
 
-// GetLatestCommitHash returns the latest commit hash in the local repo for the project directory
+// GetLatestCommitHash returns the latest commit hash in the local repo for the experiments directory
 func (c GitConfig) GetLatestCommitHash() (string, error) {
-	path := ProjectDataPath + "/" + c.ProjectID + "/"
+	path := c.ExperimentsPath() + "/"
 	r, _, err := c.getRepositoryWorktreeReference()
 	if err != nil {
 		return "", err
@@ -438,7 +570,10 @@ func (c GitConfig) GetLatestCommitHash() (string, error) {
 		return "", errors.New("failed to get latest commit hash :" + err.Error())
 	}
 	commit, err := commitIter.Next()
-
+	// the project dir isn't in the branch yet until the first pull request is merged in the pull request mode
+	if err == io.EOF && c.PullRequestMode() {
+		return "", nil
+	}
 	if err != nil {
 		return "", errors.New("failed to get latest commit hash:" + err.Error())
 	}
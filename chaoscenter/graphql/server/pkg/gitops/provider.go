@@ -0,0 +1,412 @@
+package gitops
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
+)
+
+// PullRequestState is the state of a pull request on the git provider
+type PullRequestState string
+
+const (
+	PullRequestOpen   PullRequestState = "open"
+	PullRequestMerged PullRequestState = "merged"
+	PullRequestClosed PullRequestState = "closed"
+
+	providerTimeout = 30 * time.Second
+)
+
+// PullRequest identifies a pull/merge request opened on the git provider for the changes of a branch
+type PullRequest struct {
+	Number int
+	URL    string
+	// Branch holds the changes which are requested to be merged into the Base branch
+	Branch string
+	Base   string
+	// Head is the commit of the branch when the pull request was opened
+	Head string
+}
+
+// Provider opens the pull requests of the gitops changes and reports their state on the git provider hosting the
+// repository
+type Provider interface {
+	OpenPullRequest(ctx context.Context, pullRequest PullRequest, title string, body string) (*PullRequest, error)
+	GetPullRequestState(ctx context.Context, pullRequest PullRequest) (PullRequestState, error)
+}
+
+// NewProvider returns the Provider of the git provider set in the GitConfig
+func NewProvider(c GitConfig) (Provider, error) {
+	if c.Provider == model.GitProviderLocal {
+		return &localProvider{path: strings.TrimPrefix(c.RepositoryURL, "file://")}, nil
+	}
+
+	scheme, host, repository, err := parseRepositoryURL(c.RepositoryURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var token string
+	switch {
+	case c.Token != nil && *c.Token != "":
+		token = *c.Token
+	case c.Password != nil && *c.Password != "":
+		token = *c.Password
+	default:
+		return nil, errors.New("a token or a password is required to open pull requests")
+	}
+
+	// the API URL set in the config may point anywhere, unlike the repository which already receives the token, so
+	// it is only reached on public addresses
+	apiURL, client := "", http.DefaultClient
+	if c.ProviderURL != nil && *c.ProviderURL != "" {
+		apiURL, client = strings.TrimSuffix(*c.ProviderURL, "/"), notification.PublicHTTPClient
+	}
+
+	switch c.Provider {
+	case model.GitProviderGithub:
+		if apiURL == "" {
+			apiURL = scheme + "://" + host + "/api/v3"
+			if host == "github.com" {
+				apiURL = "https://api.github.com"
+			}
+		}
+		return &githubProvider{client: client, apiURL: apiURL, repository: repository, token: token}, nil
+	case model.GitProviderGitlab:
+		if apiURL == "" {
+			apiURL = scheme + "://" + host + "/api/v4"
+		}
+		return &gitlabProvider{client: client, apiURL: apiURL, repository: repository, token: token}, nil
+	case model.GitProviderGitea:
+		if apiURL == "" {
+			apiURL = scheme + "://" + host + "/api/v1"
+		}
+		return &giteaProvider{client: client, apiURL: apiURL, repository: repository, token: token}, nil
+	default:
+		return nil, fmt.Errorf("unsupported git provider %s", c.Provider)
+	}
+}
+
+// validateWriteMode returns an error if the pull requests can't be opened with the GitConfig in the pull request mode
+func validateWriteMode(ctx context.Context, c GitConfig) error {
+	if !c.PullRequestMode() {
+		return nil
+	}
+	if c.Provider == "" {
+		return errors.New("a git provider is required to open pull requests")
+	}
+
+	if _, err := NewProvider(c); err != nil {
+		return err
+	}
+	if c.ProviderURL == nil || *c.ProviderURL == "" || c.Provider == model.GitProviderLocal {
+		return nil
+	}
+	providerURL, err := url.Parse(*c.ProviderURL)
+	if err != nil || (providerURL.Scheme != "http" && providerURL.Scheme != "https") {
+		return errors.New("invalid provider url " + *c.ProviderURL)
+	}
+	if err := notification.ValidateDestination(ctx, providerURL); err != nil {
+		return fmt.Errorf("invalid provider url: %w", err)
+	}
+	return nil
+}
+
+// pullRequestBranch returns the name of a new branch for the changes of the given experiment
+func pullRequestBranch(name string) string {
+	return "litmus/" + name + "-" + strconv.FormatInt(time.Now().Unix(), 10)
+}
+
+// parseRepositoryURL returns the scheme of the web URL, the host and the path of the repository from its http(s),
+// ssh or scp-like git URL
+func parseRepositoryURL(repositoryURL string) (string, string, string, error) {
+	scheme := "https"
+	var host, path string
+
+	if !strings.Contains(repositoryURL, "://") {
+		// scp-like syntax, e.g. git@github.com:owner/repo.git
+		parts := strings.SplitN(repositoryURL, ":", 2)
+		if len(parts) != 2 {
+			return "", "", "", errors.New("invalid repository URL " + repositoryURL)
+		}
+		host = parts[0][strings.LastIndex(parts[0], "@")+1:]
+		path = parts[1]
+	} else {
+		u, err := url.Parse(repositoryURL)
+		if err != nil {
+			return "", "", "", err
+		}
+		if u.Scheme == "http" {
+			scheme = "http"
+		}
+		host = u.Host
+		if u.Scheme == "ssh" {
+			host = u.Hostname()
+		}
+		path = u.Path
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	if host == "" || !strings.Contains(path, "/") {
+		return "", "", "", errors.New("invalid repository URL " + repositoryURL)
+	}
+
+	return scheme, host, path, nil
+}
+
+// sendProviderRequest sends a json request to the API of the git provider and decodes the json response in out
+func sendProviderRequest(ctx context.Context, client *http.Client, method string, endpoint string, headers map[string]string, in interface{}, out interface{}) error {
+	var body []byte
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = data
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, providerTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("%s %s returned %d: %s", method, endpoint, resp.StatusCode, string(data))
+	}
+
+	return json.Unmarshal(data, out)
+}
+
+// githubProvider opens pull requests through the GitHub REST API
+type githubProvider struct {
+	client     *http.Client
+	apiURL     string
+	repository string
+	token      string
+}
+
+type githubPullRequest struct {
+	Number  int    `json:"number"`
+	HTMLURL string `json:"html_url"`
+	State   string `json:"state"`
+	Merged  bool   `json:"merged"`
+}
+
+func (p *githubProvider) headers() map[string]string {
+	return map[string]string{"Authorization": "token " + p.token}
+}
+
+func (p *githubProvider) OpenPullRequest(ctx context.Context, pullRequest PullRequest, title string, body string) (*PullRequest, error) {
+	var response githubPullRequest
+	err := sendProviderRequest(ctx, p.client, http.MethodPost, p.apiURL+"/repos/"+p.repository+"/pulls", p.headers(), map[string]string{
+		"title": title,
+		"head":  pullRequest.Branch,
+		"base":  pullRequest.Base,
+		"body":  body,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest.Number = response.Number
+	pullRequest.URL = response.HTMLURL
+	return &pullRequest, nil
+}
+
+func (p *githubProvider) GetPullRequestState(ctx context.Context, pullRequest PullRequest) (PullRequestState, error) {
+	var response githubPullRequest
+	err := sendProviderRequest(ctx, p.client, http.MethodGet, fmt.Sprintf("%s/repos/%s/pulls/%d", p.apiURL, p.repository, pullRequest.Number), p.headers(), nil, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return pullRequestState(response.State, response.Merged), nil
+}
+
+// gitlabProvider opens merge requests through the GitLab REST API
+type gitlabProvider struct {
+	client     *http.Client
+	apiURL     string
+	repository string
+	token      string
+}
+
+type gitlabMergeRequest struct {
+	IID    int    `json:"iid"`
+	WebURL string `json:"web_url"`
+	State  string `json:"state"`
+}
+
+func (p *gitlabProvider) headers() map[string]string {
+	return map[string]string{"PRIVATE-TOKEN": p.token}
+}
+
+func (p *gitlabProvider) projectURL() string {
+	return p.apiURL + "/projects/" + url.PathEscape(p.repository)
+}
+
+func (p *gitlabProvider) OpenPullRequest(ctx context.Context, pullRequest PullRequest, title string, body string) (*PullRequest, error) {
+	var response gitlabMergeRequest
+	err := sendProviderRequest(ctx, p.client, http.MethodPost, p.projectURL()+"/merge_requests", p.headers(), map[string]string{
+		"title":         title,
+		"source_branch": pullRequest.Branch,
+		"target_branch": pullRequest.Base,
+		"description":   body,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest.Number = response.IID
+	pullRequest.URL = response.WebURL
+	return &pullRequest, nil
+}
+
+func (p *gitlabProvider) GetPullRequestState(ctx context.Context, pullRequest PullRequest) (PullRequestState, error) {
+	var response gitlabMergeRequest
+	err := sendProviderRequest(ctx, p.client, http.MethodGet, fmt.Sprintf("%s/merge_requests/%d", p.projectURL(), pullRequest.Number), p.headers(), nil, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return pullRequestState(response.State, response.State == "merged"), nil
+}
+
+// giteaProvider opens pull requests through the Gitea REST API
+type giteaProvider struct {
+	client     *http.Client
+	apiURL     string
+	repository string
+	token      string
+}
+
+func (p *giteaProvider) headers() map[string]string {
+	return map[string]string{"Authorization": "token " + p.token}
+}
+
+func (p *giteaProvider) OpenPullRequest(ctx context.Context, pullRequest PullRequest, title string, body string) (*PullRequest, error) {
+	// the pull requests of Gitea share the fields of the GitHub ones
+	var response githubPullRequest
+	err := sendProviderRequest(ctx, p.client, http.MethodPost, p.apiURL+"/repos/"+p.repository+"/pulls", p.headers(), map[string]string{
+		"title": title,
+		"head":  pullRequest.Branch,
+		"base":  pullRequest.Base,
+		"body":  body,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	pullRequest.Number = response.Number
+	pullRequest.URL = response.HTMLURL
+	return &pullRequest, nil
+}
+
+func (p *giteaProvider) GetPullRequestState(ctx context.Context, pullRequest PullRequest) (PullRequestState, error) {
+	var response githubPullRequest
+	err := sendProviderRequest(ctx, p.client, http.MethodGet, fmt.Sprintf("%s/repos/%s/pulls/%d", p.apiURL, p.repository, pullRequest.Number), p.headers(), nil, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return pullRequestState(response.State, response.Merged), nil
+}
+
+func pullRequestState(state string, merged bool) PullRequestState {
+	switch {
+	case merged:
+		return PullRequestMerged
+	case state == "open" || state == "opened":
+		return PullRequestOpen
+	default:
+		return PullRequestClosed
+	}
+}
+
+// localProvider treats the branches pushed to a bare repository on the disk as pull requests, a pull request is
+// merged once its head is reachable from the base branch and closed once its branch is deleted without being merged
+type localProvider struct {
+	path string
+}
+
+func (p *localProvider) OpenPullRequest(ctx context.Context, pullRequest PullRequest, title string, body string) (*PullRequest, error) {
+	repo, err := git.PlainOpen(p.path)
+	if err != nil {
+		return nil, err
+	}
+	for _, branch := range []string{pullRequest.Branch, pullRequest.Base} {
+		if _, err = repo.Reference(plumbing.NewBranchReferenceName(branch), true); err != nil {
+			return nil, errors.New("branch " + branch + " not found: " + err.Error())
+		}
+	}
+
+	pullRequest.URL = p.path + "#" + pullRequest.Branch
+	return &pullRequest, nil
+}
+
+func (p *localProvider) GetPullRequestState(ctx context.Context, pullRequest PullRequest) (PullRequestState, error) {
+	repo, err := git.PlainOpen(p.path)
+	if err != nil {
+		return "", err
+	}
+
+	head, err := repo.CommitObject(plumbing.NewHash(pullRequest.Head))
+	if err != nil {
+		return "", err
+	}
+	baseRef, err := repo.Reference(plumbing.NewBranchReferenceName(pullRequest.Base), true)
+	if err != nil {
+		return "", err
+	}
+	base, err := repo.CommitObject(baseRef.Hash())
+	if err != nil {
+		return "", err
+	}
+
+	merged, err := head.IsAncestor(base)
+	if err != nil {
+		return "", err
+	}
+	if merged || head.Hash == base.Hash {
+		return PullRequestMerged, nil
+	}
+
+	if _, err = repo.Reference(plumbing.NewBranchReferenceName(pullRequest.Branch), true); err == plumbing.ErrReferenceNotFound {
+		return PullRequestClosed, nil
+	} else if err != nil {
+		return "", err
+	}
+
+	return PullRequestOpen, nil
+}
@@ -0,0 +1,228 @@
+package gitops_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	gitconfig "github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testProjectID = "project"
+	testBranch    = "main"
+)
+
+// newBareRepository returns the path of a bare repository whose main branch holds a single commit
+func newBareRepository(t *testing.T) string {
+	dir := t.TempDir()
+	barePath := filepath.Join(dir, "remote.git")
+	_, err := git.PlainInit(barePath, true)
+	assert.NoError(t, err)
+
+	seedPath := filepath.Join(dir, "seed")
+	seed, err := git.PlainInit(seedPath, false)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(seedPath, "README.md"), []byte("chaos"), 0644))
+	w, err := seed.Worktree()
+	assert.NoError(t, err)
+	_, err = w.Add("README.md")
+	assert.NoError(t, err)
+	_, err = w.Commit("initial commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "litmus", Email: "litmus@chaos", When: time.Now()},
+	})
+	assert.NoError(t, err)
+
+	_, err = seed.CreateRemote(&gitconfig.RemoteConfig{Name: "origin", URLs: []string{barePath}})
+	assert.NoError(t, err)
+	head, err := seed.Head()
+	assert.NoError(t, err)
+	err = seed.Push(&git.PushOptions{
+		RemoteName: "origin",
+		RefSpecs:   []gitconfig.RefSpec{gitconfig.RefSpec(head.Name() + ":" + plumbing.NewBranchReferenceName(testBranch))},
+	})
+	assert.NoError(t, err)
+
+	return barePath
+}
+
+// openPullRequest writes an experiment in a clone of the repository and opens a pull request for it
+func openPullRequest(t *testing.T, config gitops.GitConfig, branch string) *gitops.PullRequest {
+	_, err := config.GitClone()
+	assert.NoError(t, err)
+
+	projectPath := filepath.Join(config.LocalPath, gitops.ProjectDataPath, testProjectID)
+	assert.NoError(t, os.MkdirAll(projectPath, 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(projectPath, "experiment.yaml"), []byte("kind: Workflow"), 0644))
+
+	pullRequest, err := config.GitPullRequest(context.Background(), gitops.GitUserFromContext(nil), branch, "Updated Experiment : experiment", nil)
+	assert.NoError(t, err)
+	return pullRequest
+}
+
+// TestLocalProvider is used to test the pull request workflow against a local bare repository
+func TestLocalProvider(t *testing.T) {
+	t.Run("pull request is open until merged", func(t *testing.T) {
+		// given
+		barePath := newBareRepository(t)
+		config := gitops.GitConfig{
+			ProjectID:     testProjectID,
+			RepositoryURL: barePath,
+			LocalPath:     t.TempDir(),
+			RemoteName:    "origin",
+			Branch:        testBranch,
+			AuthType:      model.AuthTypeNone,
+			WriteMode:     model.GitWriteModePullRequest,
+			Provider:      model.GitProviderLocal,
+		}
+		provider, err := gitops.NewProvider(config)
+		assert.NoError(t, err)
+
+		// when
+		pullRequest := openPullRequest(t, config, "litmus/experiment-1")
+		stateBeforeMerge, err := provider.GetPullRequestState(context.Background(), *pullRequest)
+		assert.NoError(t, err)
+
+		bare, err := git.PlainOpen(barePath)
+		assert.NoError(t, err)
+		err = bare.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(testBranch), plumbing.NewHash(pullRequest.Head)))
+		assert.NoError(t, err)
+		stateAfterMerge, err := provider.GetPullRequestState(context.Background(), *pullRequest)
+		assert.NoError(t, err)
+
+		// then
+		assert.Equal(t, testBranch, pullRequest.Base)
+		assert.Equal(t, gitops.PullRequestOpen, stateBeforeMerge)
+		assert.Equal(t, gitops.PullRequestMerged, stateAfterMerge)
+	})
+	t.Run("pull request is closed when the branch is deleted", func(t *testing.T) {
+		// given
+		barePath := newBareRepository(t)
+		config := gitops.GitConfig{
+			ProjectID:     testProjectID,
+			RepositoryURL: barePath,
+			LocalPath:     t.TempDir(),
+			RemoteName:    "origin",
+			Branch:        testBranch,
+			AuthType:      model.AuthTypeNone,
+			WriteMode:     model.GitWriteModePullRequest,
+			Provider:      model.GitProviderLocal,
+		}
+		provider, err := gitops.NewProvider(config)
+		assert.NoError(t, err)
+		pullRequest := openPullRequest(t, config, "litmus/experiment-2")
+
+		// when
+		bare, err := git.PlainOpen(barePath)
+		assert.NoError(t, err)
+		assert.NoError(t, bare.Storer.RemoveReference(plumbing.NewBranchReferenceName(pullRequest.Branch)))
+		state, err := provider.GetPullRequestState(context.Background(), *pullRequest)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, gitops.PullRequestClosed, state)
+	})
+	t.Run("configured branch isn't pushed to", func(t *testing.T) {
+		// given
+		barePath := newBareRepository(t)
+		config := gitops.GitConfig{
+			ProjectID:     testProjectID,
+			RepositoryURL: barePath,
+			LocalPath:     t.TempDir(),
+			RemoteName:    "origin",
+			Branch:        testBranch,
+			AuthType:      model.AuthTypeNone,
+			WriteMode:     model.GitWriteModePullRequest,
+			Provider:      model.GitProviderLocal,
+		}
+		bare, err := git.PlainOpen(barePath)
+		assert.NoError(t, err)
+		before, err := bare.Reference(plumbing.NewBranchReferenceName(testBranch), true)
+		assert.NoError(t, err)
+
+		// when
+		openPullRequest(t, config, "litmus/experiment-3")
+
+		// then
+		after, err := bare.Reference(plumbing.NewBranchReferenceName(testBranch), true)
+		assert.NoError(t, err)
+		assert.Equal(t, before.Hash(), after.Hash())
+		exists, err := gitops.PathExists(filepath.Join(config.LocalPath, gitops.ProjectDataPath, testProjectID, "experiment.yaml"))
+		assert.NoError(t, err)
+		assert.False(t, exists)
+	})
+}
+
+// TestNewProvider is used to test the providers built from the gitops configuration
+func TestNewProvider(t *testing.T) {
+	token := "token"
+	tests := []struct {
+		name          string
+		repositoryURL string
+		provider      model.GitProvider
+		token         *string
+		expectedError bool
+	}{
+		{
+			name:          "github https repository",
+			repositoryURL: "https://github.com/litmuschaos/chaos-charts.git",
+			provider:      model.GitProviderGithub,
+			token:         &token,
+		},
+		{
+			name:          "gitlab scp-like repository",
+			repositoryURL: "git@gitlab.com:litmuschaos/group/chaos-charts.git",
+			provider:      model.GitProviderGitlab,
+			token:         &token,
+		},
+		{
+			name:          "gitea ssh repository",
+			repositoryURL: "ssh://git@gitea.local:2222/litmuschaos/chaos-charts.git",
+			provider:      model.GitProviderGitea,
+			token:         &token,
+		},
+		{
+			name:          "missing token",
+			repositoryURL: "https://github.com/litmuschaos/chaos-charts.git",
+			provider:      model.GitProviderGithub,
+			expectedError: true,
+		},
+		{
+			name:          "repository without owner",
+			repositoryURL: "https://github.com/chaos-charts.git",
+			provider:      model.GitProviderGithub,
+			token:         &token,
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			config := gitops.GitConfig{
+				RepositoryURL: tc.repositoryURL,
+				Provider:      tc.provider,
+				Token:         tc.token,
+			}
+
+			// when
+			provider, err := gitops.NewProvider(config)
+
+			// then
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.NotNil(t, provider)
+		})
+	}
+}
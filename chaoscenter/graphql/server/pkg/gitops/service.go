@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -13,15 +14,18 @@ import (
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/google/uuid"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 	chaos_experiment2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment"
 	chaos_infra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
 	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/grpc"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
 
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
@@ -33,6 +37,10 @@ import (
 const (
 	timeout  = time.Second * 5
 	tempPath = "/tmp/gitops_test/"
+
+	// ExperimentRunInProgress is the gitops notification response when the run is skipped as a run is in progress, the
+	// event-tracker records the trigger as skipped on this response so its copy of the constant must be kept in sync
+	ExperimentRunInProgress = "Experiment Run In Progress"
 )
 
 var (
@@ -41,41 +49,50 @@ var (
 )
 
 type Service interface {
-	GitOpsNotificationHandler(ctx context.Context, infra chaos_infrastructure.ChaosInfra, experimentID string) (string, error)
+	GitOpsNotificationHandler(ctx context.Context, infra chaos_infrastructure.ChaosInfra, experimentID string, skipIfRunning bool) (string, error)
 	EnableGitOpsHandler(ctx context.Context, config model.GitConfig) (bool, error)
-	DisableGitOpsHandler(ctx context.Context, projectID string) (bool, error)
+	DisableGitOpsHandler(ctx context.Context, projectID string, configID *string) (bool, error)
 	UpdateGitOpsDetailsHandler(ctx context.Context, config model.GitConfig) (bool, error)
-	GetGitOpsDetails(ctx context.Context, projectID string) (*model.GitConfigResponse, error)
+	GetGitOpsDetails(ctx context.Context, projectID string, configID *string) (*model.GitConfigResponse, error)
+	ListGitOpsConfigs(ctx context.Context, projectID string) ([]*model.GitConfigResponse, error)
 	UpsertExperimentToGit(ctx context.Context, projectID string, experiment *model.ChaosExperimentRequest) error
 	DeleteExperimentFromGit(ctx context.Context, projectID string, experiment *model.ChaosExperimentRequest) error
-	GitOpsSyncHandler(singleRun bool)
+	GitOpsSyncHandler(syncInterval time.Duration)
+	WebhookHandler() http.Handler
 	SyncDBToGit(ctx context.Context, config GitConfig) error
+	GetGitOpsDriftReport(ctx context.Context, projectID string, configID *string) (*model.GitOpsDriftReport, error)
+	ResolveGitOpsDrift(ctx context.Context, projectID string, experimentID string, resolution model.GitOpsDriftResolution) (*model.GitOpsDrift, error)
 }
 
 type gitOpsService struct {
 	gitOpsOperator         *gitops.Operator
 	chaosExperimentOps     chaos_experiment.Operator
+	chaosExperimentRunOps  chaos_experiment_run.Operator
+	chaosInfraOps          chaos_infrastructure.Operator
 	chaosExperimentService chaos_experiment2.Service
 }
 
 // NewGitOpsService returns a new instance of a gitOpsService
-func NewGitOpsService(gitOpsOperator *gitops.Operator, chaosExperimentService chaos_experiment2.Service, chaosExperimentOps chaos_experiment.Operator) Service {
+func NewGitOpsService(gitOpsOperator *gitops.Operator, chaosExperimentService chaos_experiment2.Service, chaosExperimentOps chaos_experiment.Operator, chaosExperimentRunOps chaos_experiment_run.Operator, chaosInfraOps chaos_infrastructure.Operator) Service {
 	return &gitOpsService{
 		gitOpsOperator:         gitOpsOperator,
 		chaosExperimentService: chaosExperimentService,
 		chaosExperimentOps:     chaosExperimentOps,
+		chaosExperimentRunOps:  chaosExperimentRunOps,
+		chaosInfraOps:          chaosInfraOps,
 	}
 }
 
-// GitOpsNotificationHandler sends experiment run request(single run experiment only) to agent on gitops notification
-func (g *gitOpsService) GitOpsNotificationHandler(ctx context.Context, infra chaos_infrastructure.ChaosInfra, experimentID string) (string, error) {
+// GitOpsNotificationHandler sends experiment run request(single run experiment only) to agent on gitops notification,
+// the request is skipped if skipIfRunning is set and a run of the experiment is in progress on the infra
+func (g *gitOpsService) GitOpsNotificationHandler(ctx context.Context, infra chaos_infrastructure.ChaosInfra, experimentID string, skipIfRunning bool) (string, error) {
 	gitLock.Lock(infra.ProjectID, nil)
 	defer gitLock.Unlock(infra.ProjectID, nil)
-	config, err := g.gitOpsOperator.GetGitConfig(ctx, infra.ProjectID)
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, infra.ProjectID)
 	if err != nil {
 		return "", errors.New("Cannot get Git Config from DB : " + err.Error())
 	}
-	if config == nil {
+	if SelectGitConfig(configs, infra.InfraID, infra.EnvironmentID) == nil {
 		return "Gitops Disabled", nil
 	}
 	query := bson.D{{"infra_id", infra.InfraID}, {"experiment_id", experimentID}, {"isRemoved", false}}
@@ -91,6 +108,16 @@ func (g *gitOpsService) GitOpsNotificationHandler(ctx context.Context, infra cha
 	if strings.ToLower(resKind) == "cronexperiment" { // no op
 		return "Request Acknowledged for experimentID: " + experimentID, nil
 	}
+	if skipIfRunning {
+		runs, err := g.chaosExperimentRunOps.CountExperimentRuns(ctx, chaos_experiment_run.InProgressRunsQuery(infra.InfraID, experimentID, time.Now()))
+		if err != nil {
+			logrus.Error("Could not get experiment runs :", err)
+			return "could not get experiment runs", err
+		}
+		if runs > 0 {
+			return ExperimentRunInProgress, nil
+		}
+	}
 	experiments[0].Revision[len(experiments[0].Revision)-1].ExperimentManifest, err = sjson.Set(experiments[0].Revision[len(experiments[0].Revision)-1].ExperimentManifest, "metadata.name", experiments[0].Name+"-"+strconv.FormatInt(time.Now().Unix(), 10))
 	if err != nil {
 		logrus.Error("Failed to updated experiment name :", err)
@@ -98,7 +125,7 @@ func (g *gitOpsService) GitOpsNotificationHandler(ctx context.Context, infra cha
 	}
 
 	username := "git-ops"
-	chaos_infra.SendExperimentToSubscriber(experiments[0].ProjectID, &model.ChaosExperimentRequest{
+	chaos_infra.SendExperimentToSubscriber(ctx, experiments[0].ProjectID, &model.ChaosExperimentRequest{
 		ExperimentManifest: experiments[0].Revision[len(experiments[0].Revision)-1].ExperimentManifest,
 		InfraID:            experiments[0].InfraID,
 	}, &username, nil, "create", store.Store)
@@ -125,6 +152,12 @@ func (g *gitOpsService) EnableGitOpsHandler(ctx context.Context, config model.Gi
 
 	logrus.Info("Enabling Gitops")
 	gitDB := gitops.GetGitConfigDB(config)
+	gitDB.ConfigID = uuid.New().String()
+
+	err = g.validateGitConfig(ctx, &gitDB)
+	if err != nil {
+		return false, errors.New("Failed to setup GitOps : " + err.Error())
+	}
 
 	commit, err := SetupGitOps(GitUserFromContext(ctx), GetGitOpsConfig(gitDB))
 	if err != nil {
@@ -140,23 +173,48 @@ func (g *gitOpsService) EnableGitOpsHandler(ctx context.Context, config model.Gi
 	return true, nil
 }
 
-// DisableGitOpsHandler disables gitops for a specific project
-func (g *gitOpsService) DisableGitOpsHandler(ctx context.Context, projectID string) (bool, error) {
+// DisableGitOpsHandler disables the gitops config of a specific project, every config of the project is disabled if no
+// config id is provided
+func (g *gitOpsService) DisableGitOpsHandler(ctx context.Context, projectID string, configID *string) (bool, error) {
 	gitLock.Lock(projectID, nil)
 	defer gitLock.Unlock(projectID, nil)
 
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, projectID)
+	if err != nil {
+		return false, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+
 	logrus.Info("Disabling Gitops")
-	err := g.gitOpsOperator.DeleteGitConfig(ctx, projectID)
+	for _, config := range configs {
+		if configID != nil && config.ConfigID != *configID {
+			continue
+		}
+		err = g.disableGitConfig(ctx, config)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// disableGitConfig removes the git config from the DB and its local repository from the disk
+func (g *gitOpsService) disableGitConfig(ctx context.Context, config gitops.GitConfigDB) error {
+	lockKey := gitConfigLockKey(config.ProjectID, config.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+
+	err := g.gitOpsOperator.DeleteGitConfig(ctx, config.ProjectID, config.ConfigID)
 	if err != nil {
-		return false, errors.New("Failed to delete git config from DB : " + err.Error())
+		return errors.New("Failed to delete git config from DB : " + err.Error())
 	}
 
-	err = os.RemoveAll(DefaultPath + projectID)
+	err = os.RemoveAll(GetGitOpsConfig(config).LocalPath)
 	if err != nil {
-		return false, errors.New("Failed to delete git repo from disk : " + err.Error())
+		return errors.New("Failed to delete git repo from disk : " + err.Error())
 	}
 
-	return true, nil
+	return nil
 }
 
 // UpdateGitOpsDetailsHandler updates an exiting gitops config for a project
@@ -164,10 +222,7 @@ func (g *gitOpsService) UpdateGitOpsDetailsHandler(ctx context.Context, config m
 	gitLock.Lock(config.ProjectID, nil)
 	defer gitLock.Unlock(config.ProjectID, nil)
 
-	gitLock.Lock(config.RepoURL, &config.Branch)
-	defer gitLock.Unlock(config.RepoURL, &config.Branch)
-
-	existingConfig, err := g.gitOpsOperator.GetGitConfig(ctx, config.ProjectID)
+	existingConfig, err := g.getGitConfig(ctx, config.ProjectID, config.ConfigID)
 	if err != nil {
 		return false, errors.New("Cannot get Git Config from DB : " + err.Error())
 	}
@@ -175,19 +230,37 @@ func (g *gitOpsService) UpdateGitOpsDetailsHandler(ctx context.Context, config m
 		return false, errors.New("GitOps Disabled ")
 	}
 
+	lockKey := gitConfigLockKey(existingConfig.ProjectID, existingConfig.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+
+	gitLock.Lock(config.RepoURL, &config.Branch)
+	defer gitLock.Unlock(config.RepoURL, &config.Branch)
+
 	logrus.Info("Enabling Gitops")
 	gitDB := gitops.GetGitConfigDB(config)
+	gitDB.ConfigID = existingConfig.ConfigID
+	// the webhook secret isn't returned with the config, so it is kept unless a new one is provided
+	if gitDB.WebhookSecret == nil {
+		gitDB.WebhookSecret = existingConfig.WebhookSecret
+	} else if *gitDB.WebhookSecret == "" {
+		gitDB.WebhookSecret = nil
+	}
 
+	err = g.validateGitConfig(ctx, &gitDB)
+	if err != nil {
+		return false, errors.New("Failed to setup GitOps : " + err.Error())
+	}
 	gitConfig := GetGitOpsConfig(gitDB)
 	originalPath := gitConfig.LocalPath
-	gitConfig.LocalPath = tempPath + gitConfig.ProjectID
+	gitConfig.LocalPath = tempPath + filepath.Base(originalPath)
 	commit, err := SetupGitOps(GitUserFromContext(ctx), gitConfig)
 	if err != nil {
 		return false, errors.New("Failed to setup GitOps : " + err.Error())
 	}
 	gitDB.LatestCommit = commit
 
-	err = g.gitOpsOperator.ReplaceGitConfig(ctx, bson.D{{"project_id", config.ProjectID}}, &gitDB)
+	err = g.gitOpsOperator.ReplaceGitConfig(ctx, gitops.GitConfigQuery(config.ProjectID, gitDB.ConfigID), &gitDB)
 	if err != nil {
 		return false, errors.New("Failed to enable GitOps in DB : " + err.Error())
 	}
@@ -204,11 +277,12 @@ func (g *gitOpsService) UpdateGitOpsDetailsHandler(ctx context.Context, config m
 	return true, nil
 }
 
-// GetGitOpsDetails returns the current gitops config for the requested project
-func (g *gitOpsService) GetGitOpsDetails(ctx context.Context, projectID string) (*model.GitConfigResponse, error) {
+// GetGitOpsDetails returns the current gitops config for the requested project, the default config of the project is
+// returned if no config id is provided
+func (g *gitOpsService) GetGitOpsDetails(ctx context.Context, projectID string, configID *string) (*model.GitConfigResponse, error) {
 	gitLock.Lock(projectID, nil)
 	defer gitLock.Unlock(projectID, nil)
-	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID)
+	config, err := g.getGitConfig(ctx, projectID, configID)
 	if err != nil {
 		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
 	}
@@ -218,12 +292,49 @@ func (g *gitOpsService) GetGitOpsDetails(ctx context.Context, projectID string)
 			Enabled:   false,
 		}, nil
 	}
+
+	return gitConfigResponse(*config), nil
+}
+
+// ListGitOpsConfigs returns all the gitops configs of the requested project
+func (g *gitOpsService) ListGitOpsConfigs(ctx context.Context, projectID string) ([]*model.GitConfigResponse, error) {
+	gitLock.Lock(projectID, nil)
+	defer gitLock.Unlock(projectID, nil)
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, projectID)
+	if err != nil {
+		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+
+	resp := []*model.GitConfigResponse{}
+	for _, config := range configs {
+		resp = append(resp, gitConfigResponse(config))
+	}
+	return resp, nil
+}
+
+// gitConfigResponse returns the response of a gitops config with the credentials of its auth type
+func gitConfigResponse(config gitops.GitConfigDB) *model.GitConfigResponse {
+	writeMode := model.GitWriteModePush
+	if config.WriteMode != "" {
+		writeMode = config.WriteMode
+	}
 	resp := model.GitConfigResponse{
-		Enabled:   true,
-		ProjectID: config.ProjectID,
-		Branch:    &config.Branch,
-		RepoURL:   &config.RepositoryURL,
-		AuthType:  &config.AuthType,
+		Enabled:          true,
+		ProjectID:        config.ProjectID,
+		ConfigID:         &config.ConfigID,
+		Name:             &config.Name,
+		Path:             &config.Path,
+		EnvironmentID:    config.EnvironmentID,
+		InfraID:          config.InfraID,
+		Branch:           &config.Branch,
+		RepoURL:          &config.RepositoryURL,
+		AuthType:         &config.AuthType,
+		WriteMode:        &writeMode,
+		ProviderURL:      config.ProviderURL,
+		WebhookSecretSet: config.WebhookSecret != nil && *config.WebhookSecret != "",
+	}
+	if config.Provider != "" {
+		resp.Provider = &config.Provider
 	}
 	switch config.AuthType {
 
@@ -237,14 +348,83 @@ func (g *gitOpsService) GetGitOpsDetails(ctx context.Context, projectID string)
 	case model.AuthTypeSSH:
 		resp.SSHPrivateKey = config.SSHPrivateKey
 	}
-	return &resp, nil
+	return &resp
+}
+
+// getGitConfig returns the git config of the project with the given config id, or the default config of the project if
+// no config id is provided
+func (g *gitOpsService) getGitConfig(ctx context.Context, projectID string, configID *string) (*gitops.GitConfigDB, error) {
+	if configID != nil {
+		return g.gitOpsOperator.GetGitConfig(ctx, projectID, *configID)
+	}
+
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return DefaultGitConfig(configs), nil
+}
+
+// getInfraGitConfig returns the git config syncing the experiments of the infra, nil if no config of the project syncs
+// them
+func (g *gitOpsService) getInfraGitConfig(ctx context.Context, projectID string, infraID string) (*gitops.GitConfigDB, error) {
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, projectID)
+	if err != nil || len(configs) == 0 {
+		return nil, err
+	}
+
+	infra, err := g.chaosInfraOps.GetInfra(infraID)
+	if err != nil {
+		return nil, errors.New("Cannot get infra from DB : " + err.Error())
+	}
+	if infra.ProjectID != projectID {
+		return nil, nil
+	}
+
+	return SelectGitConfig(configs, infra.InfraID, infra.EnvironmentID), nil
+}
+
+// validateGitConfig validates the write mode and the scope of the git config against the other configs of the project
+func (g *gitOpsService) validateGitConfig(ctx context.Context, config *gitops.GitConfigDB) error {
+	err := validateWriteMode(ctx, GetGitOpsConfig(*config))
+	if err != nil {
+		return err
+	}
+
+	if config.InfraID != nil && *config.InfraID != "" {
+		_, err = g.chaosInfraOps.GetInfraDetails(ctx, *config.InfraID, config.ProjectID)
+		if err != nil {
+			return errors.New("infra " + *config.InfraID + " not found in the project")
+		}
+	}
+
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, config.ProjectID)
+	if err != nil {
+		return err
+	}
+	return ValidateGitConfigScope(config, configs)
+}
+
+// gitConfigLockKey returns the key locking the local repository of the git config
+func gitConfigLockKey(projectID string, configID string) string {
+	return projectID + "/" + configID
 }
 
-// UpsertExperimentToGit adds/updates experiment to git
+// UpsertExperimentToGit adds/updates experiment to git, only in the pull request mode as git is the source of truth of
+// the experiments in the push mode
 func (g *gitOpsService) UpsertExperimentToGit(ctx context.Context, projectID string, experiment *model.ChaosExperimentRequest) error {
-	gitLock.Lock(projectID, nil)
-	defer gitLock.Unlock(projectID, nil)
-	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID)
+	infraConfig, err := g.getInfraGitConfig(ctx, projectID, experiment.InfraID)
+	if err != nil {
+		return errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	if infraConfig == nil {
+		return nil
+	}
+
+	lockKey := gitConfigLockKey(projectID, infraConfig.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID, infraConfig.ConfigID)
 	if err != nil {
 		return errors.New("Cannot get Git Config from DB : " + err.Error())
 	}
@@ -255,24 +435,94 @@ func (g *gitOpsService) UpsertExperimentToGit(ctx context.Context, projectID str
 	defer gitLock.Unlock(config.RepositoryURL, &config.Branch)
 
 	gitConfig := GetGitOpsConfig(*config)
+	if !gitConfig.PullRequestMode() {
+		return nil
+	}
 
 	err = g.SyncDBToGit(ctx, gitConfig)
 	if err != nil {
 		return errors.New("Sync Error | " + err.Error())
 	}
 
-	experimentPath := gitConfig.LocalPath + "/" + ProjectDataPath + "/" + gitConfig.ProjectID + "/" + experiment.ExperimentName + ".yaml"
+	// the experiment isn't written if it has been changed in git too since the last sync, the drift has to be resolved
+	if experiment.ExperimentID != nil {
+		err = g.checkGitConflict(ctx, gitConfig, *experiment.ExperimentID, experiment.ExperimentName, experiment.ExperimentManifest)
+		if err != nil {
+			return err
+		}
+	}
+
+	return g.writeExperimentToGit(ctx, gitConfig, experiment)
+}
+
+// checkGitConflict returns ErrDriftConflict if the file of the experiment has been changed in git since the last sync
+// and differs from the given manifest
+func (g *gitOpsService) checkGitConflict(ctx context.Context, config GitConfig, experimentID, experimentName, manifest string) error {
+	dbExperiment, err := g.chaosExperimentOps.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", config.ProjectID},
+	})
+	if err != nil {
+		return errors.New("Cannot get experiment from DB : " + err.Error())
+	}
+	if dbExperiment.GitSync == nil {
+		return nil
+	}
+
+	gitManifest, err := readExperimentFile(config, experimentName)
+	if err != nil || gitManifest == nil {
+		return err
+	}
+	gitChecksum, err := ManifestChecksum(gitManifest)
+	if err != nil {
+		return errors.New("Cannot read experiment from git : " + err.Error())
+	}
+	checksum, err := ManifestChecksum([]byte(manifest))
+	if err != nil {
+		return err
+	}
+	if gitChecksum != dbExperiment.GitSync.GitChecksum && gitChecksum != checksum {
+		return ErrDriftConflict
+	}
+
+	return nil
+}
+
+// writeExperimentToGit writes the manifest of the experiment in the repository and pushes it or opens a pull request
+// for it based on the write mode
+func (g *gitOpsService) writeExperimentToGit(ctx context.Context, gitConfig GitConfig, experiment *model.ChaosExperimentRequest) error {
+	projectPath := gitConfig.LocalPath + "/" + gitConfig.ExperimentsPath()
+	experimentPath := projectPath + "/" + experiment.ExperimentName + ".yaml"
 
 	data, err := yaml.JSONToYAML([]byte(experiment.ExperimentManifest))
 	if err != nil {
 		return errors.New("Cannot convert manifest to yaml : " + err.Error())
 	}
 
+	err = os.MkdirAll(projectPath, 0755)
+	if err != nil {
+		return errors.New("Cannot write experiment to git : " + err.Error())
+	}
 	err = ioutil.WriteFile(experimentPath, data, 0644)
 	if err != nil {
 		return errors.New("Cannot write experiment to git : " + err.Error())
 	}
 
+	if gitConfig.PullRequestMode() {
+		pullRequest, err := gitConfig.GitPullRequest(ctx, GitUserFromContext(ctx), pullRequestBranch(experiment.ExperimentName), "Updated Experiment : "+experiment.ExperimentName, nil)
+		if err != nil {
+			return errors.New("Cannot open pull request for experiment : " + err.Error())
+		}
+		if experiment.ExperimentID == nil {
+			return nil
+		}
+
+		return g.chaosExperimentOps.UpdateChaosExperiment(ctx, bson.D{
+			{"experiment_id", *experiment.ExperimentID},
+			{"project_id", gitConfig.ProjectID},
+		}, bson.D{{"$set", bson.D{{"pending_review", pendingReview(*pullRequest)}}}})
+	}
+
 	commit, err := gitConfig.GitCommit(GitUserFromContext(ctx), "Updated Experiment : "+experiment.ExperimentName, nil)
 	if err != nil {
 		return errors.New("Cannot commit experiment to git : " + err.Error())
@@ -283,23 +533,40 @@ func (g *gitOpsService) UpsertExperimentToGit(ctx context.Context, projectID str
 		return errors.New("Cannot push experiment to git : " + err.Error())
 	}
 
-	query := bson.D{{"project_id", gitConfig.ProjectID}}
+	query := gitops.GitConfigQuery(gitConfig.ProjectID, gitConfig.ConfigID)
 	update := bson.D{{"$set", bson.D{{"latest_commit", commit}}}}
 	err = g.gitOpsOperator.UpdateGitConfig(ctx, query, update)
 	if err != nil {
 		return errors.New("Failed to update git config : " + err.Error())
 	}
 
+	if experiment.ExperimentID == nil {
+		return nil
+	}
+	err = g.recordGitSync(ctx, gitConfig.ProjectID, *experiment.ExperimentID, experiment.ExperimentManifest, experiment.ExperimentManifest)
+	if err != nil {
+		return errors.New("Failed to update experiment git sync : " + err.Error())
+	}
+
 	return nil
 }
 
 // DeleteExperimentFromGit deletes experiment from git
 func (g *gitOpsService) DeleteExperimentFromGit(ctx context.Context, projectID string, experiment *model.ChaosExperimentRequest) error {
 	logrus.Info("Deleting Experiment...")
-	gitLock.Lock(projectID, nil)
-	defer gitLock.Unlock(projectID, nil)
+	infraConfig, err := g.getInfraGitConfig(ctx, projectID, experiment.InfraID)
+	if err != nil {
+		return errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	if infraConfig == nil {
+		return nil
+	}
 
-	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID)
+	lockKey := gitConfigLockKey(projectID, infraConfig.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+
+	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID, infraConfig.ConfigID)
 	if err != nil {
 		return errors.New("Cannot get Git Config from DB : " + err.Error())
 	}
@@ -316,7 +583,7 @@ func (g *gitOpsService) DeleteExperimentFromGit(ctx context.Context, projectID s
 		return errors.New("Sync Error | " + err.Error())
 	}
 
-	experimentPath := ProjectDataPath + "/" + gitConfig.ProjectID + "/" + experiment.ExperimentName + ".yaml"
+	experimentPath := gitConfig.ExperimentsPath() + "/" + experiment.ExperimentName + ".yaml"
 	exists, err := PathExists(gitConfig.LocalPath + "/" + experimentPath)
 	if err != nil {
 		return errors.New("Cannot delete experiment from git : " + err.Error())
@@ -330,6 +597,14 @@ func (g *gitOpsService) DeleteExperimentFromGit(ctx context.Context, projectID s
 		return errors.New("Cannot delete experiment from git : " + err.Error())
 	}
 
+	if gitConfig.PullRequestMode() {
+		_, err = gitConfig.GitPullRequest(ctx, GitUserFromContext(ctx), pullRequestBranch(experiment.ExperimentName), "Deleted Experiment : "+experiment.ExperimentName, &experimentPath)
+		if err != nil {
+			return errors.New("Cannot open pull request for experiment[delete] : " + err.Error())
+		}
+		return nil
+	}
+
 	commit, err := gitConfig.GitCommit(GitUserFromContext(ctx), "Deleted Experiment : "+experiment.ExperimentName, &experimentPath)
 	if err != nil {
 		logrus.Error("Error", err)
@@ -342,7 +617,7 @@ func (g *gitOpsService) DeleteExperimentFromGit(ctx context.Context, projectID s
 		return errors.New("Cannot push experiment[delete] to git : " + err.Error())
 	}
 
-	query := bson.D{{"project_id", gitConfig.ProjectID}}
+	query := gitops.GitConfigQuery(gitConfig.ProjectID, gitConfig.ConfigID)
 	update := bson.D{{"$set", bson.D{{"latest_commit", commit}}}}
 	err = g.gitOpsOperator.UpdateGitConfig(ctx, query, update)
 	if err != nil {
@@ -352,14 +627,207 @@ func (g *gitOpsService) DeleteExperimentFromGit(ctx context.Context, projectID s
 	return nil
 }
 
+// GetGitOpsDriftReport pulls the repository of the git config and returns the drift of every experiment synced by the
+// config against the head of the repository, neither the DB nor the repository is changed
+func (g *gitOpsService) GetGitOpsDriftReport(ctx context.Context, projectID string, configID *string) (*model.GitOpsDriftReport, error) {
+	selectedConfig, err := g.getGitConfig(ctx, projectID, configID)
+	if err != nil {
+		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	if selectedConfig == nil {
+		return nil, errors.New("gitops is not enabled for the project")
+	}
+
+	lockKey := gitConfigLockKey(projectID, selectedConfig.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+
+	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID, selectedConfig.ConfigID)
+	if err != nil {
+		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	if config == nil {
+		return nil, errors.New("gitops is not enabled for the project")
+	}
+	gitLock.Lock(config.RepositoryURL, &config.Branch)
+	defer gitLock.Unlock(config.RepositoryURL, &config.Branch)
+
+	gitConfig := GetGitOpsConfig(*config)
+
+	// the report is read-only, the local repo is refreshed without applying the changes to the DB or the repository
+	err = gitConfig.refreshGitRepo()
+	if err != nil {
+		return nil, errors.New("Cannot refresh repository : " + err.Error())
+	}
+	commit, err := gitConfig.GetLatestCommitHash()
+	if err != nil {
+		return nil, errors.New("Cannot get latest commit : " + err.Error())
+	}
+
+	experiments, err := g.chaosExperimentOps.GetExperiments(bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, err
+	}
+	configs, err := g.gitOpsOperator.GetGitConfigs(ctx, projectID)
+	if err != nil {
+		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	infras, err := g.chaosInfraOps.GetInfraWithProjectID(projectID)
+	if err != nil {
+		return nil, errors.New("Cannot get infras from DB : " + err.Error())
+	}
+	environments := map[string]string{}
+	for _, infra := range infras {
+		environments[infra.InfraID] = infra.EnvironmentID
+	}
+
+	report := model.GitOpsDriftReport{
+		ProjectID:   projectID,
+		ConfigID:    config.ConfigID,
+		Commit:      commit,
+		Experiments: []*model.GitOpsDrift{},
+	}
+	for _, experiment := range experiments {
+		experimentConfig := SelectGitConfig(configs, experiment.InfraID, environments[experiment.InfraID])
+		if experimentConfig == nil || experimentConfig.ConfigID != config.ConfigID {
+			continue
+		}
+
+		drift, err := experimentDrift(gitConfig, experiment)
+		if err != nil {
+			return nil, errors.New("Cannot get drift of experiment " + experiment.Name + " : " + err.Error())
+		}
+		report.Experiments = append(report.Experiments, drift)
+	}
+
+	return &report, nil
+}
+
+// ResolveGitOpsDrift resolves the drift of an experiment by writing the manifest of the DB to the repository or by
+// applying the manifest of the repository to the DB
+func (g *gitOpsService) ResolveGitOpsDrift(ctx context.Context, projectID string, experimentID string, resolution model.GitOpsDriftResolution) (*model.GitOpsDrift, error) {
+	query := bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	experiment, err := g.chaosExperimentOps.GetExperiment(ctx, query)
+	if err != nil {
+		return nil, errors.New("Cannot get experiment from DB : " + err.Error())
+	}
+	infraConfig, err := g.getInfraGitConfig(ctx, projectID, experiment.InfraID)
+	if err != nil {
+		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	if infraConfig == nil {
+		return nil, errors.New("experiment isn't synced by a git config of the project")
+	}
+
+	lockKey := gitConfigLockKey(projectID, infraConfig.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+
+	config, err := g.gitOpsOperator.GetGitConfig(ctx, projectID, infraConfig.ConfigID)
+	if err != nil {
+		return nil, errors.New("Cannot get Git Config from DB : " + err.Error())
+	}
+	if config == nil {
+		return nil, errors.New("gitops is not enabled for the project")
+	}
+	gitLock.Lock(config.RepositoryURL, &config.Branch)
+	defer gitLock.Unlock(config.RepositoryURL, &config.Branch)
+
+	gitConfig := GetGitOpsConfig(*config)
+
+	err = g.SyncDBToGit(ctx, gitConfig)
+	if err != nil {
+		return nil, errors.New("Sync Error | " + err.Error())
+	}
+
+	// the experiment may have been changed by the sync
+	experiment, err = g.chaosExperimentOps.GetExperiment(ctx, query)
+	if err != nil {
+		return nil, errors.New("Cannot get experiment from DB : " + err.Error())
+	}
+	gitManifest, err := readExperimentFile(gitConfig, experiment.Name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := experimentDriftStatus(experiment, gitManifest)
+	if err != nil {
+		return nil, err
+	}
+	if status == model.GitOpsDriftStatusInSync {
+		err = g.recordGitSync(ctx, projectID, experimentID, latestManifest(experiment), string(gitManifest))
+		if err != nil {
+			return nil, errors.New("Failed to update experiment git sync : " + err.Error())
+		}
+		experiment, err = g.chaosExperimentOps.GetExperiment(ctx, query)
+		if err != nil {
+			return nil, errors.New("Cannot get experiment from DB : " + err.Error())
+		}
+		return experimentDrift(gitConfig, experiment)
+	}
+
+	switch resolution {
+	case model.GitOpsDriftResolutionUseDb:
+		manifest := latestManifest(experiment)
+		err = g.writeExperimentToGit(ctx, gitConfig, &model.ChaosExperimentRequest{
+			ExperimentID:       &experiment.ExperimentID,
+			ExperimentName:     experiment.Name,
+			ExperimentManifest: manifest,
+		})
+	case model.GitOpsDriftResolutionUseGit:
+		if gitManifest == nil {
+			err = g.chaosExperimentService.ProcessExperimentDelete(ctx, query, experiment, "git-ops", data_store.Store)
+			if err != nil {
+				return nil, err
+			}
+			return &model.GitOpsDrift{
+				ExperimentID:   experiment.ExperimentID,
+				ExperimentName: experiment.Name,
+				Status:         model.GitOpsDriftStatusInSync,
+			}, nil
+		}
+
+		var data []byte
+		data, err = yaml.YAMLToJSON(gitManifest)
+		if err != nil {
+			return nil, errors.New("Cannot read experiment from git : " + err.Error())
+		}
+		if gjson.GetBytes(data, "metadata.labels.infra_id").String() != experiment.InfraID {
+			return nil, errors.New("cannot change infra id for existing experiment")
+		}
+		err = g.applyExperimentFromGit(string(data), experiment, gitConfig)
+	default:
+		return nil, errors.New("unsupported drift resolution: " + resolution.String())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	experiment, err = g.chaosExperimentOps.GetExperiment(ctx, query)
+	if err != nil {
+		return nil, errors.New("Cannot get experiment from DB : " + err.Error())
+	}
+
+	return experimentDrift(gitConfig, experiment)
+}
+
 // GitSyncHelper sync a particular repo with DB
 func (g *gitOpsService) gitSyncHelper(config gitops.GitConfigDB, wg *sync.WaitGroup) {
 	if wg != nil {
 		defer wg.Done()
 	}
 
-	gitLock.Lock(config.ProjectID, nil)
-	defer gitLock.Unlock(config.ProjectID, nil)
+	lockKey := gitConfigLockKey(config.ProjectID, config.ConfigID)
+	gitLock.Lock(lockKey, nil)
+	defer gitLock.Unlock(lockKey, nil)
+	// the pushes received from now on are picked up by a new sync
+	queuedSyncs.Delete(lockKey)
 
 	gitLock.Lock(config.RepositoryURL, &config.Branch)
 	defer gitLock.Unlock(config.RepositoryURL, &config.Branch)
@@ -367,7 +835,7 @@ func (g *gitOpsService) gitSyncHelper(config gitops.GitConfigDB, wg *sync.WaitGr
 	ctx, cancel := context.WithTimeout(backgroundContext, timeout)
 	defer cancel()
 	// get most recent data from db after acquiring lock
-	conf, err := g.gitOpsOperator.GetGitConfig(ctx, config.ProjectID)
+	conf, err := g.gitOpsOperator.GetGitConfig(ctx, config.ProjectID, config.ConfigID)
 	if err != nil {
 		logrus.Error("Repo Sync ERROR: ", config.ProjectID, err.Error())
 	}
@@ -377,16 +845,80 @@ func (g *gitOpsService) gitSyncHelper(config gitops.GitConfigDB, wg *sync.WaitGr
 
 	gitConfig := GetGitOpsConfig(*conf)
 
+	start := time.Now()
 	err = g.SyncDBToGit(nil, gitConfig)
+	metrics.ObserveGitOpsSync(start, err)
 	if err != nil {
 		logrus.Error("Repo Sync ERROR: ", conf.ProjectID, err.Error())
 	}
+
+	if gitConfig.PullRequestMode() {
+		g.syncPendingReviews(gitConfig)
+	}
+}
+
+// syncPendingReviews clears the pending review of the experiments whose pull request has been merged or closed
+func (g *gitOpsService) syncPendingReviews(config GitConfig) {
+	provider, err := NewProvider(config)
+	if err != nil {
+		logrus.Error("Pending Review Sync ERROR: ", config.ProjectID, err.Error())
+		return
+	}
+
+	experiments, err := g.chaosExperimentOps.GetExperiments(bson.D{
+		{"project_id", config.ProjectID},
+		{"pending_review", bson.D{{"$exists", true}}},
+		{"is_removed", false},
+	})
+	if err != nil {
+		logrus.Error("Pending Review Sync ERROR: ", config.ProjectID, err.Error())
+		return
+	}
+
+	for _, experiment := range experiments {
+		review := experiment.PendingReview
+		if review == nil {
+			continue
+		}
+
+		ctx, cancel := context.WithTimeout(backgroundContext, providerTimeout)
+		state, err := provider.GetPullRequestState(ctx, PullRequest{
+			Number: review.Number,
+			URL:    review.URL,
+			Branch: review.Branch,
+			Base:   config.Branch,
+			Head:   review.Head,
+		})
+		if err != nil {
+			cancel()
+			logrus.Error("Cannot get pull request state : ", review.URL, " | ", err.Error())
+			continue
+		}
+		if state == PullRequestOpen {
+			cancel()
+			continue
+		}
+
+		if state == PullRequestClosed {
+			logrus.Warn("Pull request closed without being merged : ", review.URL)
+		} else {
+			logrus.Info("Pull request merged : ", review.URL)
+		}
+		err = g.chaosExperimentOps.UpdateChaosExperiment(ctx, bson.D{
+			{"experiment_id", experiment.ExperimentID},
+			{"pending_review.branch", review.Branch},
+		}, bson.D{{"$unset", bson.D{{"pending_review", ""}}}})
+		cancel()
+		if err != nil {
+			logrus.Error("Cannot clear pending review of experiment : ", experiment.ExperimentID, " | ", err.Error())
+		}
+	}
 }
 
-// GitOpsSyncHandler syncs all repos in the DB
-func (g *gitOpsService) GitOpsSyncHandler(singleRun bool) {
+// GitOpsSyncHandler syncs all repos in the DB every syncInterval, the repos are synced once if syncInterval isn't
+// positive and the webhooks of the repos trigger their sync
+func (g *gitOpsService) GitOpsSyncHandler(syncInterval time.Duration) {
 	const syncGroupSize = 10
-	const syncInterval = 2 * time.Minute
 	for {
 
 		ctx, cancel := context.WithTimeout(backgroundContext, timeout)
@@ -419,7 +951,7 @@ func (g *gitOpsService) GitOpsSyncHandler(singleRun bool) {
 
 			logrus.Info("GitOps DB Sync Complete") //condition
 		}
-		if singleRun {
+		if syncInterval <= 0 {
 			break
 		}
 		time.Sleep(syncInterval)
@@ -503,8 +1035,18 @@ func (g *gitOpsService) SyncDBToGit(ctx context.Context, config GitConfig) error
 		}
 
 	}
-	// push experiments with experiment_id added
-	if newExperiments {
+	// open a pull request for the experiments with experiment_id added, the configured branch keeps its latest commit
+	if newExperiments && config.PullRequestMode() {
+		pullRequestCtx := ctx
+		if pullRequestCtx == nil {
+			pullRequestCtx = backgroundContext
+		}
+		pullRequest, err := config.GitPullRequest(pullRequestCtx, GitUserFromContext(ctx), pullRequestBranch("new-experiments"), "Updated New Experiments", nil)
+		if err != nil {
+			return errors.New("Cannot open pull request for experiments : " + err.Error())
+		}
+		logrus.Info("Opened pull request for new experiments : ", pullRequest.URL)
+	} else if newExperiments {
 		latestCommit, err = config.GitCommit(GitUserFromContext(ctx), "Updated New Experiments", nil)
 		if err != nil {
 			return errors.New("Cannot commit experiments to git : " + err.Error())
@@ -515,7 +1057,7 @@ func (g *gitOpsService) SyncDBToGit(ctx context.Context, config GitConfig) error
 		}
 	}
 
-	query := bson.D{{"project_id", config.ProjectID}}
+	query := gitops.GitConfigQuery(config.ProjectID, config.ConfigID)
 	update := bson.D{{"$set", bson.D{{"latest_commit", latestCommit}}}}
 
 	if ctx == nil {
@@ -545,6 +1087,10 @@ func (g *gitOpsService) createExperiment(data, file string, config GitConfig) (b
 	if fileName != wfName {
 		return false, errors.New("file name doesn't match experiment name")
 	}
+	err := g.checkInfraSynced(config, infraID)
+	if err != nil {
+		return false, err
+	}
 	experiment := model.ChaosExperimentRequest{
 		ExperimentID:          nil,
 		ExperimentManifest:    data,
@@ -577,6 +1123,16 @@ func (g *gitOpsService) createExperiment(data, file string, config GitConfig) (b
 		return false, errors.New("Cannot write experiment to git : " + err.Error())
 	}
 
+	// the configured branch keeps the pushed file until the pull request adding the experiment_id is merged
+	gitManifest := input.ExperimentManifest
+	if config.PullRequestMode() {
+		gitManifest = data
+	}
+	err = g.recordGitSync(backgroundContext, config.ProjectID, *input.ExperimentID, input.ExperimentManifest, gitManifest)
+	if err != nil {
+		return false, errors.New("Failed to update experiment git sync : " + err.Error())
+	}
+
 	return true, nil
 }
 
@@ -595,8 +1151,15 @@ func (g *gitOpsService) updateExperiment(data, wfID, file string, config GitConf
 	if fileName != wfName {
 		return errors.New("file name doesn't match experiment name")
 	}
+	err := g.checkInfraSynced(config, infraID)
+	if err != nil {
+		return err
+	}
 
-	experiment, err := g.chaosExperimentOps.GetExperiments(bson.D{{"experiment_id", wfID}, {"project_id", config.ProjectID}, {"isRemoved", false}})
+	experiment, err := g.chaosExperimentOps.GetExperiments(bson.D{{"experiment_id", wfID}, {"project_id", config.ProjectID}, {"is_removed", false}})
+	if err != nil {
+		return err
+	}
 	if len(experiment) == 0 {
 		return errors.New("No such experiment found : " + wfID)
 	}
@@ -606,15 +1169,37 @@ func (g *gitOpsService) updateExperiment(data, wfID, file string, config GitConf
 		return nil
 	}
 
+	// the DB isn't overwritten if the experiment has been changed in ChaosCenter too since the last sync, experiments
+	// which have never been synced are still taken from git
+	status, err := experimentDriftStatus(experiment[0], []byte(data))
+	if err != nil {
+		return err
+	}
+	switch status {
+	case model.GitOpsDriftStatusInSync:
+		return g.recordGitSync(backgroundContext, config.ProjectID, wfID, latestManifest(experiment[0]), data)
+	case model.GitOpsDriftStatusDbChanged:
+		return nil
+	case model.GitOpsDriftStatusConflict:
+		if experiment[0].GitSync != nil {
+			return ErrDriftConflict
+		}
+	}
+
+	return g.applyExperimentFromGit(data, experiment[0], config)
+}
+
+// applyExperimentFromGit updates the experiment in the DB with the manifest of the repository
+func (g *gitOpsService) applyExperimentFromGit(data string, experiment chaos_experiment.ChaosExperimentRequest, config GitConfig) error {
 	experimentData := model.ChaosExperimentRequest{
-		ExperimentID:          &experiment[0].ExperimentID,
+		ExperimentID:          &experiment.ExperimentID,
 		ExperimentManifest:    data,
-		CronSyntax:            experiment[0].CronSyntax,
-		ExperimentName:        wfName,
-		ExperimentDescription: experiment[0].Description,
+		CronSyntax:            experiment.CronSyntax,
+		ExperimentName:        gjson.Get(data, "metadata.name").String(),
+		ExperimentDescription: experiment.Description,
 		Weightages:            nil,
-		IsCustomExperiment:    experiment[0].IsCustomExperiment,
-		InfraID:               experiment[0].InfraID,
+		IsCustomExperiment:    experiment.IsCustomExperiment,
+		InfraID:               experiment.InfraID,
 	}
 
 	revID := ""
@@ -623,7 +1208,30 @@ func (g *gitOpsService) updateExperiment(data, wfID, file string, config GitConf
 	if err != nil {
 		return err
 	}
-	return g.chaosExperimentService.ProcessExperimentUpdate(input, "git-ops", wfType, revID, updateRevision, config.ProjectID, data_store.Store)
+	err = g.chaosExperimentService.ProcessExperimentUpdate(backgroundContext, input, "git-ops", wfType, revID, updateRevision, config.ProjectID, data_store.Store)
+	if err != nil {
+		return err
+	}
+
+	return g.recordGitSync(backgroundContext, config.ProjectID, experiment.ExperimentID, input.ExperimentManifest, data)
+}
+
+// checkInfraSynced returns an error if the experiments of the infra aren't synced by the git config, the experiments
+// of an infra are synced with the repository of a single config of the project
+func (g *gitOpsService) checkInfraSynced(config GitConfig, infraID string) error {
+	configs, err := g.gitOpsOperator.GetGitConfigs(backgroundContext, config.ProjectID)
+	if err != nil {
+		return err
+	}
+	infra, err := g.chaosInfraOps.GetInfra(infraID)
+	if err != nil {
+		return errors.New("Cannot get infra from DB : " + err.Error())
+	}
+	if infra.ProjectID != config.ProjectID || !SyncedByGitConfig(configs, config.ConfigID, infra.InfraID, infra.EnvironmentID) {
+		return errors.New("experiments of infra " + infraID + " aren't synced by the git config")
+	}
+
+	return nil
 }
 
 // deleteExperiment helps in deleting a experiment from DB during the SyncDBToGit operation
@@ -631,11 +1239,143 @@ func (g *gitOpsService) deleteExperiment(file string, config GitConfig) error {
 	_, fileName := filepath.Split(file)
 	fileName = strings.Replace(fileName, ".yaml", "", -1)
 
-	query := bson.D{{"experiment_name", fileName}, {"project_id", config.ProjectID}}
-	experiment, err := g.chaosExperimentOps.GetExperiment(context.Background(), query)
+	experiments, err := g.chaosExperimentOps.GetExperiments(bson.D{
+		{"experiment_name", fileName},
+		{"project_id", config.ProjectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return err
+	}
+
+	// only the experiments of the infras synced by the config are deleted, the other configs of the project may sync
+	// experiments with the same name
+	for _, experiment := range experiments {
+		if err := g.checkInfraSynced(config, experiment.InfraID); err != nil {
+			logrus.Info("Skipping deletion of experiment : ", experiment.ExperimentID, " | ", err.Error())
+			continue
+		}
+		query := bson.D{{"experiment_id", experiment.ExperimentID}, {"project_id", config.ProjectID}}
+		err = g.chaosExperimentService.ProcessExperimentDelete(backgroundContext, query, experiment, "git-ops", data_store.Store)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pendingReview returns the pending review of an experiment changed by the given pull request
+func pendingReview(pullRequest PullRequest) chaos_experiment.PendingReview {
+	return chaos_experiment.PendingReview{
+		Number:   pullRequest.Number,
+		URL:      pullRequest.URL,
+		Branch:   pullRequest.Branch,
+		Head:     pullRequest.Head,
+		OpenedAt: time.Now().UnixMilli(),
+	}
+}
+
+// recordGitSync stores the checksums of the manifests of the experiment in the DB and in the repository as the base of
+// the next drift checks
+func (g *gitOpsService) recordGitSync(ctx context.Context, projectID, experimentID, dbManifest, gitManifest string) error {
+	dbChecksum, err := ManifestChecksum([]byte(dbManifest))
 	if err != nil {
 		return err
 	}
+	gitChecksum, err := ManifestChecksum([]byte(gitManifest))
+	if err != nil {
+		return err
+	}
+
+	return g.chaosExperimentOps.UpdateChaosExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+	}, bson.D{{"$set", bson.D{{"git_sync", chaos_experiment.GitSync{
+		DBChecksum:  dbChecksum,
+		GitChecksum: gitChecksum,
+		SyncedAt:    time.Now().UnixMilli(),
+	}}}}, {"$unset", bson.D{{"git_sync_error", ""}}}})
+}
+
+// readExperimentFile returns the manifest of the experiment in the local repository, nil if the file doesn't exist
+func readExperimentFile(config GitConfig, experimentName string) ([]byte, error) {
+	experimentPath := config.LocalPath + "/" + config.ExperimentsPath() + "/" + experimentName + ".yaml"
+	exists, err := PathExists(experimentPath)
+	if err != nil {
+		return nil, errors.New("Error checking file in local repo : " + experimentPath + " | " + err.Error())
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(experimentPath)
+	if err != nil {
+		return nil, errors.New("Error reading data from git file : " + experimentPath + " | " + err.Error())
+	}
+
+	return data, nil
+}
+
+// latestManifest returns the manifest of the latest revision of the experiment
+func latestManifest(experiment chaos_experiment.ChaosExperimentRequest) string {
+	if len(experiment.Revision) == 0 {
+		return ""
+	}
+
+	return experiment.Revision[len(experiment.Revision)-1].ExperimentManifest
+}
+
+// experimentDriftStatus returns the drift status of the experiment against the given manifest of the repository, nil
+// for an experiment missing in the repository
+func experimentDriftStatus(experiment chaos_experiment.ChaosExperimentRequest, gitManifest []byte) (model.GitOpsDriftStatus, error) {
+	var base, current ManifestChecksums
+	if experiment.GitSync != nil {
+		base = ManifestChecksums{DB: experiment.GitSync.DBChecksum, Git: experiment.GitSync.GitChecksum}
+	}
+
+	var err error
+	current.DB, err = ManifestChecksum([]byte(latestManifest(experiment)))
+	if err != nil {
+		return "", err
+	}
+	if gitManifest != nil {
+		current.Git, err = ManifestChecksum(gitManifest)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return GetDriftStatus(base, current), nil
+}
+
+// experimentDrift returns the drift of the experiment against the local repository
+func experimentDrift(config GitConfig, experiment chaos_experiment.ChaosExperimentRequest) (*model.GitOpsDrift, error) {
+	gitManifest, err := readExperimentFile(config, experiment.Name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := experimentDriftStatus(experiment, gitManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	drift := model.GitOpsDrift{
+		ExperimentID:   experiment.ExperimentID,
+		ExperimentName: experiment.Name,
+		Status:         status,
+	}
+	if experiment.GitSync != nil {
+		syncedAt := strconv.FormatInt(experiment.GitSync.SyncedAt, 10)
+		drift.LastSyncedAt = &syncedAt
+	}
+	if gitManifest != nil && status != model.GitOpsDriftStatusInSync {
+		diff, err := ManifestDiff([]byte(latestManifest(experiment)), gitManifest)
+		if err != nil {
+			return nil, err
+		}
+		drift.Diff = &diff
+	}
 
-	return g.chaosExperimentService.ProcessExperimentDelete(query, experiment, "git-ops", data_store.Store)
+	return &drift, nil
 }
@@ -0,0 +1,259 @@
+package gitops
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
+	"github.com/sirupsen/logrus"
+)
+
+// maxWebhookBodySize caps the size of the payloads accepted by the webhook endpoint
+const maxWebhookBodySize = 5 << 20
+
+// queuedSyncs contains the lock keys of the git configs whose sync has been triggered by a webhook and hasn't started
+// yet, so that a burst of pushes triggers a single sync
+var queuedSyncs sync.Map
+
+// WebhookEvent is the provider agnostic content of a webhook payload
+type WebhookEvent struct {
+	Provider model.GitProvider
+	// Push is false for the events other than pushes, e.g. the ping sent when the webhook is created
+	Push   bool
+	Branch string
+	// Repositories contains the keys of the URLs of the pushed repository
+	Repositories []string
+}
+
+type webhookPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"repository"`
+	Project struct {
+		GitHTTPURL string `json:"git_http_url"`
+		GitSSHURL  string `json:"git_ssh_url"`
+		WebURL     string `json:"web_url"`
+	} `json:"project"`
+}
+
+// webhookProvider returns the provider which sent the webhook and the event it notifies, gitea is checked first as it
+// sends the github headers as well
+func webhookProvider(header http.Header) (model.GitProvider, string) {
+	if event := header.Get("X-Gitea-Event"); event != "" {
+		return model.GitProviderGitea, event
+	}
+	if event := header.Get("X-GitHub-Event"); event != "" {
+		return model.GitProviderGithub, event
+	}
+	if event := header.Get("X-Gitlab-Event"); event != "" {
+		return model.GitProviderGitlab, event
+	}
+
+	return "", ""
+}
+
+// ParseWebhook parses the push webhooks of github, gitlab and gitea
+func ParseWebhook(header http.Header, body []byte) (*WebhookEvent, error) {
+	provider, event := webhookProvider(header)
+	if provider == "" {
+		return nil, errors.New("unsupported webhook, the event header of github, gitlab or gitea is missing")
+	}
+
+	webhookEvent := &WebhookEvent{Provider: provider}
+	switch provider {
+	case model.GitProviderGitlab:
+		webhookEvent.Push = event == "Push Hook"
+	default:
+		webhookEvent.Push = event == "push"
+	}
+	if !webhookEvent.Push {
+		return webhookEvent, nil
+	}
+
+	var payload webhookPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, errors.New("invalid webhook payload: " + err.Error())
+	}
+	if !strings.HasPrefix(payload.Ref, "refs/heads/") {
+		// tags don't change the synced branches
+		webhookEvent.Push = false
+		return webhookEvent, nil
+	}
+	webhookEvent.Branch = strings.TrimPrefix(payload.Ref, "refs/heads/")
+
+	urls := []string{payload.Repository.CloneURL, payload.Repository.SSHURL, payload.Repository.HTMLURL}
+	if provider == model.GitProviderGitlab {
+		urls = []string{payload.Project.GitHTTPURL, payload.Project.GitSSHURL, payload.Project.WebURL}
+	}
+	for _, url := range urls {
+		if key := repositoryKey(url); key != "" {
+			webhookEvent.Repositories = append(webhookEvent.Repositories, key)
+		}
+	}
+	if len(webhookEvent.Repositories) == 0 {
+		return nil, errors.New("invalid webhook payload: repository URL is missing")
+	}
+
+	return webhookEvent, nil
+}
+
+// VerifyWebhook returns true if the webhook has been sent with the secret, github and gitea sign the payload with it
+// while gitlab sends it as a token
+func VerifyWebhook(provider model.GitProvider, header http.Header, body []byte, secret string) bool {
+	if secret == "" {
+		return false
+	}
+
+	switch provider {
+	case model.GitProviderGithub:
+		signature := header.Get("X-Hub-Signature-256")
+		if !strings.HasPrefix(signature, "sha256=") {
+			return false
+		}
+		return validSignature(strings.TrimPrefix(signature, "sha256="), body, secret)
+	case model.GitProviderGitea:
+		return validSignature(header.Get("X-Gitea-Signature"), body, secret)
+	case model.GitProviderGitlab:
+		return subtle.ConstantTimeCompare([]byte(header.Get("X-Gitlab-Token")), []byte(secret)) == 1
+	default:
+		return false
+	}
+}
+
+// validSignature checks the hex encoded HMAC-SHA256 signature of the body
+func validSignature(signature string, body []byte, secret string) bool {
+	received, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+
+	return hmac.Equal(received, mac.Sum(nil))
+}
+
+// Matches returns true if the push updated the repository and branch synced by the git config
+func (e *WebhookEvent) Matches(config gitops.GitConfigDB) bool {
+	if !e.Push || e.Branch != config.Branch {
+		return false
+	}
+
+	key := repositoryKey(config.RepositoryURL)
+	for _, repository := range e.Repositories {
+		if key != "" && repository == key {
+			return true
+		}
+	}
+	return false
+}
+
+// RepositoryURLPattern returns the case-insensitive pattern of the http(s), ssh and scp-like URLs of the pushed
+// repositories, so that only the git configs which may match the push are queried
+func (e *WebhookEvent) RepositoryURLPattern() string {
+	patterns := make([]string, 0, len(e.Repositories))
+	for _, key := range e.Repositories {
+		parts := strings.SplitN(key, "/", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		patterns = append(patterns, regexp.QuoteMeta(parts[0])+`(:[0-9]*)?[:/]/*`+regexp.QuoteMeta(parts[1]))
+	}
+
+	return `^([a-z][a-z0-9+.-]*://)?([^@/]*@)?(` + strings.Join(patterns, "|") + `)(\.git)?/*$`
+}
+
+// repositoryKey identifies a repository by its host and path, so that its http(s), ssh and web URLs match
+func repositoryKey(repositoryURL string) string {
+	if repositoryURL == "" {
+		return ""
+	}
+	_, host, path, err := parseRepositoryURL(repositoryURL)
+	if err != nil {
+		return ""
+	}
+	if hostname, _, err := net.SplitHostPort(host); err == nil {
+		host = hostname
+	}
+
+	return strings.ToLower(host + "/" + path)
+}
+
+// WebhookHandler triggers the sync of the git configs updated by the push webhook of github, gitlab or gitea, only
+// the git configs of the pushed repository and branch are fetched and the webhook is verified with the secret of each
+// of them
+func (g *gitOpsService) WebhookHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookBodySize))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		event, err := ParseWebhook(r.Header, body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !event.Push {
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte("event ignored"))
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+		configs, err := g.gitOpsOperator.GetGitConfigsByRepository(ctx, event.Branch, event.RepositoryURLPattern())
+		if err != nil {
+			logrus.Error("Failed to get git configs from db : ", err)
+			http.Error(w, "failed to get git configs", http.StatusInternalServerError)
+			return
+		}
+
+		verified := 0
+		for _, config := range configs {
+			if !event.Matches(config) {
+				continue
+			}
+			if config.WebhookSecret == nil || !VerifyWebhook(event.Provider, r.Header, body, *config.WebhookSecret) {
+				logrus.Warn("Rejected webhook of project : ", config.ProjectID, " | invalid secret")
+				continue
+			}
+			verified++
+			g.triggerGitSync(config)
+		}
+
+		// a push matching no git config is answered like an invalid secret, so that the endpoint doesn't reveal
+		// which repositories and branches are synced
+		if verified == 0 {
+			http.Error(w, "webhook not verified", http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("sync triggered"))
+	})
+}
+
+// triggerGitSync syncs the git config in the background unless a sync of the config is already queued
+func (g *gitOpsService) triggerGitSync(config gitops.GitConfigDB) {
+	if _, queued := queuedSyncs.LoadOrStore(gitConfigLockKey(config.ProjectID, config.ConfigID), struct{}{}); queued {
+		return
+	}
+
+	logrus.Info("Triggering GitOps sync of project : ", config.ProjectID, " from webhook")
+	go g.gitSyncHelper(config, nil)
+}
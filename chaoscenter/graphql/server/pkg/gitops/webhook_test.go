@@ -0,0 +1,233 @@
+package gitops_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testWebhookSecret = "webhook-secret"
+	githubPushPayload = `{"ref":"refs/heads/main","repository":{"clone_url":"https://github.com/litmuschaos/chaos-charts.git",` +
+		`"ssh_url":"git@github.com:litmuschaos/chaos-charts.git","html_url":"https://github.com/litmuschaos/chaos-charts"}}`
+	gitlabPushPayload = `{"ref":"refs/heads/main","project":{"git_http_url":"https://gitlab.com/litmuschaos/chaos-charts.git",` +
+		`"git_ssh_url":"git@gitlab.com:litmuschaos/chaos-charts.git","web_url":"https://gitlab.com/litmuschaos/chaos-charts"}}`
+)
+
+func newWebhookHeader(values map[string]string) http.Header {
+	header := http.Header{}
+	for key, value := range values {
+		header.Set(key, value)
+	}
+	return header
+}
+
+func signWebhook(body string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// TestParseWebhook is used to test the parsing of the push webhooks of the git providers
+func TestParseWebhook(t *testing.T) {
+	tests := []struct {
+		name             string
+		header           http.Header
+		body             string
+		expectedProvider model.GitProvider
+		expectedPush     bool
+		expectedError    bool
+	}{
+		{
+			name:             "github push",
+			header:           newWebhookHeader(map[string]string{"X-GitHub-Event": "push"}),
+			body:             githubPushPayload,
+			expectedProvider: model.GitProviderGithub,
+			expectedPush:     true,
+		},
+		{
+			name:             "gitea push sent with the github headers",
+			header:           newWebhookHeader(map[string]string{"X-GitHub-Event": "push", "X-Gitea-Event": "push"}),
+			body:             githubPushPayload,
+			expectedProvider: model.GitProviderGitea,
+			expectedPush:     true,
+		},
+		{
+			name:             "gitlab push",
+			header:           newWebhookHeader(map[string]string{"X-Gitlab-Event": "Push Hook"}),
+			body:             gitlabPushPayload,
+			expectedProvider: model.GitProviderGitlab,
+			expectedPush:     true,
+		},
+		{
+			name:             "github ping",
+			header:           newWebhookHeader(map[string]string{"X-GitHub-Event": "ping"}),
+			body:             `{"zen":"Keep it logically awesome."}`,
+			expectedProvider: model.GitProviderGithub,
+		},
+		{
+			name:             "tag push",
+			header:           newWebhookHeader(map[string]string{"X-GitHub-Event": "push"}),
+			body:             `{"ref":"refs/tags/v1.0.0"}`,
+			expectedProvider: model.GitProviderGithub,
+		},
+		{
+			name:          "unsupported provider",
+			header:        http.Header{},
+			body:          githubPushPayload,
+			expectedError: true,
+		},
+		{
+			name:          "invalid payload",
+			header:        newWebhookHeader(map[string]string{"X-GitHub-Event": "push"}),
+			body:          `{"ref":`,
+			expectedError: true,
+		},
+		{
+			name:          "repository URL missing",
+			header:        newWebhookHeader(map[string]string{"X-GitHub-Event": "push"}),
+			body:          `{"ref":"refs/heads/main"}`,
+			expectedError: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			event, err := gitops.ParseWebhook(tc.header, []byte(tc.body))
+
+			// then
+			if tc.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedProvider, event.Provider)
+			assert.Equal(t, tc.expectedPush, event.Push)
+			if tc.expectedPush {
+				assert.Equal(t, testBranch, event.Branch)
+			}
+		})
+	}
+}
+
+// TestVerifyWebhook is used to test the verification of the webhooks with the secret of the git config
+func TestVerifyWebhook(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider model.GitProvider
+		header   http.Header
+		secret   string
+		expected bool
+	}{
+		{
+			name:     "valid github signature",
+			provider: model.GitProviderGithub,
+			header:   newWebhookHeader(map[string]string{"X-Hub-Signature-256": "sha256=" + signWebhook(githubPushPayload, testWebhookSecret)}),
+			secret:   testWebhookSecret,
+			expected: true,
+		},
+		{
+			name:     "github signature with another secret",
+			provider: model.GitProviderGithub,
+			header:   newWebhookHeader(map[string]string{"X-Hub-Signature-256": "sha256=" + signWebhook(githubPushPayload, "other")}),
+			secret:   testWebhookSecret,
+		},
+		{
+			name:     "github signature without the algorithm",
+			provider: model.GitProviderGithub,
+			header:   newWebhookHeader(map[string]string{"X-Hub-Signature-256": signWebhook(githubPushPayload, testWebhookSecret)}),
+			secret:   testWebhookSecret,
+		},
+		{
+			name:     "valid gitea signature",
+			provider: model.GitProviderGitea,
+			header:   newWebhookHeader(map[string]string{"X-Gitea-Signature": signWebhook(githubPushPayload, testWebhookSecret)}),
+			secret:   testWebhookSecret,
+			expected: true,
+		},
+		{
+			name:     "valid gitlab token",
+			provider: model.GitProviderGitlab,
+			header:   newWebhookHeader(map[string]string{"X-Gitlab-Token": testWebhookSecret}),
+			secret:   testWebhookSecret,
+			expected: true,
+		},
+		{
+			name:     "invalid gitlab token",
+			provider: model.GitProviderGitlab,
+			header:   newWebhookHeader(map[string]string{"X-Gitlab-Token": "other"}),
+			secret:   testWebhookSecret,
+		},
+		{
+			name:     "git config without secret",
+			provider: model.GitProviderGitlab,
+			header:   newWebhookHeader(map[string]string{"X-Gitlab-Token": ""}),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			verified := gitops.VerifyWebhook(tc.provider, tc.header, []byte(githubPushPayload), tc.secret)
+
+			// then
+			assert.Equal(t, tc.expected, verified)
+		})
+	}
+}
+
+// TestWebhookEventMatches is used to test the matching of the pushed repository and branch with the git configs
+func TestWebhookEventMatches(t *testing.T) {
+	// given
+	header := newWebhookHeader(map[string]string{"X-GitHub-Event": "push"})
+	event, err := gitops.ParseWebhook(header, []byte(githubPushPayload))
+	assert.NoError(t, err)
+
+	sshConfig := newTestGitConfig("ssh", "chaos", "", "")
+	sshConfig.RepositoryURL = "ssh://git@GitHub.com:22/litmuschaos/chaos-charts.git"
+	otherBranchConfig := newTestGitConfig("branch", "chaos", "", "")
+	otherBranchConfig.Branch = "develop"
+	otherRepositoryConfig := newTestGitConfig("repository", "chaos", "", "")
+	otherRepositoryConfig.RepositoryURL = "https://github.com/litmuschaos/litmus.git"
+
+	// then
+	assert.True(t, event.Matches(newTestGitConfig("https", "chaos", "", "")))
+	assert.True(t, event.Matches(sshConfig))
+	assert.False(t, event.Matches(otherBranchConfig))
+	assert.False(t, event.Matches(otherRepositoryConfig))
+}
+
+// TestWebhookEventRepositoryURLPattern is used to test the pattern querying the git configs of the pushed repository
+func TestWebhookEventRepositoryURLPattern(t *testing.T) {
+	// given
+	header := newWebhookHeader(map[string]string{"X-GitHub-Event": "push"})
+	event, err := gitops.ParseWebhook(header, []byte(githubPushPayload))
+	assert.NoError(t, err)
+
+	// when
+	pattern, err := regexp.Compile("(?i)" + event.RepositoryURLPattern())
+
+	// then
+	assert.NoError(t, err)
+	for _, url := range []string{
+		"https://github.com/litmuschaos/chaos-charts.git",
+		"https://github.com/litmuschaos/chaos-charts",
+		"ssh://git@GitHub.com:22/litmuschaos/chaos-charts.git",
+		"git@github.com:litmuschaos/chaos-charts.git",
+	} {
+		assert.True(t, pattern.MatchString(url), url)
+	}
+	for _, url := range []string{
+		"https://github.com/litmuschaos/litmus.git",
+		"https://github.com/litmuschaos/chaos-charts-fork.git",
+		"https://github.com.evil.io/litmuschaos/chaos-charts.git",
+	} {
+		assert.False(t, pattern.MatchString(url), url)
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
 // GetAuthGRPCSvcClient returns an RPC client for Authentication service
 func GetAuthGRPCSvcClient(conn *grpc.ClientConn) (protos.AuthRpcServiceClient, *grpc.ClientConn) {
-	conn, err := grpc.Dial(utils.Config.LitmusAuthGrpcEndpoint+utils.Config.LitmusAuthGrpcPort, grpc.WithBlock(), grpc.WithInsecure())
+	conn, err := grpc.Dial(utils.Config.LitmusAuthGrpcEndpoint+utils.Config.LitmusAuthGrpcPort, grpc.WithBlock(), grpc.WithInsecure(),
+		grpc.WithUnaryInterceptor(otelgrpc.UnaryClientInterceptor()))
 	if err != nil {
 		logrus.Fatalf("did not connect: %s", err)
 	}
@@ -22,15 +24,16 @@ func GetAuthGRPCSvcClient(conn *grpc.ClientConn) (protos.AuthRpcServiceClient, *
 
 // ValidatorGRPCRequest sends a request to Authentication server to ensure
 // user permission over the project
-func ValidatorGRPCRequest(client protos.AuthRpcServiceClient,
-	jwt string, projectID string, requiredRoles []string, invitation string) error {
+func ValidatorGRPCRequest(ctx context.Context, client protos.AuthRpcServiceClient,
+	jwt string, projectID string, requiredRoles []string, permission string, invitation string) error {
 
-	resp, err := client.ValidateRequest(context.Background(),
+	resp, err := client.ValidateRequest(ctx,
 		&protos.ValidationRequest{
 			Jwt:           jwt,
 			ProjectId:     projectID,
 			RequiredRoles: requiredRoles,
 			Invitation:    invitation,
+			Permission:    permission,
 		})
 	if err != nil {
 		return err
@@ -0,0 +1,332 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// refreshTimeout bounds the queries run for a single refresh of the database metrics
+	refreshTimeout = 10 * time.Second
+	// scoreBucketWidth is the width of the buckets of the resiliency score histogram
+	scoreBucketWidth = 10
+	// projectLabel is the label of the project of the database metrics, only set when enabled
+	projectLabel = "project_id"
+)
+
+var (
+	subscriberConnectionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "subscriber_connections"),
+		"Number of chaos infrastructure subscribers connected to this server replica.",
+		nil, nil,
+	)
+	podLogStreamsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "pod_log_streams"),
+		"Number of pod log requests in progress on this server replica.",
+		nil, nil,
+	)
+)
+
+// ScoreBucket is the number of completed experiment runs of a project whose resiliency score
+// is in the bucket ending at UpperBound, and the sum of their scores
+type ScoreBucket struct {
+	ProjectID  string  `bson:"project_id"`
+	UpperBound float64 `bson:"upper_bound"`
+	Count      uint64  `bson:"count"`
+	Sum        float64 `bson:"sum"`
+}
+
+// Collector collects the domain metrics of ChaosCenter from the database and the state of the server. The database
+// metrics are aggregated periodically by Refresh rather than on every scrape, so that scrapes don't load the
+// database, every replica reports the same values for them
+type Collector struct {
+	infraOperator         *dbChaosInfra.Operator
+	experimentRunOperator *dbChaosExperimentRun.Operator
+	state                 *store.StateData
+	// perProject labels the database metrics with their project, which creates series for every project
+	perProject bool
+
+	infrasDesc          *prometheus.Desc
+	experimentRunsDesc  *prometheus.Desc
+	resiliencyScoreDesc *prometheus.Desc
+
+	mutex  sync.RWMutex
+	cached []prometheus.Metric
+}
+
+var _ prometheus.Collector = &Collector{}
+
+// NewCollector returns a new instance of Collector, the database metrics are labelled with their project if
+// perProject is set
+func NewCollector(infraOperator *dbChaosInfra.Operator, experimentRunOperator *dbChaosExperimentRun.Operator, state *store.StateData, perProject bool) *Collector {
+	labels := func(names ...string) []string {
+		if perProject {
+			return append([]string{projectLabel}, names...)
+		}
+		return names
+	}
+
+	return &Collector{
+		infraOperator:         infraOperator,
+		experimentRunOperator: experimentRunOperator,
+		state:                 state,
+		perProject:            perProject,
+		infrasDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "infras"),
+			"Number of registered chaos infrastructures by connection state.",
+			labels("state"), nil,
+		),
+		experimentRunsDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "experiment_runs"),
+			"Number of experiment runs by phase.",
+			labels("phase"), nil,
+		),
+		resiliencyScoreDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "experiment_run_resiliency_score"),
+			"Distribution of the resiliency scores of the completed experiment runs.",
+			labels(), nil,
+		),
+	}
+}
+
+// Describe sends the descriptors of the metrics collected by the collector
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.infrasDesc
+	ch <- subscriberConnectionsDesc
+	ch <- podLogStreamsDesc
+	ch <- c.experimentRunsDesc
+	ch <- c.resiliencyScoreDesc
+}
+
+// Collect sends the current values of the metrics of the server replica and the database metrics of the last refresh
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.state.Mutex.Lock()
+	connections, logStreams := len(c.state.ConnectedInfra), len(c.state.ExperimentLog)
+	c.state.Mutex.Unlock()
+	ch <- prometheus.MustNewConstMetric(subscriberConnectionsDesc, prometheus.GaugeValue, float64(connections))
+	ch <- prometheus.MustNewConstMetric(podLogStreamsDesc, prometheus.GaugeValue, float64(logStreams))
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	for _, metric := range c.cached {
+		ch <- metric
+	}
+}
+
+// Refresh aggregates the database metrics, the metrics whose query failed keep the values of the previous refresh
+func (c *Collector) Refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	c.mutex.RLock()
+	previous := map[*prometheus.Desc][]prometheus.Metric{}
+	for _, metric := range c.cached {
+		previous[metric.Desc()] = append(previous[metric.Desc()], metric)
+	}
+	c.mutex.RUnlock()
+
+	var metrics []prometheus.Metric
+	for _, refresh := range []struct {
+		desc    *prometheus.Desc
+		name    string
+		collect func(context.Context) ([]prometheus.Metric, error)
+	}{
+		{c.infrasDesc, "infra", c.collectInfras},
+		{c.experimentRunsDesc, "experiment run", c.collectExperimentRuns},
+		{c.resiliencyScoreDesc, "resiliency score", c.collectResiliencyScores},
+	} {
+		collected, err := refresh.collect(ctx)
+		if err != nil {
+			logrus.Errorf("failed to collect the %s metrics, error: %v", refresh.name, err)
+			collected = previous[refresh.desc]
+		}
+		metrics = append(metrics, collected...)
+	}
+
+	c.mutex.Lock()
+	c.cached = metrics
+	c.mutex.Unlock()
+}
+
+// RecurringRefresh refreshes the database metrics at the given interval
+func (c *Collector) RecurringRefresh(interval time.Duration) {
+	c.Refresh(context.Background())
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		c.Refresh(context.Background())
+	}
+}
+
+// groupID returns the _id of the $group stage of the aggregations, the project is only part of it when the metrics
+// are labelled with their project
+func (c *Collector) groupID(fields ...bson.E) bson.D {
+	if c.perProject {
+		return append(bson.D{{"project_id", "$project_id"}}, fields...)
+	}
+	return fields
+}
+
+// labelValues prefixes the label values with the project when the metrics are labelled with their project
+func (c *Collector) labelValues(projectID string, values ...string) []string {
+	if c.perProject {
+		return append([]string{projectID}, values...)
+	}
+	return values
+}
+
+func (c *Collector) collectInfras(ctx context.Context) ([]prometheus.Metric, error) {
+	cursor, err := c.infraOperator.GetAggregateInfras(mongo.Pipeline{
+		{{"$match", bson.D{{"is_removed", false}, {"is_registered", true}}}},
+		{{"$group", bson.D{
+			{"_id", c.groupID(bson.E{"is_active", "$is_active"})},
+			{"count", bson.D{{"$sum", 1}}},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		ID struct {
+			ProjectID string `bson:"project_id"`
+			IsActive  bool   `bson:"is_active"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	var metrics []prometheus.Metric
+	for _, result := range results {
+		state := "disconnected"
+		if result.ID.IsActive {
+			state = "connected"
+		}
+		metrics = append(metrics, prometheus.MustNewConstMetric(c.infrasDesc, prometheus.GaugeValue, float64(result.Count),
+			c.labelValues(result.ID.ProjectID, state)...))
+	}
+	return metrics, nil
+}
+
+func (c *Collector) collectExperimentRuns(ctx context.Context) ([]prometheus.Metric, error) {
+	cursor, err := c.experimentRunOperator.GetAggregateExperimentRuns(mongo.Pipeline{
+		{{"$match", bson.D{{"is_removed", false}}}},
+		{{"$group", bson.D{
+			{"_id", c.groupID(bson.E{"phase", "$phase"})},
+			{"count", bson.D{{"$sum", 1}}},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var results []struct {
+		ID struct {
+			ProjectID string `bson:"project_id"`
+			Phase     string `bson:"phase"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	}
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, err
+	}
+	var metrics []prometheus.Metric
+	for _, result := range results {
+		metrics = append(metrics, prometheus.MustNewConstMetric(c.experimentRunsDesc, prometheus.GaugeValue, float64(result.Count),
+			c.labelValues(result.ID.ProjectID, result.ID.Phase)...))
+	}
+	return metrics, nil
+}
+
+func (c *Collector) collectResiliencyScores(ctx context.Context) ([]prometheus.Metric, error) {
+	// scores are grouped in buckets of scoreBucketWidth, a score equal to a bound belongs to the bucket ending at it
+	upperBound := bson.D{{"$multiply", bson.A{
+		bson.D{{"$ceil", bson.D{{"$divide", bson.A{"$resiliency_score", scoreBucketWidth}}}}},
+		scoreBucketWidth,
+	}}}
+	cursor, err := c.experimentRunOperator.GetAggregateExperimentRuns(mongo.Pipeline{
+		{{"$match", bson.D{
+			{"is_removed", false},
+			{"completed", true},
+			{"resiliency_score", bson.D{{"$ne", nil}}},
+		}}},
+		{{"$group", bson.D{
+			{"_id", c.groupID(bson.E{"upper_bound", upperBound})},
+			{"count", bson.D{{"$sum", 1}}},
+			{"sum", bson.D{{"$sum", "$resiliency_score"}}},
+		}}},
+		{{"$project", bson.D{
+			{"_id", 0},
+			{"project_id", "$_id.project_id"},
+			{"upper_bound", "$_id.upper_bound"},
+			{"count", 1},
+			{"sum", 1},
+		}}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buckets []ScoreBucket
+	if err := cursor.All(ctx, &buckets); err != nil {
+		return nil, err
+	}
+	var metrics []prometheus.Metric
+	for projectID, histogram := range ScoreHistograms(buckets) {
+		metrics = append(metrics, prometheus.MustNewConstHistogram(c.resiliencyScoreDesc, histogram.Count, histogram.Sum, histogram.Buckets,
+			c.labelValues(projectID)...))
+	}
+	return metrics, nil
+}
+
+// ScoreHistogram is the cumulative histogram of the resiliency scores of a project
+type ScoreHistogram struct {
+	Count   uint64
+	Sum     float64
+	Buckets map[float64]uint64
+}
+
+// ScoreHistograms converts the buckets of resiliency scores to the cumulative histograms of each project,
+// the histograms have a bucket for every bound from 0 to 100 even if no score falls in it
+func ScoreHistograms(buckets []ScoreBucket) map[string]ScoreHistogram {
+	byProject := map[string][]ScoreBucket{}
+	for _, bucket := range buckets {
+		byProject[bucket.ProjectID] = append(byProject[bucket.ProjectID], bucket)
+	}
+
+	histograms := map[string]ScoreHistogram{}
+	for projectID, projectBuckets := range byProject {
+		sort.Slice(projectBuckets, func(i, j int) bool {
+			return projectBuckets[i].UpperBound < projectBuckets[j].UpperBound
+		})
+
+		histogram := ScoreHistogram{Buckets: map[float64]uint64{}}
+		next := 0
+		for bound := 0; bound <= 100; bound += scoreBucketWidth {
+			for next < len(projectBuckets) && projectBuckets[next].UpperBound <= float64(bound) {
+				histogram.Count += projectBuckets[next].Count
+				histogram.Sum += projectBuckets[next].Sum
+				next++
+			}
+			histogram.Buckets[float64(bound)] = histogram.Count
+		}
+		// scores above 100 only show up in the +Inf bucket
+		for ; next < len(projectBuckets); next++ {
+			histogram.Count += projectBuckets[next].Count
+			histogram.Sum += projectBuckets[next].Sum
+		}
+		histograms[projectID] = histogram
+	}
+	return histograms
+}
@@ -0,0 +1,135 @@
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// aggregateOperator returns the aggregated infras, experiment runs and resiliency scores of a project
+type aggregateOperator struct {
+	mongodb.MongoOperator
+	aggregations int
+}
+
+func (o *aggregateOperator) Aggregate(ctx context.Context, collectionType int, pipeline interface{}, opts ...*options.AggregateOptions) (*mongo.Cursor, error) {
+	o.aggregations++
+	var documents []interface{}
+	switch {
+	case collectionType == mongodb.ChaosInfraCollection:
+		documents = []interface{}{bson.M{"_id": bson.M{"project_id": "p1", "is_active": true}, "count": 2}}
+	case len(pipeline.(mongo.Pipeline)) == 3:
+		documents = []interface{}{bson.M{"project_id": "p1", "upper_bound": 80, "count": 1, "sum": 75}}
+	default:
+		documents = []interface{}{bson.M{"_id": bson.M{"project_id": "p1", "phase": "Completed"}, "count": 3}}
+	}
+	return mongo.NewCursorFromDocuments(documents, nil, nil)
+}
+
+// TestScoreHistograms tests the conversion of resiliency score buckets to cumulative histograms
+func TestScoreHistograms(t *testing.T) {
+	// given
+	buckets := []metrics.ScoreBucket{
+		{ProjectID: "p1", UpperBound: 100, Count: 2, Sum: 195},
+		{ProjectID: "p1", UpperBound: 0, Count: 1, Sum: 0},
+		{ProjectID: "p1", UpperBound: 50, Count: 3, Sum: 140},
+		{ProjectID: "p2", UpperBound: 80, Count: 1, Sum: 75},
+	}
+
+	// when
+	histograms := metrics.ScoreHistograms(buckets)
+
+	// then
+	assert.Len(t, histograms, 2)
+	p1 := histograms["p1"]
+	assert.Equal(t, uint64(6), p1.Count)
+	assert.Equal(t, float64(335), p1.Sum)
+	assert.Len(t, p1.Buckets, 11)
+	assert.Equal(t, uint64(1), p1.Buckets[0])
+	assert.Equal(t, uint64(1), p1.Buckets[40])
+	assert.Equal(t, uint64(4), p1.Buckets[50])
+	assert.Equal(t, uint64(4), p1.Buckets[90])
+	assert.Equal(t, uint64(6), p1.Buckets[100])
+
+	p2 := histograms["p2"]
+	assert.Equal(t, uint64(1), p2.Count)
+	assert.Equal(t, uint64(0), p2.Buckets[70])
+	assert.Equal(t, uint64(1), p2.Buckets[80])
+}
+
+// TestOutcome tests the outcome label of the sync metrics
+func TestOutcome(t *testing.T) {
+	// given
+	err := errors.New("sync failed")
+
+	// when
+	success, failure := metrics.Outcome(nil), metrics.Outcome(err)
+
+	// then
+	assert.Equal(t, "success", success)
+	assert.Equal(t, "failure", failure)
+}
+
+// TestCollectorRefresh tests that the database metrics are only aggregated on refresh and only labelled with their
+// project when enabled
+func TestCollectorRefresh(t *testing.T) {
+	tests := []struct {
+		name           string
+		perProject     bool
+		expectedLabels []string
+	}{
+		{
+			name:           "without project labels",
+			expectedLabels: []string{"state"},
+		},
+		{
+			name:           "with project labels",
+			perProject:     true,
+			expectedLabels: []string{"project_id", "state"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			operator := &aggregateOperator{}
+			mongodb.Operator = operator
+			collector := metrics.NewCollector(dbChaosInfra.NewInfrastructureOperator(operator),
+				dbChaosExperimentRun.NewChaosExperimentRunOperator(operator), store.NewStore(), tc.perProject)
+			registry := prometheus.NewRegistry()
+			registry.MustRegister(collector)
+
+			// when
+			collector.Refresh(context.Background())
+			_, err := registry.Gather()
+			assert.NoError(t, err)
+			families, err := registry.Gather()
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, 3, operator.aggregations)
+			var infras []string
+			for _, family := range families {
+				if family.GetName() != "litmus_infras" {
+					continue
+				}
+				assert.Len(t, family.GetMetric(), 1)
+				assert.Equal(t, float64(2), family.GetMetric()[0].GetGauge().GetValue())
+				for _, label := range family.GetMetric()[0].GetLabel() {
+					infras = append(infras, label.GetName())
+				}
+			}
+			assert.Equal(t, tc.expectedLabels, infras)
+		})
+	}
+}
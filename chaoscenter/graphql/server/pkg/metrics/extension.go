@@ -0,0 +1,43 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Extension is a GraphQL server extension recording the latency and errors of the root field resolvers
+type Extension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = Extension{}
+
+// ExtensionName returns the name of the extension
+func (e Extension) ExtensionName() string {
+	return "Metrics"
+}
+
+// Validate validates the executable schema of the server
+func (e Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptField records the latency and error of the root fields, nested fields are resolved as part of
+// their root field so they aren't recorded to keep the cardinality of the metrics bounded
+func (e Extension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Parent != nil {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	ResolverDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		ResolverErrors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+	return res, err
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// namespace prefixes every metric exposed by the GraphQL server
+const namespace = "litmus"
+
+// Outcomes of the operations recorded by the metrics
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+var (
+	// ResolverDuration records the latency of the root fields of the GraphQL operations
+	ResolverDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "resolver_duration_seconds",
+		Help:      "Latency of the GraphQL root field resolvers.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"object", "field"})
+
+	// ResolverErrors counts the root fields of the GraphQL operations resolved with an error
+	ResolverErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "resolver_errors_total",
+		Help:      "Number of GraphQL root field resolvers which returned an error.",
+	}, []string{"object", "field"})
+
+	// HubSyncs counts the syncs of the chaos hubs by hub type and outcome
+	HubSyncs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "chaos_hub",
+		Name:      "syncs_total",
+		Help:      "Number of chaos hub syncs by hub type and outcome.",
+	}, []string{"hub_type", "outcome"})
+
+	// GitOpsSyncDuration records the latency of the syncs of the GitOps repositories with the database
+	GitOpsSyncDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "gitops",
+		Name:      "sync_duration_seconds",
+		Help:      "Latency of the syncs of the GitOps repositories by outcome.",
+		Buckets:   []float64{0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+	}, []string{"outcome"})
+)
+
+func init() {
+	prometheus.MustRegister(ResolverDuration, ResolverErrors, HubSyncs, GitOpsSyncDuration)
+}
+
+// Outcome returns the outcome label of an operation which returned the error
+func Outcome(err error) string {
+	if err != nil {
+		return OutcomeFailure
+	}
+	return OutcomeSuccess
+}
+
+// ObserveHubSync records the outcome of a chaos hub sync
+func ObserveHubSync(hubType string, err error) {
+	HubSyncs.WithLabelValues(hubType, Outcome(err)).Inc()
+}
+
+// ObserveGitOpsSync records the latency and outcome of a GitOps repository sync started at the given time
+func ObserveGitOpsSync(start time.Time, err error) {
+	GitOpsSyncDuration.WithLabelValues(Outcome(err)).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,280 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+)
+
+const (
+	// SignatureHeader carries the HMAC-SHA256 signature of the payloads sent to signed webhooks
+	SignatureHeader = "X-Litmus-Signature"
+	// EventIDHeader carries the ID of the event, so that receivers can deduplicate the retried deliveries
+	EventIDHeader = "X-Litmus-Event-ID"
+	// webhookTimeout bounds every request sent to a webhook
+	webhookTimeout = 10 * time.Second
+)
+
+// Driver sends the notifications of an event to a type of channel
+type Driver interface {
+	Send(ctx context.Context, channel dbNotification.Channel, event Event) error
+}
+
+// drivers are the channel drivers indexed by channel type, new channel types register their driver here
+var drivers = map[model.NotificationChannelType]Driver{
+	model.NotificationChannelTypeWebhook: webhookDriver{},
+	model.NotificationChannelTypeSLACk:   slackDriver{},
+	model.NotificationChannelTypeTeams:   teamsDriver{},
+	model.NotificationChannelTypeEmail:   emailDriver{},
+}
+
+// ErrPrivateDestination is returned for the webhooks of the loopback, link-local and private networks, which
+// would let the users of a project reach the internal services of the control plane
+var ErrPrivateDestination = errors.New("webhook url resolves to a loopback, link-local or private address")
+
+// PublicHTTPClient dials the destinations set by the users directly, without the proxy of the environment, so that the
+// checked address is the one of the receiver, the check is done on every dial to cover the redirects and the DNS
+// changes since the destination was validated
+var PublicHTTPClient = &http.Client{
+	Timeout: webhookTimeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: webhookTimeout,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				if !publicIP(net.ParseIP(host)) {
+					return ErrPrivateDestination
+				}
+				return nil
+			},
+		}).DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   webhookTimeout,
+		ExpectContinueTimeout: time.Second,
+	},
+}
+
+// publicIP returns false for the addresses which aren't reachable on the internet
+func publicIP(ip net.IP) bool {
+	return ip != nil && !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() && !ip.IsPrivate() && !ip.IsUnspecified()
+}
+
+// ValidateDestination returns ErrPrivateDestination if the host of the webhook url resolves to an address which
+// isn't public
+func ValidateDestination(ctx context.Context, webhookURL *url.URL) error {
+	host := webhookURL.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !publicIP(ip) {
+			return ErrPrivateDestination
+		}
+		return nil
+	}
+
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("cannot resolve the host of the webhook url: %w", err)
+	}
+	for _, address := range addresses {
+		if !publicIP(address.IP) {
+			return ErrPrivateDestination
+		}
+	}
+	return nil
+}
+
+// Sign returns the signature of the payload sent in the signature header, in the sha256=<hex HMAC> format
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Summary returns the human readable summary of the event sent to the chat and email channels
+func Summary(eventType model.NotificationEventType, event Event) string {
+	switch eventType {
+	case model.NotificationEventTypeExperimentRunStarted:
+		return fmt.Sprintf("Experiment %s started a new run %s", event.ExperimentName, event.ExperimentRunID)
+	case model.NotificationEventTypeExperimentRunCompleted:
+		return fmt.Sprintf("Experiment run %s of %s completed with a resiliency score of %s", event.ExperimentRunID, event.ExperimentName, formatScore(event.ResiliencyScore))
+	case model.NotificationEventTypeExperimentRunFailed:
+		return fmt.Sprintf("Experiment run %s of %s ended in phase %s with a resiliency score of %s", event.ExperimentRunID, event.ExperimentName, event.Phase, formatScore(event.ResiliencyScore))
+	case model.NotificationEventTypeResiliencyScoreBelowThreshold:
+		return fmt.Sprintf("Resiliency score of experiment run %s of %s dropped to %s", event.ExperimentRunID, event.ExperimentName, formatScore(event.ResiliencyScore))
+	case model.NotificationEventTypeInfraConnected:
+		return fmt.Sprintf("Chaos infrastructure %s connected", event.InfraName)
+	case model.NotificationEventTypeInfraDisconnected:
+		return fmt.Sprintf("Chaos infrastructure %s disconnected", event.InfraName)
+	}
+	return event.Message
+}
+
+func formatScore(score *float64) string {
+	if score == nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.2f", *score)
+}
+
+// postJSON posts the payload to the webhook, responses with a non 2xx status are returned as errors
+func postJSON(ctx context.Context, url string, payload []byte, headers map[string]string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := PublicHTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("webhook responded with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// webhookDriver posts the event as JSON, signed with the secret of the channel if set
+type webhookDriver struct{}
+
+func (webhookDriver) Send(ctx context.Context, channel dbNotification.Channel, event Event) error {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	headers := map[string]string{EventIDHeader: event.EventID}
+	if channel.Secret != "" {
+		headers[SignatureHeader] = Sign(channel.Secret, payload)
+	}
+	return postJSON(ctx, channel.URL, payload, headers)
+}
+
+// slackDriver posts the summary of the event to a Slack compatible incoming webhook
+type slackDriver struct{}
+
+func (slackDriver) Send(ctx context.Context, channel dbNotification.Channel, event Event) error {
+	payload, err := json.Marshal(map[string]string{
+		"text": "*[LitmusChaos]* " + Summary(event.Type, event),
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, channel.URL, payload, nil)
+}
+
+// teamsDriver posts the event as a message card to a Microsoft Teams incoming webhook
+type teamsDriver struct{}
+
+func (teamsDriver) Send(ctx context.Context, channel dbNotification.Channel, event Event) error {
+	facts := []map[string]string{{"name": "Event", "value": event.Type.String()}}
+	if event.ExperimentName != "" {
+		facts = append(facts, map[string]string{"name": "Experiment", "value": event.ExperimentName})
+	}
+	if event.Phase != "" {
+		facts = append(facts, map[string]string{"name": "Phase", "value": event.Phase})
+	}
+	if event.ResiliencyScore != nil {
+		facts = append(facts, map[string]string{"name": "Resiliency Score", "value": formatScore(event.ResiliencyScore)})
+	}
+	if event.InfraName != "" {
+		facts = append(facts, map[string]string{"name": "Chaos Infrastructure", "value": event.InfraName})
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"@type":    "MessageCard",
+		"@context": "https://schema.org/extensions",
+		"summary":  Summary(event.Type, event),
+		"title":    "LitmusChaos",
+		"sections": []map[string]interface{}{
+			{
+				"activityTitle": Summary(event.Type, event),
+				"facts":         facts,
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return postJSON(ctx, channel.URL, payload, nil)
+}
+
+// emailDriver sends the summary of the event to the recipients through the SMTP server of the control plane
+type emailDriver struct{}
+
+func (emailDriver) Send(ctx context.Context, channel dbNotification.Channel, event Event) error {
+	if utils.Config.SmtpHost == "" || utils.Config.SmtpFrom == "" {
+		return errors.New("smtp server is not configured")
+	}
+
+	message, err := EmailMessage(utils.Config.SmtpFrom, channel.Recipients, event)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if utils.Config.SmtpUsername != "" {
+		auth = smtp.PlainAuth("", utils.Config.SmtpUsername, utils.Config.SmtpPassword, utils.Config.SmtpHost)
+	}
+
+	// smtp.SendMail doesn't accept a context, the send is abandoned once the context is done
+	done := make(chan error, 1)
+	go func() {
+		done <- smtp.SendMail(net.JoinHostPort(utils.Config.SmtpHost, utils.Config.SmtpPort), auth, utils.Config.SmtpFrom, channel.Recipients, []byte(message))
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// headerReplacer removes the line breaks from the values of the email headers, so that the names of the experiments
+// and infras can't inject headers
+var headerReplacer = strings.NewReplacer("\r", "", "\n", "")
+
+// EmailMessage returns the email sent to the recipients for the event, with the summary of the event as subject
+func EmailMessage(from string, recipients []string, event Event) (string, error) {
+	summary := Summary(event.Type, event)
+	body, err := json.MarshalIndent(event, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return "From: " + headerReplacer.Replace(from) + "\r\n" +
+		"To: " + headerReplacer.Replace(strings.Join(recipients, ", ")) + "\r\n" +
+		"Subject: [LitmusChaos] " + headerReplacer.Replace(summary) + "\r\n" +
+		"MIME-Version: 1.0\r\n" +
+		"Content-Type: text/plain; charset=UTF-8\r\n" +
+		"\r\n" +
+		summary + "\r\n\r\n" + string(body) + "\r\n", nil
+}
@@ -0,0 +1,98 @@
+package notification_test
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestValidateDestination is used to test that the webhooks of the internal networks are rejected
+func TestValidateDestination(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		expectedError error
+	}{
+		{
+			name: "public address",
+			url:  "https://8.8.8.8/hooks/litmus",
+		},
+		{
+			name:          "loopback address",
+			url:           "http://127.0.0.1:8080/query",
+			expectedError: notification.ErrPrivateDestination,
+		},
+		{
+			name:          "loopback hostname",
+			url:           "http://localhost:8080/query",
+			expectedError: notification.ErrPrivateDestination,
+		},
+		{
+			name:          "ipv6 loopback address",
+			url:           "http://[::1]/query",
+			expectedError: notification.ErrPrivateDestination,
+		},
+		{
+			name:          "ipv4 mapped loopback address",
+			url:           "http://[::ffff:127.0.0.1]/query",
+			expectedError: notification.ErrPrivateDestination,
+		},
+		{
+			name:          "link-local metadata address",
+			url:           "http://169.254.169.254/latest/meta-data",
+			expectedError: notification.ErrPrivateDestination,
+		},
+		{
+			name:          "private address",
+			url:           "https://10.0.12.4/hooks",
+			expectedError: notification.ErrPrivateDestination,
+		},
+		{
+			name:          "unspecified address",
+			url:           "http://0.0.0.0:9002",
+			expectedError: notification.ErrPrivateDestination,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			webhookURL, err := url.Parse(tc.url)
+			assert.NoError(t, err)
+
+			// when
+			err = notification.ValidateDestination(context.Background(), webhookURL)
+
+			// then
+			assert.Equal(t, tc.expectedError, err)
+		})
+	}
+}
+
+// TestEmailMessage is used to test that the names of the experiments can't inject headers in the emails
+func TestEmailMessage(t *testing.T) {
+	// given
+	event := notification.Event{
+		Type:            model.NotificationEventTypeExperimentRunStarted,
+		ExperimentName:  "pod-delete\r\nBcc: attacker@example.com",
+		ExperimentRunID: "run",
+	}
+
+	// when
+	message, err := notification.EmailMessage("litmus@example.com", []string{"sre@example.com"}, event)
+
+	// then
+	assert.NoError(t, err)
+	headers := strings.SplitN(message, "\r\n\r\n", 2)[0]
+	assert.Equal(t, []string{
+		"From: litmus@example.com",
+		"To: sre@example.com",
+		"Subject: [LitmusChaos] Experiment pod-deleteBcc: attacker@example.com started a new run run",
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}, strings.Split(headers, "\r\n"))
+}
@@ -0,0 +1,75 @@
+package notification
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
+)
+
+// Event is a run lifecycle or infra event notified to the channels of the matching rules
+type Event struct {
+	EventID         string                      `json:"eventID"`
+	Type            model.NotificationEventType `json:"type"`
+	ProjectID       string                      `json:"projectID"`
+	ExperimentID    string                      `json:"experimentID,omitempty"`
+	ExperimentName  string                      `json:"experimentName,omitempty"`
+	ExperimentRunID string                      `json:"experimentRunID,omitempty"`
+	Phase           string                      `json:"phase,omitempty"`
+	ResiliencyScore *float64                    `json:"resiliencyScore,omitempty"`
+	Tags            []string                    `json:"tags,omitempty"`
+	EnvironmentID   string                      `json:"environmentID,omitempty"`
+	InfraID         string                      `json:"infraID,omitempty"`
+	InfraName       string                      `json:"infraName,omitempty"`
+	Message         string                      `json:"message"`
+	Timestamp       int64                       `json:"timestamp"`
+}
+
+// MatchRule returns the type of the event notified by the rule, if the rule matches the event.
+// Completed and failed runs are notified as RESILIENCY_SCORE_BELOW_THRESHOLD to the rules subscribed
+// to it when their resiliency score is below the threshold of the rule and the rule isn't subscribed
+// to the event itself. The experiment filter only applies to the events of experiment runs.
+func MatchRule(rule dbNotification.Rule, event Event) (model.NotificationEventType, bool) {
+	if !rule.Enabled || rule.ProjectID != event.ProjectID {
+		return "", false
+	}
+
+	if len(rule.ExperimentIDs) > 0 && event.ExperimentID != "" && !contains(rule.ExperimentIDs, event.ExperimentID) {
+		return "", false
+	}
+	if len(rule.EnvironmentIDs) > 0 && !contains(rule.EnvironmentIDs, event.EnvironmentID) {
+		return "", false
+	}
+	if len(rule.Tags) > 0 && !containsAny(rule.Tags, event.Tags) {
+		return "", false
+	}
+
+	if contains(rule.EventTypes, event.Type.String()) {
+		return event.Type, true
+	}
+
+	isCompletion := event.Type == model.NotificationEventTypeExperimentRunCompleted || event.Type == model.NotificationEventTypeExperimentRunFailed
+	if isCompletion && rule.ScoreThreshold != nil && event.ResiliencyScore != nil &&
+		*event.ResiliencyScore < *rule.ScoreThreshold &&
+		contains(rule.EventTypes, model.NotificationEventTypeResiliencyScoreBelowThreshold.String()) {
+		return model.NotificationEventTypeResiliencyScoreBelowThreshold, true
+	}
+
+	return "", false
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(values []string, candidates []string) bool {
+	for _, candidate := range candidates {
+		if contains(values, candidate) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,120 @@
+package notification_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
+	"github.com/stretchr/testify/assert"
+)
+
+func newRule(eventTypes ...model.NotificationEventType) dbNotification.Rule {
+	rule := dbNotification.Rule{
+		ProjectID: "project",
+		Enabled:   true,
+	}
+	for _, eventType := range eventTypes {
+		rule.EventTypes = append(rule.EventTypes, eventType.String())
+	}
+	return rule
+}
+
+// TestMatchRuleFilters is used to test that the experiment, environment and tag filters of the rules are applied
+func TestMatchRuleFilters(t *testing.T) {
+	// given
+	event := notification.Event{
+		Type:          model.NotificationEventTypeExperimentRunFailed,
+		ProjectID:     "project",
+		ExperimentID:  "experiment",
+		EnvironmentID: "prod",
+		Tags:          []string{"payments", "nightly"},
+	}
+	matching := newRule(model.NotificationEventTypeExperimentRunFailed)
+	matching.ExperimentIDs = []string{"experiment"}
+	matching.EnvironmentIDs = []string{"prod"}
+	matching.Tags = []string{"nightly"}
+
+	otherExperiment := newRule(model.NotificationEventTypeExperimentRunFailed)
+	otherExperiment.ExperimentIDs = []string{"other"}
+	otherEnvironment := newRule(model.NotificationEventTypeExperimentRunFailed)
+	otherEnvironment.EnvironmentIDs = []string{"staging"}
+	otherTag := newRule(model.NotificationEventTypeExperimentRunFailed)
+	otherTag.Tags = []string{"checkout"}
+	disabled := newRule(model.NotificationEventTypeExperimentRunFailed)
+	disabled.Enabled = false
+	otherType := newRule(model.NotificationEventTypeExperimentRunStarted)
+
+	// when
+	eventType, ok := notification.MatchRule(matching, event)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, model.NotificationEventTypeExperimentRunFailed, eventType)
+	for _, rule := range []dbNotification.Rule{otherExperiment, otherEnvironment, otherTag, disabled, otherType} {
+		_, ok := notification.MatchRule(rule, event)
+		assert.False(t, ok)
+	}
+}
+
+// TestMatchRuleScoreThreshold is used to test that completed runs scoring below the threshold are notified as such
+func TestMatchRuleScoreThreshold(t *testing.T) {
+	// given
+	threshold := 80.0
+	rule := newRule(model.NotificationEventTypeResiliencyScoreBelowThreshold)
+	rule.ScoreThreshold = &threshold
+	low, high := 62.5, 90.0
+	event := notification.Event{
+		Type:            model.NotificationEventTypeExperimentRunCompleted,
+		ProjectID:       "project",
+		ResiliencyScore: &low,
+	}
+
+	// when
+	eventType, ok := notification.MatchRule(rule, event)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, model.NotificationEventTypeResiliencyScoreBelowThreshold, eventType)
+
+	event.ResiliencyScore = &high
+	_, ok = notification.MatchRule(rule, event)
+	assert.False(t, ok)
+}
+
+// TestMatchRuleInfraEvents is used to test that the experiment filter doesn't apply to infra events
+func TestMatchRuleInfraEvents(t *testing.T) {
+	// given
+	rule := newRule(model.NotificationEventTypeInfraDisconnected)
+	rule.ExperimentIDs = []string{"experiment"}
+	event := notification.Event{
+		Type:      model.NotificationEventTypeInfraDisconnected,
+		ProjectID: "project",
+		InfraID:   "infra",
+	}
+
+	// when
+	eventType, ok := notification.MatchRule(rule, event)
+
+	// then
+	assert.True(t, ok)
+	assert.Equal(t, model.NotificationEventTypeInfraDisconnected, eventType)
+}
+
+// TestSign is used to test the HMAC-SHA256 signature of the webhook payloads
+func TestSign(t *testing.T) {
+	// when
+	signature := notification.Sign("It's a Secret to Everybody", []byte("Hello, World!"))
+
+	// then
+	assert.Equal(t, "sha256=757107ea0eb2509fc211221cce984b8a37570b6d7586c22c46f4379c8b043e17", signature)
+}
+
+// TestRetryBackoff is used to test that the retry backoff doubles after every attempt up to its cap
+func TestRetryBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, notification.RetryBackoff(1))
+	assert.Equal(t, time.Minute, notification.RetryBackoff(2))
+	assert.Equal(t, 4*time.Minute, notification.RetryBackoff(4))
+	assert.Equal(t, time.Hour, notification.RetryBackoff(20))
+}
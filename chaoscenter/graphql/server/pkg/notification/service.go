@@ -0,0 +1,596 @@
+package notification
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/mail"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// MaxDeliveryAttempts is the number of attempts after which a delivery is marked as failed
+	MaxDeliveryAttempts = 5
+	// deliveryInterval is the interval at which the pending deliveries are sent
+	deliveryInterval = 10 * time.Second
+	// deliveryLease is the time a claimed delivery is hidden from the other replicas while it is being sent
+	deliveryLease = time.Minute
+	// baseRetryBackoff is the delay before the first retry, doubled after every failed attempt
+	baseRetryBackoff = 30 * time.Second
+	// maxRetryBackoff caps the delay between two attempts
+	maxRetryBackoff = time.Hour
+	// deliveryBatchSize is the number of due deliveries sent at every interval
+	deliveryBatchSize = 100
+)
+
+// deliverySignal wakes the delivery worker up as soon as new deliveries are logged
+var deliverySignal = make(chan struct{}, 1)
+
+// Service is the interface for the notification service
+type Service interface {
+	CreateNotificationRule(ctx context.Context, projectID string, request model.NotificationRuleRequest) (*model.NotificationRule, error)
+	UpdateNotificationRule(ctx context.Context, projectID string, ruleID string, request model.NotificationRuleRequest) (*model.NotificationRule, error)
+	DeleteNotificationRule(ctx context.Context, projectID string, ruleID string) (bool, error)
+	ListNotificationRules(ctx context.Context, projectID string) ([]*model.NotificationRule, error)
+	ListNotificationDeliveries(ctx context.Context, projectID string, request *model.ListNotificationDeliveriesRequest) (*model.ListNotificationDeliveriesResponse, error)
+	RetryNotificationDelivery(ctx context.Context, projectID string, deliveryID string) (*model.NotificationDelivery, error)
+	Notify(ctx context.Context, event Event)
+	SendPendingDeliveries(ctx context.Context) error
+	RecurringDelivery()
+}
+
+// notificationService is the implementation of Service interface
+type notificationService struct {
+	notificationOperator *dbNotification.Operator
+}
+
+// NewNotificationService returns a new instance of notificationService
+func NewNotificationService(notificationOperator *dbNotification.Operator) Service {
+	return &notificationService{
+		notificationOperator: notificationOperator,
+	}
+}
+
+// CreateNotificationRule creates a new notification rule for the project
+func (n *notificationService) CreateNotificationRule(ctx context.Context, projectID string, request model.NotificationRuleRequest) (*model.NotificationRule, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := newRule(ctx, projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	currentTime := time.Now().UnixMilli()
+	rule.RuleID = uuid.New().String()
+	rule.Audit = mongodb.Audit{
+		CreatedAt: currentTime,
+		UpdatedAt: currentTime,
+		CreatedBy: username,
+		UpdatedBy: username,
+		IsRemoved: false,
+	}
+
+	err = n.notificationOperator.InsertRule(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModel(rule), nil
+}
+
+// UpdateNotificationRule replaces the definition of the notification rule, the secret of the channel is kept if not set
+func (n *notificationService) UpdateNotificationRule(ctx context.Context, projectID string, ruleID string, request model.NotificationRuleRequest) (*model.NotificationRule, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	query := bson.D{
+		{"rule_id", ruleID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	existing, err := n.notificationOperator.GetRule(ctx, query)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no notification rule found with ruleID: " + ruleID)
+		}
+		return nil, err
+	}
+
+	rule, err := newRule(ctx, projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	if request.Channel.Secret == nil && rule.Channel.Type == existing.Channel.Type {
+		rule.Channel.Secret = existing.Channel.Secret
+	}
+	rule.RuleID = existing.RuleID
+	rule.Audit = existing.Audit
+	rule.UpdatedAt = time.Now().UnixMilli()
+	rule.UpdatedBy = username
+
+	update := bson.D{
+		{"$set", bson.D{
+			{"name", rule.Name},
+			{"enabled", rule.Enabled},
+			{"event_types", rule.EventTypes},
+			{"experiment_ids", rule.ExperimentIDs},
+			{"environment_ids", rule.EnvironmentIDs},
+			{"tags", rule.Tags},
+			{"score_threshold", rule.ScoreThreshold},
+			{"channel", rule.Channel},
+			{"updated_at", rule.UpdatedAt},
+			{"updated_by", rule.UpdatedBy},
+		}},
+	}
+	err = n.notificationOperator.UpdateRule(ctx, query, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return toModel(rule), nil
+}
+
+// DeleteNotificationRule removes the notification rule, its pending deliveries are still sent
+func (n *notificationService) DeleteNotificationRule(ctx context.Context, projectID string, ruleID string) (bool, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return false, err
+	}
+
+	query := bson.D{
+		{"rule_id", ruleID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	if _, err := n.notificationOperator.GetRule(ctx, query); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return false, errors.New("no notification rule found with ruleID: " + ruleID)
+		}
+		return false, err
+	}
+
+	update := bson.D{
+		{"$set", bson.D{
+			{"is_removed", true},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", username},
+		}},
+	}
+	err = n.notificationOperator.UpdateRule(ctx, query, update)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// ListNotificationRules returns the notification rules of the project
+func (n *notificationService) ListNotificationRules(ctx context.Context, projectID string) ([]*model.NotificationRule, error) {
+	rules, err := n.notificationOperator.ListRules(ctx, bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.NotificationRule{}
+	for _, rule := range rules {
+		result = append(result, toModel(rule))
+	}
+	return result, nil
+}
+
+// ListNotificationDeliveries returns the paginated notification deliveries of the project, latest first
+func (n *notificationService) ListNotificationDeliveries(ctx context.Context, projectID string, request *model.ListNotificationDeliveriesRequest) (*model.ListNotificationDeliveriesResponse, error) {
+	query := bson.D{{"project_id", projectID}}
+	var skip, limit int64
+	if request != nil {
+		if request.RuleID != nil && *request.RuleID != "" {
+			query = append(query, bson.E{"rule_id", *request.RuleID})
+		}
+		if request.Status != nil {
+			query = append(query, bson.E{"status", request.Status.String()})
+		}
+		if request.Pagination != nil {
+			skip = int64(request.Pagination.Page * request.Pagination.Limit)
+			limit = int64(request.Pagination.Limit)
+		}
+	}
+
+	total, err := n.notificationOperator.CountDeliveries(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, err := n.notificationOperator.ListDeliveries(ctx, query, skip, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.ListNotificationDeliveriesResponse{
+		TotalNoOfDeliveries: int(total),
+		Deliveries:          []*model.NotificationDelivery{},
+	}
+	for _, delivery := range deliveries {
+		response.Deliveries = append(response.Deliveries, toDeliveryModel(delivery))
+	}
+	return response, nil
+}
+
+// RetryNotificationDelivery schedules a failed notification delivery to be sent again with a fresh set of attempts
+func (n *notificationService) RetryNotificationDelivery(ctx context.Context, projectID string, deliveryID string) (*model.NotificationDelivery, error) {
+	query := bson.D{
+		{"delivery_id", deliveryID},
+		{"project_id", projectID},
+	}
+	delivery, err := n.notificationOperator.GetDelivery(ctx, query)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, errors.New("no notification delivery found with deliveryID: " + deliveryID)
+		}
+		return nil, err
+	}
+	if delivery.Status != dbNotification.DeliveryFailed {
+		return nil, errors.New("only failed notification deliveries can be retried")
+	}
+
+	currentTime := time.Now().UnixMilli()
+	delivery.Status = dbNotification.DeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = currentTime
+	delivery.UpdatedAt = currentTime
+	_, err = n.notificationOperator.UpdateDelivery(ctx, append(query, bson.E{"status", dbNotification.DeliveryFailed}), bson.D{
+		{"$set", bson.D{
+			{"status", delivery.Status},
+			{"attempts", delivery.Attempts},
+			{"next_attempt_at", delivery.NextAttemptAt},
+			{"updated_at", delivery.UpdatedAt},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signalDelivery()
+	return toDeliveryModel(delivery), nil
+}
+
+// Notify logs a pending delivery of the event for every rule of the project matching it, the deliveries
+// are sent in the background so that the event sources aren't slowed down by the channels
+func (n *notificationService) Notify(ctx context.Context, event Event) {
+	logFields := logrus.Fields{
+		"projectId": event.ProjectID,
+		"eventType": event.Type,
+	}
+
+	if event.EventID == "" {
+		event.EventID = uuid.New().String()
+	}
+	if event.Timestamp == 0 {
+		event.Timestamp = time.Now().UnixMilli()
+	}
+
+	rules, err := n.notificationOperator.ListRules(ctx, bson.D{
+		{"project_id", event.ProjectID},
+		{"enabled", true},
+		{"is_removed", false},
+	})
+	if err != nil {
+		logrus.WithFields(logFields).Errorf("failed to list the notification rules, error: %v", err)
+		return
+	}
+
+	currentTime := time.Now().UnixMilli()
+	var deliveries []dbNotification.Delivery
+	for _, rule := range rules {
+		eventType, ok := MatchRule(rule, event)
+		if !ok {
+			continue
+		}
+
+		ruleEvent := event
+		ruleEvent.Type = eventType
+		payload, err := json.Marshal(ruleEvent)
+		if err != nil {
+			logrus.WithFields(logFields).Errorf("failed to marshal the notification event, error: %v", err)
+			return
+		}
+		deliveries = append(deliveries, dbNotification.Delivery{
+			DeliveryID:    uuid.New().String(),
+			ProjectID:     rule.ProjectID,
+			RuleID:        rule.RuleID,
+			EventID:       event.EventID,
+			EventType:     eventType.String(),
+			ChannelType:   rule.Channel.Type,
+			Payload:       string(payload),
+			Status:        dbNotification.DeliveryPending,
+			NextAttemptAt: currentTime,
+			CreatedAt:     currentTime,
+			UpdatedAt:     currentTime,
+		})
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	if err := n.notificationOperator.InsertDeliveries(ctx, deliveries); err != nil {
+		logrus.WithFields(logFields).Errorf("failed to log the notification deliveries, error: %v", err)
+		return
+	}
+	signalDelivery()
+}
+
+// SendPendingDeliveries sends the pending deliveries which are due, every delivery is claimed before it is
+// sent so that replicas sending the deliveries concurrently don't send it twice
+func (n *notificationService) SendPendingDeliveries(ctx context.Context) error {
+	currentTime := time.Now().UnixMilli()
+	deliveries, err := n.notificationOperator.ListDeliveries(ctx, bson.D{
+		{"status", dbNotification.DeliveryPending},
+		{"next_attempt_at", bson.D{{"$lte", currentTime}}},
+	}, 0, deliveryBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for _, delivery := range deliveries {
+		claimed, err := n.notificationOperator.UpdateDelivery(ctx, bson.D{
+			{"delivery_id", delivery.DeliveryID},
+			{"status", dbNotification.DeliveryPending},
+			{"next_attempt_at", delivery.NextAttemptAt},
+		}, bson.D{{"$set", bson.D{{"next_attempt_at", time.Now().Add(deliveryLease).UnixMilli()}}}})
+		if err != nil {
+			return err
+		}
+		if !claimed {
+			continue
+		}
+		n.send(ctx, delivery)
+	}
+	return nil
+}
+
+// send sends the delivery to the channel of its rule and records the outcome of the attempt
+func (n *notificationService) send(ctx context.Context, delivery dbNotification.Delivery) {
+	logFields := logrus.Fields{
+		"projectId":  delivery.ProjectID,
+		"ruleId":     delivery.RuleID,
+		"deliveryId": delivery.DeliveryID,
+	}
+
+	err := n.deliver(ctx, delivery)
+	attempts := delivery.Attempts + 1
+	currentTime := time.Now()
+
+	set := bson.D{
+		{"attempts", attempts},
+		{"updated_at", currentTime.UnixMilli()},
+	}
+	switch {
+	case err == nil:
+		set = append(set, bson.E{"status", dbNotification.DeliveryDelivered}, bson.E{"last_error", ""})
+	case attempts >= MaxDeliveryAttempts:
+		logrus.WithFields(logFields).Errorf("notification delivery failed after %d attempts, error: %v", attempts, err)
+		set = append(set, bson.E{"status", dbNotification.DeliveryFailed}, bson.E{"last_error", err.Error()})
+	default:
+		logrus.WithFields(logFields).Warnf("notification delivery attempt %d failed, error: %v", attempts, err)
+		set = append(set,
+			bson.E{"last_error", err.Error()},
+			bson.E{"next_attempt_at", currentTime.Add(RetryBackoff(attempts)).UnixMilli()},
+		)
+	}
+
+	_, err = n.notificationOperator.UpdateDelivery(ctx, bson.D{{"delivery_id", delivery.DeliveryID}}, bson.D{{"$set", set}})
+	if err != nil {
+		logrus.WithFields(logFields).Errorf("failed to record the notification delivery attempt, error: %v", err)
+	}
+}
+
+// deliver sends the event of the delivery with the driver of the channel of its rule
+func (n *notificationService) deliver(ctx context.Context, delivery dbNotification.Delivery) error {
+	rule, err := n.notificationOperator.GetRule(ctx, bson.D{{"rule_id", delivery.RuleID}})
+	if err != nil {
+		return err
+	}
+	driver, ok := drivers[model.NotificationChannelType(rule.Channel.Type)]
+	if !ok {
+		return errors.New("unsupported notification channel type " + rule.Channel.Type)
+	}
+
+	var event Event
+	if err := json.Unmarshal([]byte(delivery.Payload), &event); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+	defer cancel()
+	return driver.Send(ctx, rule.Channel, event)
+}
+
+// RecurringDelivery sends the pending deliveries periodically and whenever new deliveries are logged
+func (n *notificationService) RecurringDelivery() {
+	ticker := time.NewTicker(deliveryInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-deliverySignal:
+		}
+		if err := n.SendPendingDeliveries(context.Background()); err != nil {
+			logrus.Errorf("failed to send the pending notification deliveries, error: %v", err)
+		}
+	}
+}
+
+// RetryBackoff returns the delay before the next attempt of a delivery which failed the given number of attempts
+func RetryBackoff(attempts int) time.Duration {
+	backoff := baseRetryBackoff
+	for i := 1; i < attempts && backoff < maxRetryBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxRetryBackoff {
+		backoff = maxRetryBackoff
+	}
+	return backoff
+}
+
+func signalDelivery() {
+	select {
+	case deliverySignal <- struct{}{}:
+	default:
+	}
+}
+
+// newRule validates the request and converts it to a notification rule of the project
+func newRule(ctx context.Context, projectID string, request model.NotificationRuleRequest) (dbNotification.Rule, error) {
+	if strings.TrimSpace(request.Name) == "" {
+		return dbNotification.Rule{}, errors.New("name of the notification rule is required")
+	}
+	if len(request.EventTypes) == 0 {
+		return dbNotification.Rule{}, errors.New("at least one event type is required")
+	}
+
+	rule := dbNotification.Rule{
+		ProjectID:      projectID,
+		Name:           request.Name,
+		Enabled:        request.Enabled == nil || *request.Enabled,
+		ExperimentIDs:  request.ExperimentIDs,
+		EnvironmentIDs: request.EnvironmentIDs,
+		Tags:           request.Tags,
+		ScoreThreshold: request.ScoreThreshold,
+	}
+	for _, eventType := range request.EventTypes {
+		if eventType == model.NotificationEventTypeResiliencyScoreBelowThreshold && request.ScoreThreshold == nil {
+			return dbNotification.Rule{}, errors.New("score threshold is required for " + eventType.String() + " events")
+		}
+		rule.EventTypes = append(rule.EventTypes, eventType.String())
+	}
+	if request.ScoreThreshold != nil && (*request.ScoreThreshold < 0 || *request.ScoreThreshold > 100) {
+		return dbNotification.Rule{}, errors.New("score threshold must be between 0 and 100")
+	}
+
+	channel, err := newChannel(ctx, request.Channel)
+	if err != nil {
+		return dbNotification.Rule{}, err
+	}
+	rule.Channel = channel
+	return rule, nil
+}
+
+// newChannel validates the destination of the channel, the webhooks of the internal networks are rejected
+func newChannel(ctx context.Context, input *model.NotificationChannelInput) (dbNotification.Channel, error) {
+	if input == nil {
+		return dbNotification.Channel{}, errors.New("channel of the notification rule is required")
+	}
+	channel := dbNotification.Channel{Type: input.Type.String()}
+
+	if input.Type == model.NotificationChannelTypeEmail {
+		if len(input.Recipients) == 0 {
+			return dbNotification.Channel{}, errors.New("at least one recipient is required for email channels")
+		}
+		for _, recipient := range input.Recipients {
+			if _, err := mail.ParseAddress(recipient); err != nil {
+				return dbNotification.Channel{}, errors.New("invalid recipient " + recipient)
+			}
+		}
+		channel.Recipients = input.Recipients
+		return channel, nil
+	}
+
+	if input.URL == nil || *input.URL == "" {
+		return dbNotification.Channel{}, errors.New("url is required for " + input.Type.String() + " channels")
+	}
+	webhookURL, err := url.Parse(*input.URL)
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return dbNotification.Channel{}, errors.New("invalid webhook url " + *input.URL)
+	}
+	if err := ValidateDestination(ctx, webhookURL); err != nil {
+		return dbNotification.Channel{}, err
+	}
+	channel.URL = *input.URL
+	if input.Secret != nil {
+		channel.Secret = *input.Secret
+	}
+	return channel, nil
+}
+
+// toModel converts the notification rule to its GraphQL model, the secret of the channel is never returned
+func toModel(rule dbNotification.Rule) *model.NotificationRule {
+	eventTypes := make([]model.NotificationEventType, 0, len(rule.EventTypes))
+	for _, eventType := range rule.EventTypes {
+		eventTypes = append(eventTypes, model.NotificationEventType(eventType))
+	}
+
+	channel := &model.NotificationChannel{
+		Type:       model.NotificationChannelType(rule.Channel.Type),
+		Recipients: rule.Channel.Recipients,
+		IsSigned:   rule.Channel.Secret != "",
+	}
+	if rule.Channel.URL != "" {
+		webhookURL := rule.Channel.URL
+		channel.URL = &webhookURL
+	}
+
+	var (
+		createdAt = strconv.FormatInt(rule.CreatedAt, 10)
+		updatedAt = strconv.FormatInt(rule.UpdatedAt, 10)
+	)
+	return &model.NotificationRule{
+		RuleID:         rule.RuleID,
+		ProjectID:      rule.ProjectID,
+		Name:           rule.Name,
+		Enabled:        rule.Enabled,
+		EventTypes:     eventTypes,
+		ExperimentIDs:  rule.ExperimentIDs,
+		EnvironmentIDs: rule.EnvironmentIDs,
+		Tags:           rule.Tags,
+		ScoreThreshold: rule.ScoreThreshold,
+		Channel:        channel,
+		CreatedAt:      &createdAt,
+		UpdatedAt:      &updatedAt,
+		CreatedBy:      &model.UserDetails{Username: rule.CreatedBy},
+		UpdatedBy:      &model.UserDetails{Username: rule.UpdatedBy},
+	}
+}
+
+// toDeliveryModel converts the notification delivery to its GraphQL model
+func toDeliveryModel(delivery dbNotification.Delivery) *model.NotificationDelivery {
+	result := &model.NotificationDelivery{
+		DeliveryID:  delivery.DeliveryID,
+		RuleID:      delivery.RuleID,
+		EventID:     delivery.EventID,
+		EventType:   model.NotificationEventType(delivery.EventType),
+		ChannelType: model.NotificationChannelType(delivery.ChannelType),
+		Status:      model.NotificationDeliveryStatus(delivery.Status),
+		Attempts:    delivery.Attempts,
+		CreatedAt:   strconv.FormatInt(delivery.CreatedAt, 10),
+		UpdatedAt:   strconv.FormatInt(delivery.UpdatedAt, 10),
+	}
+	if delivery.LastError != "" {
+		lastError := delivery.LastError
+		result.LastError = &lastError
+	}
+	if delivery.Status == dbNotification.DeliveryPending {
+		nextAttemptAt := strconv.FormatInt(delivery.NextAttemptAt, 10)
+		result.NextAttemptAt = &nextAttemptAt
+	}
+	return result
+}
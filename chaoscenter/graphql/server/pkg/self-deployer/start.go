@@ -8,6 +8,8 @@ import (
 	chaos_infra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
 
 	"log"
 	"strings"
@@ -63,7 +65,8 @@ func StartDeployer(projectID string, mongoOp mongodb.MongoOperator) {
 	}
 
 	infraOps := chaos_infrastructure.NewInfrastructureOperator(mongoOp)
-	infrastructureService := chaos_infra.NewChaosInfrastructureService(infraOps)
+	notificationService := notification.NewNotificationService(dbNotification.NewNotificationOperator(mongoOp))
+	infrastructureService := chaos_infra.NewChaosInfrastructureService(infraOps, notificationService)
 	resp, err := infrastructureService.RegisterInfra(context.Background(), projectID, infraInput)
 	if err != nil {
 		log.Print("SELF CLUSTER REG FAILED[DB-REG] : ", err)
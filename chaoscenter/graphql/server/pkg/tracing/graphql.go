@@ -0,0 +1,76 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Extension is a GraphQL server extension tracing the queries and mutations along with their root field resolvers
+type Extension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Extension{}
+
+// ExtensionName returns the name of the extension
+func (e Extension) ExtensionName() string {
+	return "Tracing"
+}
+
+// Validate validates the executable schema of the server
+func (e Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse traces the execution of the queries and mutations, subscriptions respond once per event
+// for as long as they are open so only their resolvers are traced
+func (e Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	oc := graphql.GetOperationContext(ctx)
+	if oc.Operation == nil || oc.Operation.Operation == ast.Subscription {
+		return next(ctx)
+	}
+
+	name := string(oc.Operation.Operation)
+	if oc.OperationName != "" {
+		name += " " + oc.OperationName
+	}
+	ctx, span := Tracer().Start(ctx, name, trace.WithAttributes(
+		attribute.String("graphql.operation.type", string(oc.Operation.Operation)),
+		attribute.String("graphql.operation.name", oc.OperationName),
+	))
+	defer span.End()
+
+	resp := next(ctx)
+	if resp != nil && len(resp.Errors) > 0 {
+		span.SetStatus(codes.Error, resp.Errors.Error())
+	}
+	return resp
+}
+
+// InterceptField traces the root field resolvers, nested fields are resolved as part of their root field
+func (e Extension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || fc.Parent != nil {
+		return next(ctx)
+	}
+
+	ctx, span := Tracer().Start(ctx, fc.Object+"."+fc.Field.Name, trace.WithAttributes(
+		attribute.String("graphql.field.object", fc.Object),
+		attribute.String("graphql.field.name", fc.Field.Name),
+	))
+	defer span.End()
+
+	res, err := next(ctx)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return res, err
+}
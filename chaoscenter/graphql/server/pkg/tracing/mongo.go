@@ -0,0 +1,80 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/event"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// mongoCommandKey identifies a command among the in-flight commands of all the connections
+type mongoCommandKey struct {
+	connectionID string
+	requestID    int64
+}
+
+// mongoMonitor traces the commands sent to Mongo
+type mongoMonitor struct {
+	spans sync.Map
+}
+
+// NewMongoMonitor returns the command monitor tracing the commands sent to Mongo as part of a traced request,
+// the commands of the background loops and change streams aren't traced to keep the traces readable
+func NewMongoMonitor() *event.CommandMonitor {
+	m := &mongoMonitor{}
+	return &event.CommandMonitor{
+		Started:   m.started,
+		Succeeded: m.succeeded,
+		Failed:    m.failed,
+	}
+}
+
+func (m *mongoMonitor) started(ctx context.Context, evt *event.CommandStartedEvent) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+
+	name := evt.CommandName
+	attributes := []attribute.KeyValue{
+		semconv.DBSystemMongoDB,
+		semconv.DBNameKey.String(evt.DatabaseName),
+		semconv.DBOperationKey.String(evt.CommandName),
+	}
+	// the collection is the value of the command name for the commands operating on a collection
+	if collection, ok := evt.Command.Lookup(evt.CommandName).StringValueOK(); ok {
+		name = collection + "." + evt.CommandName
+		attributes = append(attributes, semconv.DBMongoDBCollectionKey.String(collection))
+	}
+
+	_, span := Tracer().Start(ctx, name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+	m.spans.Store(mongoCommandKey{connectionID: evt.ConnectionID, requestID: evt.RequestID}, span)
+}
+
+func (m *mongoMonitor) succeeded(ctx context.Context, evt *event.CommandSucceededEvent) {
+	if span, ok := m.end(evt.CommandFinishedEvent); ok {
+		span.End()
+	}
+}
+
+func (m *mongoMonitor) failed(ctx context.Context, evt *event.CommandFailedEvent) {
+	if span, ok := m.end(evt.CommandFinishedEvent); ok {
+		span.SetStatus(codes.Error, evt.Failure)
+		span.End()
+	}
+}
+
+// end removes the span of the finished command from the in-flight commands
+func (m *mongoMonitor) end(evt event.CommandFinishedEvent) (trace.Span, bool) {
+	span, ok := m.spans.LoadAndDelete(mongoCommandKey{connectionID: evt.ConnectionID, requestID: evt.RequestID})
+	if !ok {
+		return nil, false
+	}
+	return span.(trace.Span), true
+}
@@ -0,0 +1,83 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ServiceName is the name of the server in the exported spans unless OTEL_SERVICE_NAME is set
+	ServiceName         = "litmus-graphql-server"
+	instrumentationName = "github.com/litmuschaos/litmus/chaoscenter/graphql/server"
+)
+
+// InitTracer registers the global tracer provider and propagator of the server and returns the function flushing
+// the spans on shutdown, the spans are only exported when an OTLP endpoint is configured, the trace context of the
+// callers is propagated either way
+func InitTracer(ctx context.Context) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if utils.Config.OtelExporterOtlpEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	// the exporter reads the endpoint, headers and TLS settings from the standard OTEL_EXPORTER_OTLP_* variables
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceNameKey.String(ServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the server
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// ActionTraceContext returns the trace context to be carried in the actions sent to the chaos infrastructures,
+// so the operations of the subscriber continue the trace of the request which triggered them
+func ActionTraceContext(ctx context.Context) *string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(carrier)
+	if err != nil {
+		return nil
+	}
+	traceContext := string(data)
+	return &traceContext
+}
+
+// ContextFromTraceContext returns the context continuing the trace carried by the given trace context
+func ContextFromTraceContext(ctx context.Context, traceContext string) context.Context {
+	carrier := propagation.MapCarrier{}
+	if err := json.Unmarshal([]byte(traceContext), &carrier); err != nil {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
@@ -0,0 +1,54 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestActionTraceContext tests the trace context carried in the actions sent to the chaos infrastructures
+func TestActionTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	t.Run("without a span", func(t *testing.T) {
+		// when
+		traceContext := tracing.ActionTraceContext(context.Background())
+
+		// then
+		assert.Nil(t, traceContext)
+	})
+
+	t.Run("round trip of a span context", func(t *testing.T) {
+		// given
+		spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+			SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+			TraceFlags: trace.FlagsSampled,
+		})
+		ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+		// when
+		traceContext := tracing.ActionTraceContext(ctx)
+		restored := trace.SpanContextFromContext(tracing.ContextFromTraceContext(context.Background(), *traceContext))
+
+		// then
+		assert.NotNil(t, traceContext)
+		assert.Equal(t, spanContext.TraceID(), restored.TraceID())
+		assert.Equal(t, spanContext.SpanID(), restored.SpanID())
+		assert.True(t, restored.IsSampled())
+		assert.True(t, restored.IsRemote())
+	})
+
+	t.Run("invalid trace context", func(t *testing.T) {
+		// when
+		ctx := tracing.ContextFromTraceContext(context.Background(), "not a trace context")
+
+		// then
+		assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+	})
+}
@@ -1,17 +1,29 @@
 package main
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub"
 	handler2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub/handler"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
 	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
+	dbGitOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/projects"
 
 	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"runtime"
+	"syscall"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
@@ -27,10 +39,22 @@ import (
 	"github.com/gorilla/websocket"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
+	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbNotification "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/notification"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/pubsub"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/handlers"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/notification"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 	pb "github.com/litmuschaos/litmus/chaoscenter/graphql/server/protos"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -51,6 +75,7 @@ func setupGin() *gin.Engine {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 	router.Use(middleware.DefaultStructuredLogger())
+	router.Use(middleware.Tracing())
 	router.Use(gin.Recovery())
 	router.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"*"},
@@ -62,8 +87,13 @@ func setupGin() *gin.Engine {
 }
 
 func main() {
+	shutdownTracer, err := tracing.InitTracer(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+	go flushTracerOnSignal(shutdownTracer)
+
 	router := setupGin()
-	var err error
 	mongodb.MgoClient, err = mongodb.MongoConnection()
 	if err != nil {
 		log.Fatal(err)
@@ -74,6 +104,21 @@ func main() {
 	var mongodbOperator mongodb.MongoOperator = mongodb.NewMongoOperations(mongoClient)
 	mongodb.Operator = mongodbOperator
 
+	// share the subscription state with the other replicas when the server is horizontally scaled
+	switch utils.Config.PubsubBroker {
+	case data_store.MongoDBBroker:
+		data_store.Store.Broker = data_store.NewMongoBroker(pubsub.NewPubSubOperator(mongodbOperator))
+		go func() {
+			err := data_store.Store.ListenBroker(context.Background())
+			if err != nil {
+				log.Error(err.Error())
+			}
+		}()
+	case data_store.LocalBroker:
+	default:
+		log.Fatalf("unsupported pubsub broker %s", utils.Config.PubsubBroker)
+	}
+
 	go startGRPCServer(utils.Config.RpcPort, mongodbOperator) // start GRPC serve
 
 	srv := handler.New(generated.NewExecutableSchema(graph.NewConfig(mongodbOperator)))
@@ -91,6 +136,60 @@ func main() {
 	// to be removed in production
 	srv.Use(extension.Introspection{})
 
+	// record an audit event for every mutation performed by a user
+	auditOperator := dbAudit.NewAuditOperator(mongodbOperator)
+	srv.Use(audit.NewRecorder(auditOperator))
+
+	// trace the queries and mutations along with their resolvers
+	srv.Use(tracing.Extension{})
+
+	// record the latency and errors of the graphql resolvers and expose the domain state as prometheus metrics,
+	// the metrics are served on their own port which isn't exposed outside the cluster
+	srv.Use(metrics.Extension{})
+	metricsRefreshInterval, err := time.ParseDuration(utils.Config.MetricsRefreshInterval)
+	if err != nil {
+		log.Fatalf("invalid metrics refresh interval %s", utils.Config.MetricsRefreshInterval)
+	}
+	metricsProjectLabels, err := strconv.ParseBool(utils.Config.MetricsProjectLabels)
+	if err != nil {
+		log.Fatalf("invalid metrics project labels %s", utils.Config.MetricsProjectLabels)
+	}
+	collector := metrics.NewCollector(dbChaosInfra.NewInfrastructureOperator(mongodbOperator),
+		dbChaosExperimentRun.NewChaosExperimentRunOperator(mongodbOperator), data_store.Store, metricsProjectLabels)
+	prometheus.MustRegister(collector)
+	go collector.RecurringRefresh(metricsRefreshInterval)
+	go startMetricsServer(utils.Config.MetricsPort)
+
+	// mark the infras which stopped sending heartbeats as inactive
+	heartbeatTimeout, err := time.ParseDuration(utils.Config.InfraHeartbeatTimeout)
+	if err != nil {
+		log.Fatalf("invalid infra heartbeat timeout %s", utils.Config.InfraHeartbeatTimeout)
+	}
+	notificationService := notification.NewNotificationService(dbNotification.NewNotificationOperator(mongodbOperator))
+	infraService := chaos_infrastructure.NewChaosInfrastructureService(dbChaosInfra.NewInfrastructureOperator(mongodbOperator), notificationService)
+	go infraService.RecurringInactiveInfraSweep(heartbeatTimeout, *data_store.Store)
+
+	// send the notifications of the run lifecycle and infra events to the channels of the notification rules
+	go notificationService.RecurringDelivery()
+
+	podLogMaxBytes, err := strconv.Atoi(utils.Config.PodLogMaxBytes)
+	if err != nil || podLogMaxBytes <= 0 {
+		log.Fatalf("invalid pod log max bytes %s", utils.Config.PodLogMaxBytes)
+	}
+	data_store.Store.PodLogMaxBytes = podLogMaxBytes
+
+	// sync the gitops repos on their push webhooks and poll them as a fallback
+	gitopsSyncInterval, err := time.ParseDuration(utils.Config.GitopsSyncInterval)
+	if err != nil {
+		log.Fatalf("invalid gitops sync interval %s", utils.Config.GitopsSyncInterval)
+	}
+	chaosExperimentOperator := dbChaosExperiment.NewChaosExperimentOperator(mongodbOperator)
+	chaosInfraOperator := dbChaosInfra.NewInfrastructureOperator(mongodbOperator)
+	gitOpsService := gitops.NewGitOpsService(dbGitOps.NewGitOpsOperator(mongodbOperator),
+		chaos_experiment.NewChaosExperimentService(chaosExperimentOperator, chaosInfraOperator), *chaosExperimentOperator,
+		*dbChaosExperimentRun.NewChaosExperimentRunOperator(mongodbOperator), *chaosInfraOperator)
+	go gitOpsService.GitOpsSyncHandler(gitopsSyncInterval)
+
 	// go routine for syncing chaos hubs
 	go chaoshub.NewService(dbSchemaChaosHub.NewChaosHubOperator(mongodbOperator)).RecurringHubSync()
 	go chaoshub.NewService(dbSchemaChaosHub.NewChaosHubOperator(mongodbOperator)).SyncDefaultChaosHubs()
@@ -100,6 +199,8 @@ func main() {
 	router.Any("/query", authorization.Middleware(srv, mongodb.MgoClient))
 
 	router.Any("/file/:key", handlers.FileHandler(mongodbOperator))
+	router.GET("/audit/export", authorization.Middleware(audit.NewService(auditOperator).ExportHandler(), mongodb.MgoClient))
+	router.POST("/gitops/webhook", gin.WrapH(gitOpsService.WebhookHandler()))
 
 	//chaos hub routers
 	router.GET("/icon/:projectId/:hubName/:chartName/:iconName", handler2.ChaosHubIconHandler())
@@ -118,7 +219,34 @@ func main() {
 	}()
 
 	log.Infof("chaos manager running at http://localhost:%s", utils.Config.HttpPort)
-	log.Fatal(http.ListenAndServe(":"+utils.Config.HttpPort, router))
+	err = http.ListenAndServe(":"+utils.Config.HttpPort, router)
+	if err := shutdownTracer(context.Background()); err != nil {
+		log.Error("failed to shutdown the tracer: ", err)
+	}
+	log.Fatal(err)
+}
+
+// flushTracerOnSignal exports the buffered spans before the server exits on SIGINT or SIGTERM
+func flushTracerOnSignal(shutdownTracer func(context.Context) error) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	<-sigCh
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := shutdownTracer(ctx); err != nil {
+		log.Error("failed to shutdown the tracer: ", err)
+	}
+	os.Exit(0)
+}
+
+// startMetricsServer serves the prometheus metrics
+func startMetricsServer(port string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	log.Infof("metrics server listening on port %s", port)
+	log.Fatal(http.ListenAndServe(":"+port, mux))
 }
 
 // startGRPCServer initializes, registers services to and starts the gRPC server for RPC calls
@@ -128,7 +256,7 @@ func startGRPCServer(port string, mongodbOperator mongodb.MongoOperator) {
 		log.Fatal("failed to listen: %w", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.UnaryInterceptor(otelgrpc.UnaryServerInterceptor()))
 
 	// Register services
 
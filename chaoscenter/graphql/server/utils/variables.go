@@ -46,6 +46,19 @@ type Configuration struct {
 	DefaultHubBranchName        string `required:"true" split_words:"true"`
 	CustomChaosHubPath          string `split_words:"true" default:"/tmp/"`
 	DefaultChaosHubPath         string `split_words:"true" default:"/tmp/default/"`
+	PubsubBroker                string `split_words:"true" default:"local"`
+	InfraHeartbeatTimeout       string `split_words:"true" default:"3m"`
+	PodLogMaxBytes              string `split_words:"true" default:"10485760"`
+	SmtpHost                    string `split_words:"true"`
+	SmtpPort                    string `split_words:"true" default:"587"`
+	SmtpUsername                string `split_words:"true"`
+	SmtpPassword                string `split_words:"true"`
+	SmtpFrom                    string `split_words:"true"`
+	OtelExporterOtlpEndpoint    string `split_words:"true"`
+	GitopsSyncInterval          string `split_words:"true" default:"2m"`
+	MetricsPort                 string `split_words:"true" default:"9091"`
+	MetricsRefreshInterval      string `split_words:"true" default:"1m"`
+	MetricsProjectLabels        string `split_words:"true" default:"false"`
 }
 
 var Config Configuration
@@ -123,6 +123,11 @@ func chaosEventHandler(obj interface{}, eventType string, stream chan types.Work
 		Children:   []string{workflowObj.Name + "-engine"},
 		Type:       "Steps",
 	}
+	// standalone chaos engines are weighted by the name of their fault
+	faultName := ""
+	if len(workflowObj.Spec.Experiments) > 0 {
+		faultName = workflowObj.Spec.Experiments[0].Name
+	}
 	details := types.Node{
 		Name:       workflowObj.Name,
 		Phase:      mapStatus(workflowObj.Status.EngineStatus),
@@ -130,6 +135,7 @@ func chaosEventHandler(obj interface{}, eventType string, stream chan types.Work
 		StartedAt:  StrConvTime(workflowObj.CreationTimestamp.Unix()),
 		FinishedAt: StrConvTime(finTime),
 		Children:   []string{},
+		FaultName:  faultName,
 		ChaosExp:   cd,
 		Message:    string(workflowObj.Status.EngineStatus),
 	}
@@ -0,0 +1,268 @@
+package events
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"subscriber/pkg/k8s"
+	"subscriber/pkg/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	outboxMinBackoff = time.Second
+	outboxMaxBackoff = 5 * time.Minute
+	// outboxMaxRejections is the number of times the server may reject an event before it is dropped,
+	// events which failed to reach the server are retried until they are delivered
+	outboxMaxRejections = 10
+	// outboxMaxSize keeps the outbox configmap under the 1MiB limit of the objects stored in etcd, the least recently
+	// queued events are dropped beyond it
+	outboxMaxSize = 900 * 1024
+)
+
+// outboxEntry is the latest event of an experiment run which is yet to be delivered to the server,
+// every event carries the complete state of the run so older events of the run are superseded
+type outboxEntry struct {
+	Event      types.WorkflowEvent `json:"event"`
+	EventID    string              `json:"eventID"`
+	Completed  bool                `json:"completed"`
+	Rejections int                 `json:"rejections"`
+	QueuedAt   int64               `json:"queuedAt"`
+}
+
+// outbox persists the undelivered experiment run events in a configmap so that they survive
+// control plane outages and subscriber restarts
+type outbox struct {
+	mutex   sync.Mutex
+	entries map[string]outboxEntry
+	notify  chan struct{}
+}
+
+var runOutbox = &outbox{
+	entries: make(map[string]outboxEntry),
+	notify:  make(chan struct{}, 1),
+}
+
+// LoadOutbox restores the events which were not delivered before the subscriber restarted
+func LoadOutbox() {
+	data, err := k8s.GetOutbox()
+	if err != nil {
+		logrus.WithError(err).Error("failed to load the undelivered experiment run events")
+		return
+	}
+
+	runOutbox.mutex.Lock()
+	defer runOutbox.mutex.Unlock()
+	for uid, value := range data {
+		var entry outboxEntry
+		if err := json.Unmarshal([]byte(value), &entry); err != nil {
+			logrus.WithError(err).Error("failed to parse the undelivered event of experiment run: ", uid)
+			continue
+		}
+		runOutbox.entries[uid] = entry
+		// chaos data of the run is merged into its later events
+		if !entry.Completed {
+			eventMap[uid] = entry.Event
+		}
+	}
+	logrus.Info("loaded ", len(runOutbox.entries), " undelivered experiment run events")
+	runOutbox.signal()
+}
+
+// QueueWorkflowUpdates persists the event, superseding the undelivered events of its run, and schedules its delivery
+func QueueWorkflowUpdates(event types.WorkflowEvent, completed bool) error {
+	eventID, err := generateEventID(event, completed)
+	if err != nil {
+		return err
+	}
+
+	runOutbox.mutex.Lock()
+	defer runOutbox.mutex.Unlock()
+	runOutbox.entries[event.UID] = outboxEntry{
+		Event:     event,
+		EventID:   eventID,
+		Completed: completed,
+		QueuedAt:  time.Now().UnixNano(),
+	}
+	runOutbox.persist()
+	runOutbox.signal()
+	return nil
+}
+
+// DeliverOutbox sends the queued events to the server, retrying with an exponential backoff while the server is unreachable
+func DeliverOutbox(infraData map[string]string) {
+	backoff := outboxMinBackoff
+	for {
+		retry := false
+		for uid, entry := range runOutbox.pending() {
+			var rejected bool
+			response, err := SendWorkflowUpdates(infraData, entry.Event, entry.EventID, entry.Completed)
+			if err == nil {
+				rejected, err = checkResponse(response)
+			}
+			if err != nil {
+				logrus.WithError(err).Error("failed to deliver the event of experiment run: ", uid)
+				retry = true
+				// the remaining events would fail as well
+				break
+			}
+			if rejected {
+				logrus.Error("event of experiment run: ", uid, " rejected by the server: ", response)
+				retry = runOutbox.reject(uid, entry.EventID) || retry
+				continue
+			}
+
+			logrus.Print("Response from the server: ", response)
+			runOutbox.remove(uid, entry.EventID)
+		}
+
+		if retry {
+			time.Sleep(backoff)
+			backoff *= 2
+			if backoff > outboxMaxBackoff {
+				backoff = outboxMaxBackoff
+			}
+			continue
+		}
+		backoff = outboxMinBackoff
+		<-runOutbox.notify
+	}
+}
+
+// pending returns a copy of the queued events
+func (o *outbox) pending() map[string]outboxEntry {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	entries := make(map[string]outboxEntry, len(o.entries))
+	for uid, entry := range o.entries {
+		entries[uid] = entry
+	}
+	return entries
+}
+
+// remove drops the delivered event unless it has been superseded in the meantime
+func (o *outbox) remove(uid string, eventID string) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	if entry, ok := o.entries[uid]; ok && entry.EventID == eventID {
+		delete(o.entries, uid)
+		o.persist()
+	}
+}
+
+// reject records a rejection of the event and drops it once it has been rejected too many times,
+// it returns true if the event is kept for another attempt
+func (o *outbox) reject(uid string, eventID string) bool {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	entry, ok := o.entries[uid]
+	if !ok || entry.EventID != eventID {
+		return false
+	}
+
+	entry.Rejections++
+	if entry.Rejections >= outboxMaxRejections {
+		logrus.Error("dropping the event of experiment run: ", uid, " after ", entry.Rejections, " rejections")
+		delete(o.entries, uid)
+		o.persist()
+		return false
+	}
+	o.entries[uid] = entry
+	o.persist()
+	return true
+}
+
+// persist writes the queued events to the outbox configmap, the caller must hold the lock
+func (o *outbox) persist() {
+	data, dropped := outboxData(o.entries)
+	for _, uid := range dropped {
+		logrus.Error("dropping the event of experiment run: ", uid, " as the outbox is full")
+		delete(o.entries, uid)
+	}
+
+	if err := k8s.SaveOutbox(data); err != nil {
+		logrus.WithError(err).Error("failed to persist the undelivered experiment run events")
+	}
+}
+
+// outboxData returns the serialised events of the outbox configmap and the runs whose events are dropped, the least
+// recently queued first, to keep it under outboxMaxSize
+func outboxData(entries map[string]outboxEntry) (map[string]string, []string) {
+	data := make(map[string]string, len(entries))
+	size := 0
+	for uid, entry := range entries {
+		value, err := json.Marshal(entry)
+		if err != nil {
+			logrus.WithError(err).Error("failed to marshal the event of experiment run: ", uid)
+			continue
+		}
+		data[uid] = string(value)
+		size += len(uid) + len(value)
+	}
+	if size <= outboxMaxSize {
+		return data, nil
+	}
+
+	uids := make([]string, 0, len(data))
+	for uid := range data {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		return entries[uids[i]].QueuedAt < entries[uids[j]].QueuedAt
+	})
+	var dropped []string
+	for _, uid := range uids {
+		if size <= outboxMaxSize {
+			break
+		}
+		size -= len(uid) + len(data[uid])
+		delete(data, uid)
+		dropped = append(dropped, uid)
+	}
+	return data, dropped
+}
+
+func (o *outbox) signal() {
+	select {
+	case o.notify <- struct{}{}:
+	default:
+	}
+}
+
+// generateEventID derives the ID of the event from the state of the run it carries, so that the server
+// can recognise events which are replayed after a restart
+func generateEventID(event types.WorkflowEvent, completed bool) (string, error) {
+	event.EventType = ""
+	data, err := json.Marshal(struct {
+		Event     types.WorkflowEvent `json:"event"`
+		Completed bool                `json:"completed"`
+	}{event, completed})
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:16]), nil
+}
+
+// checkResponse checks if the server processed the event and responded with errors, responses which
+// are not graphql responses are treated as delivery failures
+func checkResponse(response string) (bool, error) {
+	var body struct {
+		Data   interface{}   `json:"data"`
+		Errors []interface{} `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(response), &body); err != nil {
+		return false, errors.New("unexpected response from the server: " + response)
+	}
+	return len(body.Errors) > 0, nil
+}
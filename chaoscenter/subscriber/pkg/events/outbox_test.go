@@ -0,0 +1,50 @@
+package events
+
+import (
+	"strings"
+	"testing"
+
+	"subscriber/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestOutboxData is used to test that the least recently queued events are dropped once the outbox is full
+func TestOutboxData(t *testing.T) {
+	tests := []struct {
+		name            string
+		messageSize     int
+		expectedDropped []string
+	}{
+		{
+			name:        "outbox under the limit",
+			messageSize: 1024,
+		},
+		{
+			name:            "outbox over the limit",
+			messageSize:     outboxMaxSize / 3,
+			expectedDropped: []string{"run-1"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// given
+			message := strings.Repeat("m", tc.messageSize)
+			entries := map[string]outboxEntry{
+				"run-1": {Event: types.WorkflowEvent{UID: "run-1", Message: message}, QueuedAt: 1},
+				"run-2": {Event: types.WorkflowEvent{UID: "run-2", Message: message}, QueuedAt: 3},
+				"run-3": {Event: types.WorkflowEvent{UID: "run-3", Message: message}, QueuedAt: 2},
+			}
+
+			// when
+			data, dropped := outboxData(entries)
+
+			// then
+			assert.Equal(t, tc.expectedDropped, dropped)
+			assert.Len(t, data, len(entries)-len(tc.expectedDropped))
+			for _, uid := range tc.expectedDropped {
+				assert.NotContains(t, data, uid)
+			}
+		})
+	}
+}
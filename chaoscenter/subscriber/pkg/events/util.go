@@ -42,23 +42,23 @@ func getChaosData(nodeStatus v1alpha13.NodeStatus, engineName, engineNS string,
 	cd.EngineContext = string(crd.Labels["context"])
 
 	if strings.ToLower(string(crd.Status.EngineStatus)) == "stopped" {
-		cd.ExperimentVerdict = "Fail"
-		cd.ExperimentStatus = string(crd.Status.EngineStatus)
+		cd.FaultVerdict = "Fail"
+		cd.FaultStatus = string(crd.Status.EngineStatus)
 	}
 	if len(crd.Status.Experiments) == 0 {
 		return cd, nil
 	}
 
 	// considering chaosengine will only have 1 experiment
-	cd.ExperimentPod = crd.Status.Experiments[0].ExpPod
+	cd.FaultPod = crd.Status.Experiments[0].ExpPod
 	cd.RunnerPod = crd.Status.Experiments[0].Runner
-	cd.ExperimentStatus = string(crd.Status.Experiments[0].Status)
-	cd.ExperimentName = crd.Status.Experiments[0].Name
+	cd.FaultStatus = string(crd.Status.Experiments[0].Status)
+	cd.FaultName = crd.Status.Experiments[0].Name
 	cd.LastUpdatedAt = strconv.FormatInt(crd.Status.Experiments[0].LastUpdateTime.Unix(), 10)
-	cd.ExperimentVerdict = crd.Status.Experiments[0].Verdict
-	if strings.ToLower(string(crd.Status.EngineStatus)) == "stopped" || (strings.ToLower(string(crd.Status.EngineStatus)) == "completed" && strings.ToLower(cd.ExperimentVerdict) != "pass") {
-		cd.ExperimentVerdict = "Fail"
-		cd.ExperimentStatus = string(crd.Status.EngineStatus)
+	cd.FaultVerdict = crd.Status.Experiments[0].Verdict
+	if strings.ToLower(string(crd.Status.EngineStatus)) == "stopped" || (strings.ToLower(string(crd.Status.EngineStatus)) == "completed" && strings.ToLower(cd.FaultVerdict) != "pass") {
+		cd.FaultVerdict = "Fail"
+		cd.FaultStatus = string(crd.Status.EngineStatus)
 	}
 
 	if len(crd.Status.Experiments) == 1 {
@@ -78,8 +78,10 @@ func getChaosData(nodeStatus v1alpha13.NodeStatus, engineName, engineNS string,
 }
 
 // CheckChaosData util function, checks if event is a chaos-exp event, if so -  extract the chaos data
-func CheckChaosData(nodeStatus v1alpha13.NodeStatus, workflowNS string, chaosClient *v1alpha12.LitmuschaosV1alpha1Client) (string, *types.ChaosData, error) {
+// along with the name of the fault the node executes, which is the (generate) name of its chaos engine
+func CheckChaosData(nodeStatus v1alpha13.NodeStatus, workflowNS string, chaosClient *v1alpha12.LitmuschaosV1alpha1Client) (string, string, *types.ChaosData, error) {
 	nodeType := string(nodeStatus.Type)
+	faultName := ""
 	var cd *types.ChaosData = nil
 	// considering chaos events has only 1 artifact with manifest as raw data
 	data := nodeStatus.Inputs.Artifacts[0].Raw.Data
@@ -88,23 +90,27 @@ func CheckChaosData(nodeStatus v1alpha13.NodeStatus, workflowNS string, chaosCli
 	_, _, err := decUnstructured.Decode([]byte(data), nil, obj)
 	if err == nil && obj.GetKind() == "ChaosEngine" {
 		nodeType = "ChaosEngine"
+		faultName = obj.GetName()
+		if obj.GetGenerateName() != "" {
+			faultName = obj.GetGenerateName()
+		}
 		if nodeStatus.Phase != "Pending" {
 			name := obj.GetName()
 			if obj.GetGenerateName() != "" {
 				log, err := k8s.GetLogs(nodeStatus.ID, workflowNS, "main")
 				if err != nil {
-					return nodeType, nil, err
+					return nodeType, faultName, nil, err
 				}
 				name = getNameFromLog(log)
 				if name == "" {
-					return nodeType, nil, errors.New("Chaos-Engine Generated Name couldn't be retrieved")
+					return nodeType, faultName, nil, errors.New("Chaos-Engine Generated Name couldn't be retrieved")
 				}
 			}
 			cd, err = getChaosData(nodeStatus, name, obj.GetNamespace(), chaosClient)
-			return nodeType, cd, err
+			return nodeType, faultName, cd, err
 		}
 	}
-	return nodeType, nil, nil
+	return nodeType, faultName, nil, nil
 }
 
 func getNameFromLog(log string) string {
@@ -170,12 +176,15 @@ func ListWorkflowObject(wfid string) (*v1alpha1.WorkflowList, error) {
 }
 
 // GenerateWorkflowPayload generate graphql mutation payload for events event
-func GenerateWorkflowPayload(cid, accessKey, version, completed string, wfEvent types.WorkflowEvent) ([]byte, error) {
+func GenerateWorkflowPayload(cid, accessKey, version, completed, eventID string, wfEvent types.WorkflowEvent) ([]byte, error) {
 	infraID := `{infraID: \"` + cid + `\", version: \"` + version + `\", accessKey: \"` + accessKey + `\"}`
+	// the nodes are copied since the event may still be queued for delivery
+	nodes := make(map[string]types.Node, len(wfEvent.Nodes))
 	for id, event := range wfEvent.Nodes {
 		event.Message = strings.Replace(event.Message, `"`, ``, -1)
-		wfEvent.Nodes[id] = event
+		nodes[id] = event
 	}
+	wfEvent.Nodes = nodes
 
 	data, err := json.Marshal(wfEvent)
 	if err != nil {
@@ -184,10 +193,10 @@ func GenerateWorkflowPayload(cid, accessKey, version, completed string, wfEvent
 
 	executionData := base64.StdEncoding.EncodeToString(data)
 
-	mutation := `{ experimentID: \"` + wfEvent.WorkflowID + `\", experimentRunID: \"` + wfEvent.UID + `\", revisionID:\"` + wfEvent.RevisionID + `\", completed: ` + completed + `, experimentName:\"` + wfEvent.Name + `\", infraID: ` + infraID + `, updatedBy:\"` + wfEvent.UpdatedBy + `\", executionData:\"` + executionData + `\"}`
+	mutation := `{ experimentID: \"` + wfEvent.WorkflowID + `\", experimentRunID: \"` + wfEvent.UID + `\", revisionID:\"` + wfEvent.RevisionID + `\", eventID:\"` + eventID + `\", completed: ` + completed + `, experimentName:\"` + wfEvent.Name + `\", infraID: ` + infraID + `, updatedBy:\"` + wfEvent.UpdatedBy + `\", executionData:\"` + executionData + `\"}`
 
 	if wfEvent.NotifyID != nil {
-		mutation = `{ experimentID: \"` + wfEvent.WorkflowID + `\", experimentRunID: \"` + wfEvent.UID + `\", revisionID:\"` + wfEvent.RevisionID + `\", notifyID:\"` + *wfEvent.NotifyID + `\", completed: ` + completed + `, experimentName:\"` + wfEvent.Name + `\", infraID: ` + infraID + `, updatedBy:\"` + wfEvent.UpdatedBy + `\", executionData:\"` + executionData + `\"}`
+		mutation = `{ experimentID: \"` + wfEvent.WorkflowID + `\", experimentRunID: \"` + wfEvent.UID + `\", revisionID:\"` + wfEvent.RevisionID + `\", notifyID:\"` + *wfEvent.NotifyID + `\", eventID:\"` + eventID + `\", completed: ` + completed + `, experimentName:\"` + wfEvent.Name + `\", infraID: ` + infraID + `, updatedBy:\"` + wfEvent.UpdatedBy + `\", executionData:\"` + executionData + `\"}`
 	}
 
 	var payload = []byte(`{"query":"mutation { chaosExperimentRun(request:` + mutation + ` )}"}`)
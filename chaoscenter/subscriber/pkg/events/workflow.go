@@ -1,6 +1,7 @@
 package events
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -11,6 +12,7 @@ import (
 	"subscriber/pkg/graphql"
 
 	"subscriber/pkg/k8s"
+	"subscriber/pkg/tracing"
 	"subscriber/pkg/types"
 
 	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
@@ -18,6 +20,9 @@ import (
 	"github.com/argoproj/argo-workflows/v3/pkg/client/informers/externalversions"
 	litmusV1alpha1 "github.com/litmuschaos/chaos-operator/pkg/client/clientset/versioned/typed/litmuschaos/v1alpha1"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 )
@@ -131,14 +136,15 @@ func WorkflowEventHandler(workflowObj *v1alpha1.Workflow, eventType string, star
 	for _, nodeStatus := range workflowObj.Status.Nodes {
 
 		var (
-			nodeType                  = string(nodeStatus.Type)
-			cd       *types.ChaosData = nil
+			nodeType                   = string(nodeStatus.Type)
+			faultName                  = ""
+			cd        *types.ChaosData = nil
 		)
 
 		// considering chaos events has only 1 artifact with manifest as raw data
 		if nodeStatus.Type == "Pod" && nodeStatus.Inputs != nil && len(nodeStatus.Inputs.Artifacts) == 1 && nodeStatus.Inputs.Artifacts[0].Raw != nil {
 			//extracts chaos data
-			nodeType, cd, err = CheckChaosData(nodeStatus, workflowObj.ObjectMeta.Namespace, chaosClient)
+			nodeType, faultName, cd, err = CheckChaosData(nodeStatus, workflowObj.ObjectMeta.Namespace, chaosClient)
 			if err != nil {
 				logrus.WithError(err).Print("Failed to parse ChaosEngine CRD")
 			}
@@ -151,16 +157,17 @@ func WorkflowEventHandler(workflowObj *v1alpha1.Workflow, eventType string, star
 			StartedAt:  StrConvTime(nodeStatus.StartedAt.Unix()),
 			FinishedAt: StrConvTime(nodeStatus.FinishedAt.Unix()),
 			Children:   nodeStatus.Children,
+			FaultName:  faultName,
 			ChaosExp:   cd,
 			Message:    nodeStatus.Message,
 		}
-		if cd != nil && strings.ToLower(cd.ExperimentVerdict) == "fail" {
+		if cd != nil && strings.ToLower(cd.FaultVerdict) == "fail" {
 			details.Phase = "Failed"
 			details.Message = "Chaos Experiment Failed"
-			cd.ExperimentVerdict = "Fail"
-		} else if cd != nil && strings.ToLower(cd.ExperimentVerdict) == "pass" {
+			cd.FaultVerdict = "Fail"
+		} else if cd != nil && strings.ToLower(cd.FaultVerdict) == "pass" {
 			details.Phase = "Passed"
-			cd.ExperimentVerdict = "Pass"
+			cd.FaultVerdict = "Pass"
 		}
 		nodes[nodeStatus.ID] = details
 	}
@@ -202,13 +209,14 @@ func WorkflowEventHandler(workflowObj *v1alpha1.Workflow, eventType string, star
 		FinishedAt:        finishedTime,
 		Nodes:             nodes,
 		UpdatedBy:         workflowObj.Labels["updated_by"],
+		TraceContext:      workflowObj.Annotations[tracing.TraceContextAnnotation],
 	}
 
 	return workflow, nil
 }
 
-// SendWorkflowUpdates generates graphql mutation to send events updates to graphql server
-func SendWorkflowUpdates(infraData map[string]string, event types.WorkflowEvent) (string, error) {
+// QueueWorkflowEvent merges the event with the chaos data of the earlier events of its run and queues it for delivery
+func QueueWorkflowEvent(event types.WorkflowEvent) error {
 	if wfEvent, ok := eventMap[event.UID]; ok {
 		for key, node := range wfEvent.Nodes {
 			if node.Type == "ChaosEngine" && node.ChaosExp != nil && event.Nodes[key].ChaosExp == nil {
@@ -229,34 +237,58 @@ func SendWorkflowUpdates(infraData map[string]string, event types.WorkflowEvent)
 	}
 	eventMap[event.UID] = event
 
+	completed := event.FinishedAt != ""
+	if completed {
+		delete(eventMap, event.UID)
+	}
+
+	return QueueWorkflowUpdates(event, completed)
+}
+
+// SendWorkflowUpdates generates graphql mutation to send events updates to graphql server
+func SendWorkflowUpdates(infraData map[string]string, event types.WorkflowEvent, eventID string, completed bool) (string, error) {
 	// generate graphql payload
-	payload, err := GenerateWorkflowPayload(infraData["INFRA_ID"], infraData["ACCESS_KEY"], infraData["VERSION"], "false", event)
+	payload, err := GenerateWorkflowPayload(infraData["INFRA_ID"], infraData["ACCESS_KEY"], infraData["VERSION"], strconv.FormatBool(completed), eventID, event)
 	if err != nil {
 		return "", errors.New("Error while generating graphql payload from the workflow event" + err.Error())
 	}
 
-	if event.FinishedAt != "" {
-		payload, err = GenerateWorkflowPayload(infraData["INFRA_ID"], infraData["ACCESS_KEY"], infraData["VERSION"], "true", event)
-		delete(eventMap, event.UID)
-	}
-
-	body, err := graphql.SendRequest(infraData["SERVER_ADDR"], payload)
+	// the run events are part of the trace of the request which created the workflow
+	ctx := tracing.ContextFromTraceContext(context.Background(), event.TraceContext)
+	ctx, span := tracing.Tracer().Start(ctx, "chaosExperimentRun", trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("litmus.experiment.id", event.WorkflowID),
+			attribute.String("litmus.experiment_run.id", event.UID),
+			attribute.String("litmus.experiment_run.phase", event.Phase),
+		),
+	)
+	defer span.End()
+
+	body, err := graphql.SendRequestWithContext(ctx, infraData["SERVER_ADDR"], payload)
 	if err != nil {
+		span.SetStatus(codes.Error, err.Error())
 		return "", err
 	}
 
 	return body, nil
 }
 
+// WorkflowUpdates queues the streamed events in the outbox, from which they are delivered to the server. The events
+// which were not delivered before a restart are replayed, and the informers re-list the workflows and chaos engines of
+// the infra on start so that the server catches up with the runs which progressed in the meantime
 func WorkflowUpdates(infraData map[string]string, event chan types.WorkflowEvent) {
+	LoadOutbox()
+	go DeliverOutbox(infraData)
+
 	// listen on the channel for streaming event updates
 	for eventData := range event {
-		response, err := SendWorkflowUpdates(infraData, eventData)
-		if err != nil {
+		// ignored workflows are streamed as empty events
+		if eventData.UID == "" {
+			continue
+		}
+		if err := QueueWorkflowEvent(eventData); err != nil {
 			logrus.Print(err.Error())
 		}
-
-		logrus.Print("Response from the server: ", response)
 	}
 }
 
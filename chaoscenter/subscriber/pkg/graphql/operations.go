@@ -2,19 +2,28 @@ package graphql
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
 	"strconv"
 	"strings"
+
+	"subscriber/pkg/tracing"
 )
 
 func SendRequest(server string, payload []byte) (string, error) {
-	req, err := http.NewRequest("POST", server, bytes.NewBuffer(payload))
+	return SendRequestWithContext(context.Background(), server, payload)
+}
+
+// SendRequestWithContext sends the request to the server as part of the trace of the context
+func SendRequestWithContext(ctx context.Context, server string, payload []byte) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", server, bytes.NewBuffer(payload))
 	if err != nil {
 		return "", err
 	}
 	req.Header.Set("Content-Type", "application/json")
+	tracing.InjectHeaders(ctx, req.Header)
 	resp, err := http.DefaultClient.Do(req)
 	if err != nil {
 		return "", err
@@ -65,3 +65,13 @@ func GenerateArgoClient(namespace string) (v1alpha12.WorkflowInterface, error) {
 	wfClient := wfclientset.NewForConfigOrDie(conf).ArgoprojV1alpha1().Workflows(namespace)
 	return wfClient, nil
 }
+
+func GenerateCronWorkflowClient(namespace string) (v1alpha12.CronWorkflowInterface, error) {
+	conf, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cronWfClient := wfclientset.NewForConfigOrDie(conf).ArgoprojV1alpha1().CronWorkflows(namespace)
+	return cronWfClient, nil
+}
@@ -0,0 +1,103 @@
+package k8s
+
+import (
+	"context"
+
+	"subscriber/pkg/graphql"
+	"subscriber/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	memory "k8s.io/client-go/discovery/cached"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+)
+
+// dryRunFieldManager is the field manager of the objects applied during dry-runs
+const dryRunFieldManager = "litmus-subscriber"
+
+// DryRunManifests applies the manifests with a server-side dry-run, so that they are validated by the API server
+// and the admission webhooks of the cluster without being persisted
+func DryRunManifests(request types.DryRunRequest) ([]types.DryRunResult, error) {
+	discoveryClient, dynamicClient, err := GetDynamicAndDiscoveryClient()
+	if err != nil {
+		return nil, err
+	}
+	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(discoveryClient))
+
+	results := []types.DryRunResult{}
+	for _, manifest := range request.Manifests {
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON([]byte(manifest)); err != nil {
+			results = append(results, types.DryRunResult{Error: "failed to parse the manifest: " + err.Error()})
+			continue
+		}
+
+		result := types.DryRunResult{Kind: obj.GetKind()}
+		if err := dryRunObject(mapper, dynamicClient, obj, request.Namespace); err != nil {
+			result.Error = err.Error()
+		}
+		result.Name = obj.GetName()
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func dryRunObject(mapper meta.RESTMapper, dynamicClient dynamic.Interface, obj *unstructured.Unstructured, namespace string) error {
+	// server-side apply requires a name, objects created with a generated name are dry-run with a fixed one
+	if obj.GetName() == "" {
+		obj.SetName(obj.GetGenerateName() + "dry-run")
+		obj.SetGenerateName("")
+	}
+
+	gvk := obj.GroupVersionKind()
+	mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return err
+	}
+
+	var resource dynamic.ResourceInterface
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(namespace)
+		}
+		resource = dynamicClient.Resource(mapping.Resource).Namespace(obj.GetNamespace())
+	} else {
+		resource = dynamicClient.Resource(mapping.Resource)
+	}
+
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return err
+	}
+	force := true
+	_, err = resource.Patch(context.TODO(), obj.GetName(), k8stypes.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:       []string{metav1.DryRunAll},
+		FieldManager: dryRunFieldManager,
+		Force:        &force,
+	})
+	return err
+}
+
+// SendDryRunResults generates graphql mutation to send the dry-run results to graphql server
+func SendDryRunResults(ctx context.Context, infraData map[string]string, requestID string, results []types.DryRunResult) error {
+	processed, err := graphql.MarshalGQLData(results)
+	if err != nil {
+		return err
+	}
+
+	infraID := `{infraID: \"` + infraData["INFRA_ID"] + `\", version: \"` + infraData["VERSION"] + `\", accessKey: \"` + infraData["ACCESS_KEY"] + `\"}`
+	mutation := `{ infraID: ` + infraID + `, requestID:\"` + requestID + `\", results:\"` + processed[1:len(processed)-1] + `\"}`
+	var payload = []byte(`{"query":"mutation { dryRunResult(request:` + mutation + ` )}"}`)
+
+	body, err := graphql.SendRequestWithContext(ctx, infraData["SERVER_ADDR"], payload)
+	if err != nil {
+		return err
+	}
+
+	logrus.Print("Response from the server: ", body)
+	return nil
+}
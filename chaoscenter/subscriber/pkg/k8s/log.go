@@ -3,9 +3,11 @@ package k8s
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"strconv"
 	"strings"
+	"time"
 
 	"subscriber/pkg/graphql"
 	"subscriber/pkg/types"
@@ -13,29 +15,21 @@ import (
 	"github.com/sirupsen/logrus"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
 
 func GetLogs(podName, namespace, container string) (string, error) {
-	ctx := context.TODO()
-	conf, err := GetKubeConfig()
-	if err != nil {
-		return "", err
-	}
-
 	podLogOpts := v1.PodLogOptions{}
 	if container != "" {
 		podLogOpts.Container = container
 	}
+	return GetPodLogs(context.TODO(), podName, namespace, podLogOpts)
+}
 
-	// creates the clientset
-	clientset, err := kubernetes.NewForConfig(conf)
-	if err != nil {
-		return "", err
-	}
-
-	req := clientset.CoreV1().Pods(namespace).GetLogs(podName, &podLogOpts)
-	podLogs, err := req.Stream(ctx)
+// GetPodLogs returns the logs of the pod selected by the log options
+func GetPodLogs(ctx context.Context, podName, namespace string, podLogOpts v1.PodLogOptions) (string, error) {
+	podLogs, err := OpenPodLogStream(ctx, podName, namespace, podLogOpts)
 	if err != nil {
 		return "", err
 	}
@@ -53,17 +47,62 @@ func GetLogs(podName, namespace, container string) (string, error) {
 	return str, nil
 }
 
+// OpenPodLogStream opens a stream of the logs of the pod selected by the log options
+func OpenPodLogStream(ctx context.Context, podName, namespace string, podLogOpts v1.PodLogOptions) (io.ReadCloser, error) {
+	conf, err := GetKubeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// creates the clientset
+	clientset, err := kubernetes.NewForConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	return clientset.CoreV1().Pods(namespace).GetLogs(podName, &podLogOpts).Stream(ctx)
+}
+
+// mainPodLogOptions returns the log options of the requested pod, logs of the main container are returned by default
+func mainPodLogOptions(podLog types.PodLogRequest) (v1.PodLogOptions, error) {
+	podLogOpts := v1.PodLogOptions{
+		Container: "main",
+		TailLines: podLog.TailLines,
+	}
+	if podLog.Container != nil && *podLog.Container != "" {
+		podLogOpts.Container = *podLog.Container
+	}
+	if podLog.SinceTime != nil && *podLog.SinceTime != "" {
+		sinceTime, err := strconv.ParseInt(*podLog.SinceTime, 10, 64)
+		if err != nil {
+			return podLogOpts, fmt.Errorf("invalid since time %s: %w", *podLog.SinceTime, err)
+		}
+		since := metav1.NewTime(time.UnixMilli(sinceTime))
+		podLogOpts.SinceTime = &since
+	}
+	return podLogOpts, nil
+}
+
+// escapeLog formats the logs so that they can be embedded in the graphql payload
+func escapeLog(log string) string {
+	escaped := strconv.Quote(strings.Replace(log, `"`, `'`, -1))
+	return escaped[1 : len(escaped)-1]
+}
+
 // create pod log for normal pods and chaos-engine pods
 func CreatePodLog(podLog types.PodLogRequest) (types.PodLog, error) {
 	logDetails := types.PodLog{}
-	mainLog, err := GetLogs(podLog.PodName, podLog.PodNamespace, "main")
+	podLogOpts, err := mainPodLogOptions(podLog)
+	if err != nil {
+		return logDetails, err
+	}
+	mainLog, err := GetPodLogs(context.TODO(), podLog.PodName, podLog.PodNamespace, podLogOpts)
 	// try getting argo pod logs
 	if err != nil {
 		logrus.Errorf("Failed to get argo pod %v logs, err: %v", podLog.PodName, err)
 		logDetails.MainPod = "Failed to get argo pod logs"
 	} else {
-		logDetails.MainPod = strconv.Quote(strings.Replace(mainLog, `"`, `'`, -1))
-		logDetails.MainPod = logDetails.MainPod[1 : len(logDetails.MainPod)-1]
+		logDetails.MainPod = escapeLog(mainLog)
 	}
 	// try getting experiment pod logs if requested
 	if strings.ToLower(podLog.PodType) == "chaosengine" && podLog.ChaosNamespace != nil {
@@ -71,8 +110,7 @@ func CreatePodLog(podLog types.PodLogRequest) (types.PodLog, error) {
 		if podLog.ExpPod != nil {
 			expLog, err := GetLogs(*podLog.ExpPod, *podLog.ChaosNamespace, "")
 			if err == nil {
-				chaosLog[*podLog.ExpPod] = strconv.Quote(strings.Replace(expLog, `"`, `'`, -1))
-				chaosLog[*podLog.ExpPod] = chaosLog[*podLog.ExpPod][1 : len(chaosLog[*podLog.ExpPod])-1]
+				chaosLog[*podLog.ExpPod] = escapeLog(expLog)
 			} else {
 				logrus.Errorf("Failed to get experiment pod %v logs, err: %v", *podLog.ExpPod, err)
 			}
@@ -80,8 +118,7 @@ func CreatePodLog(podLog types.PodLogRequest) (types.PodLog, error) {
 		if podLog.RunnerPod != nil {
 			runnerLog, err := GetLogs(*podLog.RunnerPod, *podLog.ChaosNamespace, "")
 			if err == nil {
-				chaosLog[*podLog.RunnerPod] = strconv.Quote(strings.Replace(runnerLog, `"`, `'`, -1))
-				chaosLog[*podLog.RunnerPod] = chaosLog[*podLog.RunnerPod][1 : len(chaosLog[*podLog.RunnerPod])-1]
+				chaosLog[*podLog.RunnerPod] = escapeLog(runnerLog)
 			} else {
 				logrus.Errorf("Failed to get runner pod %v logs, err: %v", *podLog.RunnerPod, err)
 			}
@@ -110,7 +147,6 @@ func SendPodLogs(infraData map[string]string, podLog types.PodLogRequest) {
 }
 
 func GenerateLogPayload(cid, accessKey, version string, podLog types.PodLogRequest) ([]byte, error) {
-	infraID := `{infraID: \"` + cid + `\", version: \"` + version + `\", accessKey: \"` + accessKey + `\"}`
 	processed := " Could not get logs "
 
 	// get the logs
@@ -123,8 +159,17 @@ func GenerateLogPayload(cid, accessKey, version string, podLog types.PodLogReque
 		}
 	}
 
-	mutation := `{ infraID: ` + infraID + `, requestID:\"` + podLog.RequestID + `\", experimentRunID: \"` + podLog.ExperimentRunID + `\", podName: \"` + podLog.PodName + `\", podType: \"` + podLog.PodType + `\", log:\"` + processed[1:len(processed)-1] + `\"}`
-	var payload = []byte(`{"query":"mutation { podLog(request:` + mutation + ` )}"}`)
+	return generateLogMutation(cid, accessKey, version, podLog, processed, nil), nil
+}
+
+// generateLogMutation generates the podLog mutation for the processed logs, final is set for the chunks of streamed logs
+func generateLogMutation(cid, accessKey, version string, podLog types.PodLogRequest, processed string, final *bool) []byte {
+	infraID := `{infraID: \"` + cid + `\", version: \"` + version + `\", accessKey: \"` + accessKey + `\"}`
+	isFinal := ""
+	if final != nil {
+		isFinal = `, isFinal: ` + strconv.FormatBool(*final)
+	}
 
-	return payload, nil
+	mutation := `{ infraID: ` + infraID + `, requestID:\"` + podLog.RequestID + `\", experimentRunID: \"` + podLog.ExperimentRunID + `\", podName: \"` + podLog.PodName + `\", podType: \"` + podLog.PodType + `\", log:\"` + processed[1:len(processed)-1] + `\"` + isFinal + `}`
+	return []byte(`{"query":"mutation { podLog(request:` + mutation + ` )}"}`)
 }
@@ -0,0 +1,109 @@
+package k8s
+
+import (
+	"bufio"
+	"context"
+	"strings"
+	"time"
+
+	"subscriber/pkg/graphql"
+	"subscriber/pkg/types"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// logChunkSize is the number of bytes of logs after which a chunk is sent to the server
+	logChunkSize = 32 * 1024
+	// logFlushInterval is the interval after which the buffered logs are sent even if the chunk isn't full
+	logFlushInterval = time.Second
+	// maxLogStreamDuration bounds the streams of pods which keep running after the user stopped listening
+	maxLogStreamDuration = 30 * time.Minute
+	// logRequestCancelled is the response of the server once the user stopped listening or the request exceeded its byte budget
+	logRequestCancelled = "LOG REQUEST CANCELLED"
+)
+
+// StreamPodLogs follows the logs of the requested pod and sends them to the server in chunks
+// until the pod terminates or the server cancels the request
+func StreamPodLogs(infraData map[string]string, podLog types.PodLogRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), maxLogStreamDuration)
+	defer cancel()
+
+	send := func(log string, final bool) bool {
+		processed, err := graphql.MarshalGQLData(types.PodLog{MainPod: escapeLog(log)})
+		if err != nil {
+			logrus.WithError(err).Error("failed to marshal the logs of pod: ", podLog.PodName)
+			return true
+		}
+		payload := generateLogMutation(infraData["INFRA_ID"], infraData["ACCESS_KEY"], infraData["VERSION"], podLog, processed, &final)
+		body, err := graphql.SendRequest(infraData["SERVER_ADDR"], payload)
+		if err != nil {
+			logrus.WithError(err).Error("failed to send the logs of pod: ", podLog.PodName)
+			return false
+		}
+		return !strings.Contains(body, logRequestCancelled)
+	}
+
+	podLogOpts, err := mainPodLogOptions(podLog)
+	if err != nil {
+		send(err.Error(), true)
+		return
+	}
+	podLogOpts.Follow = true
+
+	stream, err := OpenPodLogStream(ctx, podLog.PodName, podLog.PodNamespace, podLogOpts)
+	if err != nil {
+		logrus.Errorf("Failed to stream pod %v logs, err: %v", podLog.PodName, err)
+		send("Failed to get argo pod logs", true)
+		return
+	}
+	defer stream.Close()
+
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		reader := bufio.NewReader(stream)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(logFlushInterval)
+	defer ticker.Stop()
+
+	var chunk strings.Builder
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				send(chunk.String(), true)
+				logrus.Print("Log stream of pod: ", podLog.PodName, " completed")
+				return
+			}
+			chunk.WriteString(line)
+			if chunk.Len() < logChunkSize {
+				continue
+			}
+		case <-ticker.C:
+			if chunk.Len() == 0 {
+				continue
+			}
+		}
+
+		if !send(chunk.String(), false) {
+			logrus.Print("Log stream of pod: ", podLog.PodName, " cancelled")
+			return
+		}
+		chunk.Reset()
+	}
+}
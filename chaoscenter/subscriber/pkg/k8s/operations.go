@@ -12,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 
 	"subscriber/pkg/graphql"
+	"subscriber/pkg/tracing"
 	"subscriber/pkg/types"
 
 	yaml_converter "github.com/ghodss/yaml"
@@ -305,6 +306,20 @@ func addCustomLabels(obj *unstructured.Unstructured, customLabels map[string]str
 	obj.SetLabels(newLabels)
 }
 
+func addCustomAnnotations(obj *unstructured.Unstructured, customAnnotations map[string]string) {
+	newAnnotations := obj.GetAnnotations()
+
+	if len(newAnnotations) == 0 {
+		newAnnotations = make(map[string]string)
+	}
+
+	for annotation, value := range customAnnotations {
+		newAnnotations[annotation] = value
+	}
+
+	obj.SetAnnotations(newAnnotations)
+}
+
 // AgentOperations This function handles agent operations
 func AgentOperations(infraAction types.Action) (*unstructured.Unstructured, error) {
 	// Converting JSON to YAML and store it in yamlStr variable
@@ -331,6 +346,10 @@ func AgentOperations(infraAction types.Action) (*unstructured.Unstructured, erro
 
 	if infraAction.RequestType != "delete" {
 		addCustomLabels(obj, map[string]string{"updated_by": base64.RawURLEncoding.EncodeToString([]byte(infraAction.Username))})
+		// the run events of the workflow continue the trace of the request which created it
+		if infraAction.TraceContext != "" {
+			addCustomAnnotations(obj, map[string]string{tracing.TraceContextAnnotation: infraAction.TraceContext})
+		}
 	}
 
 	// Find GVR
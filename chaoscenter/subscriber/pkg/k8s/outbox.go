@@ -0,0 +1,53 @@
+package k8s
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OutboxConfigName is the configmap persisting the experiment run events which are yet to be delivered to the server
+const OutboxConfigName = "subscriber-outbox"
+
+// GetOutbox returns the persisted experiment run events, keyed by the UID of their run
+func GetOutbox() (map[string]string, error) {
+	clientset, err := GetGenericK8sClient()
+	if err != nil {
+		return nil, err
+	}
+
+	cm, err := clientset.CoreV1().ConfigMaps(InfraNamespace).Get(context.TODO(), OutboxConfigName, metav1.GetOptions{})
+	if k8s_errors.IsNotFound(err) {
+		return map[string]string{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if cm.Data == nil {
+		return map[string]string{}, nil
+	}
+	return cm.Data, nil
+}
+
+// SaveOutbox replaces the persisted experiment run events, the configmap is created on first use
+func SaveOutbox(data map[string]string) error {
+	clientset, err := GetGenericK8sClient()
+	if err != nil {
+		return err
+	}
+
+	cm := &corev1.ConfigMap{
+		Data: data,
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      OutboxConfigName,
+			Namespace: InfraNamespace,
+		},
+	}
+	_, err = clientset.CoreV1().ConfigMaps(InfraNamespace).Update(context.TODO(), cm, metav1.UpdateOptions{})
+	if k8s_errors.IsNotFound(err) {
+		_, err = clientset.CoreV1().ConfigMaps(InfraNamespace).Create(context.TODO(), cm, metav1.CreateOptions{})
+	}
+	return err
+}
@@ -0,0 +1,47 @@
+package requests
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"subscriber/pkg/graphql"
+
+	"github.com/sirupsen/logrus"
+)
+
+// heartbeatInterval is the interval at which the infra reports to the server that it is alive
+const heartbeatInterval = 30 * time.Second
+
+// Heartbeat periodically reports to the server that the infra is alive, the server marks infras
+// which stop sending heartbeats as inactive
+func Heartbeat(stopCh chan struct{}, infraData map[string]string) {
+	ticker := time.NewTicker(heartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := sendHeartbeat(infraData); err != nil {
+			logrus.WithError(err).Error("Failed to send heartbeat")
+		}
+
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func sendHeartbeat(infraData map[string]string) error {
+	infraID := `{infraID: \"` + infraData["INFRA_ID"] + `\", version: \"` + infraData["VERSION"] + `\", accessKey: \"` + infraData["ACCESS_KEY"] + `\"}`
+	payload := []byte(`{"query":"mutation { infraHeartbeat(request:` + infraID + ` )}"}`)
+
+	body, err := graphql.SendRequest(infraData["SERVER_ADDR"], payload)
+	if err != nil {
+		return err
+	}
+	if !strings.Contains(body, `"infraHeartbeat":true`) {
+		return errors.New("unexpected response from the server: " + body)
+	}
+	return nil
+}
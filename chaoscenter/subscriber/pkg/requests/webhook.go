@@ -1,6 +1,7 @@
 package requests
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/url"
@@ -8,15 +9,47 @@ import (
 	"time"
 
 	"subscriber/pkg/k8s"
+	"subscriber/pkg/tracing"
 	"subscriber/pkg/types"
 	"subscriber/pkg/utils"
 
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+const (
+	minReconnectBackoff = time.Second
+	maxReconnectBackoff = time.Minute
+	// keepAliveTimeout is how long the connection may stay silent, the server sends a keepalive every 10 seconds
+	keepAliveTimeout = 30 * time.Second
+)
+
+// AgentConnect keeps the infra connected to the server, reconnecting with an exponential backoff whenever the connection fails
 func AgentConnect(infraData map[string]string) {
-	query := `{"query":"subscription {\n    infraConnect(request: {infraID: \"` + infraData["INFRA_ID"] + `\", version: \"` + infraData["VERSION"] + `\", accessKey: \"` + infraData["ACCESS_KEY"] + `\"}) {\n     action{\n      k8sManifest,\n      externalData,\n      requestID\n requestType\n     username\n     namespace\n     }\n  }\n}\n"}`
+	backoff := minReconnectBackoff
+	for {
+		connectedAt := time.Now()
+		err := connect(infraData)
+		// connections rejected by the server right away keep backing off
+		if time.Since(connectedAt) > maxReconnectBackoff {
+			backoff = minReconnectBackoff
+		}
+		logrus.WithError(err).Errorf("Connection to the server lost, reconnecting in %v", backoff)
+
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > maxReconnectBackoff {
+			backoff = maxReconnectBackoff
+		}
+	}
+}
+
+// connect subscribes to the infra actions and processes them until the connection fails
+func connect(infraData map[string]string) error {
+	query := `{"query":"subscription {\n    infraConnect(request: {infraID: \"` + infraData["INFRA_ID"] + `\", version: \"` + infraData["VERSION"] + `\", accessKey: \"` + infraData["ACCESS_KEY"] + `\"}) {\n     action{\n      k8sManifest,\n      externalData,\n      requestID\n requestType\n     username\n     namespace\n     traceContext\n     }\n  }\n}\n"}`
 	serverURL, err := url.Parse(infraData["SERVER_ADDR"])
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to parse URL")
@@ -31,47 +64,33 @@ func AgentConnect(infraData map[string]string) {
 
 	c, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
 	if err != nil {
-		logrus.WithError(err).Fatal("Failed to established websocket connection")
+		return errors.New("failed to establish websocket connection: " + err.Error())
 	}
 	defer c.Close()
 
-	go func() {
-		time.Sleep(1 * time.Second)
-		payload := types.OperationMessage{
-			Type: "connection_init",
-		}
+	for _, payload := range []types.OperationMessage{
+		{Type: "connection_init"},
+		{Type: "start", Payload: []byte(query)},
+	} {
 		data, err := json.Marshal(payload)
 		if err != nil {
-			logrus.WithError(err).Fatal("Failed to marshal message")
+			return errors.New("failed to marshal message: " + err.Error())
 		}
 
 		err = c.WriteMessage(websocket.TextMessage, data)
 		if err != nil {
-			logrus.WithError(err).Fatal("Failed to write message after init")
-			return
+			return errors.New("failed to write " + payload.Type + " message: " + err.Error())
 		}
-
-		payload = types.OperationMessage{
-			Payload: []byte(query),
-			Type:    "start",
-		}
-
-		data, err = json.Marshal(payload)
-		if err != nil {
-			logrus.WithError(err).Fatal("Failed to marshal message")
-		}
-
-		err = c.WriteMessage(websocket.TextMessage, data)
-		if err != nil {
-			logrus.WithError(err).Fatal("Failed to write message after start")
-			return
-		}
-	}()
+	}
 
 	for {
+		// a connection which missed the keepalives of the server is considered broken
+		if err := c.SetReadDeadline(time.Now().Add(keepAliveTimeout)); err != nil {
+			return err
+		}
 		_, message, err := c.ReadMessage()
 		if err != nil {
-			logrus.WithError(err).Fatal("Failed to read message")
+			return errors.New("failed to read message: " + err.Error())
 		}
 
 		var r types.RawData
@@ -81,8 +100,16 @@ func AgentConnect(infraData map[string]string) {
 			continue
 		}
 
-		if r.Type == "connection_ack" {
+		switch r.Type {
+		case "connection_ack":
 			logrus.Info("Server connection established, Listening....")
+			continue
+		case "ka":
+			continue
+		case "connection_error", "error":
+			return errors.New("error response from the server: " + string(message))
+		case "complete":
+			return errors.New("subscription completed by the server")
 		}
 		if r.Type != "data" {
 			continue
@@ -99,7 +126,28 @@ func AgentConnect(infraData map[string]string) {
 	}
 }
 
+// RequestProcessor processes the action sent by the server as part of the trace of the request which triggered it
 func RequestProcessor(infraData map[string]string, r types.RawData) error {
+	action := r.Payload.Data.InfraConnect.Action
+	ctx := tracing.ContextFromTraceContext(context.Background(), action.TraceContext)
+	ctx, span := tracing.Tracer().Start(ctx, "infra action "+strings.ToLower(action.RequestType),
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.String("litmus.request.id", action.RequestID),
+			attribute.String("litmus.request.type", action.RequestType),
+		),
+	)
+	defer span.End()
+
+	err := processRequest(ctx, infraData, r)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return err
+}
+
+func processRequest(ctx context.Context, infraData map[string]string, r types.RawData) error {
 	if strings.Index("kubeobject kubeobjects", strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType)) >= 0 {
 		KubeObjRequest := types.KubeObjRequest{
 			RequestID: r.Payload.Data.InfraConnect.Action.RequestID,
@@ -115,6 +163,32 @@ func RequestProcessor(infraData map[string]string, r types.RawData) error {
 			return errors.New("error getting kubernetes object data: " + err.Error())
 		}
 	}
+	if strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType) == "blackout_windows" {
+		err := utils.UpdateBlackoutWindows(r.Payload.Data.InfraConnect.Action.ExternalData)
+		if err != nil {
+			return errors.New("error updating blackout windows: " + err.Error())
+		}
+		return nil
+	}
+	if strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType) == "dry_run" {
+		dryRunRequest := types.DryRunRequest{
+			RequestID: r.Payload.Data.InfraConnect.Action.RequestID,
+		}
+		err := json.Unmarshal([]byte(r.Payload.Data.InfraConnect.Action.ExternalData), &dryRunRequest)
+		if err != nil {
+			return errors.New("error reading infra-action request [external-data]: " + err.Error())
+		}
+
+		results, err := k8s.DryRunManifests(dryRunRequest)
+		if err != nil {
+			return errors.New("error dry-running the manifests: " + err.Error())
+		}
+		err = k8s.SendDryRunResults(ctx, infraData, dryRunRequest.RequestID, results)
+		if err != nil {
+			return errors.New("error sending the dry-run results: " + err.Error())
+		}
+		return nil
+	}
 	if strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType) == "logs" {
 		podRequest := types.PodLogRequest{
 			RequestID: r.Payload.Data.InfraConnect.Action.RequestID,
@@ -125,13 +199,18 @@ func RequestProcessor(infraData map[string]string, r types.RawData) error {
 		}
 
 		logrus.Print("Log Request: ", r.Payload.Data.InfraConnect.Action.ExternalData)
-		k8s.SendPodLogs(infraData, podRequest)
+		if podRequest.Follow != nil && *podRequest.Follow {
+			// streams last until the pod terminates, so they don't hold up the other requests
+			go k8s.StreamPodLogs(infraData, podRequest)
+		} else {
+			k8s.SendPodLogs(infraData, podRequest)
+		}
 	} else if strings.Index("create update delete get", strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType)) >= 0 {
 		_, err := k8s.AgentOperations(r.Payload.Data.InfraConnect.Action)
 		if err != nil {
 			return errors.New("error performing infra operationn: " + err.Error())
 		}
-	} else if strings.Index("workflow_delete workflow_run_delete ", strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType)) >= 0 {
+	} else if strings.Index("workflow_delete workflow_run_delete workflow_run_stop ", strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType)) >= 0 {
 
 		err := utils.WorkflowRequest(infraData, r.Payload.Data.InfraConnect.Action.RequestType, r.Payload.Data.InfraConnect.Action.ExternalData, r.Payload.Data.InfraConnect.Action.Username)
 		if err != nil {
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ServiceName is the name of the subscriber in the exported spans unless OTEL_SERVICE_NAME is set
+	ServiceName         = "litmus-subscriber"
+	instrumentationName = "subscriber"
+	// TraceContextAnnotation carries the trace context of the action which created a workflow, so that the run
+	// events of the workflow continue the trace of the request which started the run
+	TraceContextAnnotation = "litmuschaos.io/trace-context"
+)
+
+// InitTracer registers the global tracer provider and propagator of the subscriber and returns the function
+// flushing the spans on shutdown, the spans are only exported when an OTLP endpoint is configured
+func InitTracer(ctx context.Context, endpoint string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	// the exporter reads the endpoint, headers and TLS settings from the standard OTEL_EXPORTER_OTLP_* variables
+	exporter, err := otlptracegrpc.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(semconv.ServiceNameKey.String(ServiceName)),
+		resource.WithFromEnv(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the subscriber
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// ContextFromTraceContext returns the context continuing the trace carried by an action or workflow, the trace
+// context is the JSON encoded carrier set by the server
+func ContextFromTraceContext(ctx context.Context, traceContext string) context.Context {
+	if traceContext == "" {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{}
+	if err := json.Unmarshal([]byte(traceContext), &carrier); err != nil {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+// InjectHeaders sets the trace context of the context on the headers of a request to the server
+func InjectHeaders(ctx context.Context, header http.Header) {
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(header))
+}
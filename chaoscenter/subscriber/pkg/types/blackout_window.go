@@ -0,0 +1,15 @@
+package types
+
+// BlackoutWindow is a blackout window of the project or environment of the chaos infrastructure,
+// cron experiments are suspended while one of the windows is in effect
+type BlackoutWindow struct {
+	WindowID       string   `json:"windowID"`
+	Name           string   `json:"name"`
+	Timezone       string   `json:"timezone"`
+	Type           string   `json:"type"`
+	StartTime      int64    `json:"startTime,omitempty"`
+	EndTime        int64    `json:"endTime,omitempty"`
+	Weekdays       []string `json:"weekdays,omitempty"`
+	DailyStartTime string   `json:"dailyStartTime,omitempty"`
+	DailyEndTime   string   `json:"dailyEndTime,omitempty"`
+}
@@ -41,6 +41,7 @@ type Action struct {
 	RequestType  string `json:"requestType"`
 	Username     string `json:"username"`
 	Namespace    string `json:"namespace"`
+	TraceContext string `json:"traceContext"`
 }
 
 type WorkflowSyncExternalData struct {
@@ -49,7 +50,7 @@ type WorkflowSyncExternalData struct {
 }
 
 type WorkflowStopExternalData struct {
-	WorkflowName   string   `json:"workflow_name"`
-	WorkflowID     string   `json:"workflow_id"`
-	WorkflowRunIDs []string `json:"workflow_run_ids"`
+	WorkflowName   string   `json:"experiment_name"`
+	WorkflowID     string   `json:"experiment_id"`
+	WorkflowRunIDs []string `json:"experiment_run_ids"`
 }
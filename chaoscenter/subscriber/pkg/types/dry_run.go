@@ -0,0 +1,15 @@
+package types
+
+// DryRunRequest holds the manifests of an experiment which are dry-run before the experiment is saved
+type DryRunRequest struct {
+	RequestID string   `json:"requestID"`
+	Namespace string   `json:"namespace"`
+	Manifests []string `json:"manifests"`
+}
+
+// DryRunResult is the result of dry-running a manifest, Error is empty if the manifest was accepted by the cluster
+type DryRunResult struct {
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+	Error string `json:"error,omitempty"`
+}
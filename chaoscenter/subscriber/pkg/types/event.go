@@ -19,6 +19,7 @@ type WorkflowEvent struct {
 	FinishedAt        string          `json:"finishedAt"`
 	Nodes             map[string]Node `json:"nodes"`
 	UpdatedBy         string          `json:"updatedBy"`
+	TraceContext      string          `json:"traceContext,omitempty"`
 }
 
 // each node/step data
@@ -30,6 +31,7 @@ type Node struct {
 	FinishedAt string     `json:"finishedAt"`
 	Children   []string   `json:"children"`
 	Type       string     `json:"type"`
+	FaultName  string     `json:"faultName,omitempty"`
 	ChaosExp   *ChaosData `json:"chaosData,omitempty"`
 }
 
@@ -39,11 +41,11 @@ type ChaosData struct {
 	EngineContext          string                `json:"engineContext"`
 	EngineName             string                `json:"engineName"`
 	Namespace              string                `json:"namespace"`
-	ExperimentName         string                `json:"experimentName"`
-	ExperimentStatus       string                `json:"experimentStatus"`
+	FaultName              string                `json:"faultName"`
+	FaultStatus            string                `json:"faultStatus"`
 	LastUpdatedAt          string                `json:"lastUpdatedAt"`
-	ExperimentVerdict      string                `json:"experimentVerdict"`
-	ExperimentPod          string                `json:"experimentPod"`
+	FaultVerdict           string                `json:"faultVerdict"`
+	FaultPod               string                `json:"faultPod"`
 	RunnerPod              string                `json:"runnerPod"`
 	ProbeSuccessPercentage string                `json:"probeSuccessPercentage"`
 	FailStep               string                `json:"failStep"`
@@ -10,6 +10,10 @@ type PodLogRequest struct {
 	ExpPod          *string `json:"expPod"`
 	RunnerPod       *string `json:"runnerPod"`
 	ChaosNamespace  *string `json:"chaosNamespace"`
+	Follow          *bool   `json:"follow"`
+	TailLines       *int64  `json:"tailLines"`
+	SinceTime       *string `json:"sinceTime"`
+	Container       *string `json:"container"`
 }
 
 type PodLog struct {
@@ -0,0 +1,173 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"subscriber/pkg/k8s"
+	"subscriber/pkg/types"
+
+	"github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// BlackoutWindowAnnotation marks the cron experiments suspended by a blackout window,
+	// only those are resumed once no window is in effect anymore
+	BlackoutWindowAnnotation = "litmuschaos.io/blackout-window"
+
+	blackoutWindowSyncInterval = 30 * time.Second
+	dailyTimeLayout            = "15:04"
+)
+
+var (
+	blackoutWindows     []types.BlackoutWindow
+	blackoutWindowsLock sync.RWMutex
+	blackoutWindowsSync = make(chan struct{}, 1)
+)
+
+// UpdateBlackoutWindows replaces the blackout windows of the infrastructure with the ones sent by the server
+func UpdateBlackoutWindows(externalData string) error {
+	var windows []types.BlackoutWindow
+	if err := json.Unmarshal([]byte(externalData), &windows); err != nil {
+		return errors.New("failed to parse blackout windows: " + err.Error())
+	}
+
+	blackoutWindowsLock.Lock()
+	blackoutWindows = windows
+	blackoutWindowsLock.Unlock()
+
+	// trigger a sync without waiting for the next tick
+	select {
+	case blackoutWindowsSync <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// BlackoutWindowWatcher periodically suspends the cron experiments of the infrastructure while a
+// blackout window is in effect and resumes them once the window ends
+func BlackoutWindowWatcher(stopCh chan struct{}, infraData map[string]string) {
+	ticker := time.NewTicker(blackoutWindowSyncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		case <-blackoutWindowsSync:
+		}
+
+		if err := syncCronWorkflows(infraData["INFRA_NAMESPACE"], infraData["INFRA_ID"], activeBlackoutWindow(time.Now())); err != nil {
+			logrus.WithError(err).Error("failed to apply blackout windows to cron experiments")
+		}
+	}
+}
+
+// activeBlackoutWindow returns the first blackout window in effect at the given time, nil if there is none
+func activeBlackoutWindow(now time.Time) *types.BlackoutWindow {
+	blackoutWindowsLock.RLock()
+	defer blackoutWindowsLock.RUnlock()
+
+	for i := range blackoutWindows {
+		if isBlackoutWindowActive(blackoutWindows[i], now) {
+			window := blackoutWindows[i]
+			return &window
+		}
+	}
+	return nil
+}
+
+// syncCronWorkflows suspends or resumes the cron experiments of the infrastructure, the cron workflows of the other
+// infrastructures sharing the namespace are left untouched
+func syncCronWorkflows(namespace string, infraID string, window *types.BlackoutWindow) error {
+	cronWfClient, err := k8s.GenerateCronWorkflowClient(namespace)
+	if err != nil {
+		return err
+	}
+	cronWfs, err := cronWfClient.List(context.Background(), metav1.ListOptions{LabelSelector: "infra_id=" + infraID})
+	if err != nil {
+		return err
+	}
+
+	for _, cronWf := range cronWfs.Items {
+		_, suspendedByWindow := cronWf.Annotations[BlackoutWindowAnnotation]
+		if window != nil {
+			// cron experiments suspended by the user are left untouched
+			if cronWf.Spec.Suspend {
+				continue
+			}
+			if cronWf.Annotations == nil {
+				cronWf.Annotations = map[string]string{}
+			}
+			cronWf.Annotations[BlackoutWindowAnnotation] = window.WindowID
+			cronWf.Spec.Suspend = true
+			logrus.Info("suspending cron experiment: ", cronWf.Name, " during blackout window: ", window.Name)
+		} else {
+			if !suspendedByWindow {
+				continue
+			}
+			delete(cronWf.Annotations, BlackoutWindowAnnotation)
+			cronWf.Spec.Suspend = false
+			logrus.Info("resuming cron experiment: ", cronWf.Name, " after blackout window")
+		}
+
+		if _, err := cronWfClient.Update(context.Background(), &cronWf, metav1.UpdateOptions{}); err != nil {
+			logrus.WithError(err).Error("failed to update cron experiment: ", cronWf.Name)
+		}
+	}
+	return nil
+}
+
+// isBlackoutWindowActive checks if the blackout window is in effect at the given time, recurring
+// windows are evaluated in their own timezone and may span midnight
+func isBlackoutWindowActive(window types.BlackoutWindow, now time.Time) bool {
+	switch window.Type {
+	case "ONE_OFF":
+		nowMilli := now.UnixMilli()
+		return window.StartTime <= nowMilli && nowMilli < window.EndTime
+	case "RECURRING":
+		location, err := time.LoadLocation(window.Timezone)
+		if err != nil {
+			return false
+		}
+		start, err := time.Parse(dailyTimeLayout, window.DailyStartTime)
+		if err != nil {
+			return false
+		}
+		end, err := time.Parse(dailyTimeLayout, window.DailyEndTime)
+		if err != nil {
+			return false
+		}
+
+		localNow := now.In(location)
+		for _, day := range []time.Time{localNow, localNow.AddDate(0, 0, -1)} {
+			if !hasWeekday(window.Weekdays, day.Weekday()) {
+				continue
+			}
+			startAt := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, location)
+			endAt := time.Date(day.Year(), day.Month(), day.Day(), end.Hour(), end.Minute(), 0, 0, location)
+			if !endAt.After(startAt) {
+				endAt = endAt.AddDate(0, 0, 1)
+			}
+			if !localNow.Before(startAt) && localNow.Before(endAt) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasWeekday(weekdays []string, weekday time.Weekday) bool {
+	for _, w := range weekdays {
+		if strings.EqualFold(w, weekday.String()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata"
+
+	"subscriber/pkg/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestIsBlackoutWindowActive is used to test that the recurring windows are evaluated in their own timezone
+func TestIsBlackoutWindowActive(t *testing.T) {
+	// given
+	window := types.BlackoutWindow{
+		Type:           "RECURRING",
+		Timezone:       "Asia/Kolkata",
+		DailyStartTime: "09:00",
+		DailyEndTime:   "17:00",
+		Weekdays:       []string{"Monday"},
+	}
+	tests := []struct {
+		name     string
+		window   types.BlackoutWindow
+		now      time.Time
+		expected bool
+	}{
+		{
+			// 10:00 in Kolkata
+			name:     "within the window in its timezone",
+			window:   window,
+			now:      time.Date(2024, time.January, 1, 4, 30, 0, 0, time.UTC),
+			expected: true,
+		},
+		{
+			// 18:00 in Kolkata, while it is still within 09:00-17:00 in UTC
+			name:   "after the window in its timezone",
+			window: window,
+			now:    time.Date(2024, time.January, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			// 10:00 in Kolkata on a Tuesday
+			name:   "on another weekday in its timezone",
+			window: window,
+			now:    time.Date(2024, time.January, 2, 4, 30, 0, 0, time.UTC),
+		},
+		{
+			name: "unknown timezone",
+			window: types.BlackoutWindow{
+				Type:           "RECURRING",
+				Timezone:       "Mars/Olympus_Mons",
+				DailyStartTime: "00:00",
+				DailyEndTime:   "23:59",
+				Weekdays:       []string{"Monday"},
+			},
+			now: time.Date(2024, time.January, 1, 4, 30, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// when
+			active := isBlackoutWindowActive(tc.window, tc.now)
+
+			// then
+			assert.Equal(t, tc.expected, active)
+		})
+	}
+}
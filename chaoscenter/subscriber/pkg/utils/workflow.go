@@ -2,12 +2,17 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"subscriber/pkg/events"
 	"subscriber/pkg/k8s"
+	"subscriber/pkg/types"
 
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
 	wfclientset "github.com/argoproj/argo-workflows/v3/pkg/client/clientset/versioned"
 	"github.com/sirupsen/logrus"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mergeType "k8s.io/apimachinery/pkg/types"
 )
 
 func WorkflowRequest(agentData map[string]string, requestType string, externalData string, uuid string) error {
@@ -40,11 +45,69 @@ func WorkflowRequest(agentData map[string]string, requestType string, externalDa
 		}
 
 		logrus.Info("events delete name: ", wfOb.Name, "namespace: ", wfOb.Namespace)
+	} else if requestType == "workflow_run_stop" {
+		var stopData types.WorkflowStopExternalData
+		err := json.Unmarshal([]byte(externalData), &stopData)
+		if err != nil {
+			return errors.New("failed to parse stop request: " + err.Error())
+		}
+
+		var workflows []v1alpha1.Workflow
+		if len(stopData.WorkflowRunIDs) == 0 {
+			// no run specified, stop every run of the experiment
+			wfList, err := events.ListWorkflowObject(stopData.WorkflowID)
+			if err != nil {
+				return err
+			}
+			workflows = wfList.Items
+		} else {
+			for _, runID := range stopData.WorkflowRunIDs {
+				wfOb, err := events.GetWorkflowObj(runID)
+				if err != nil {
+					return err
+				}
+				if wfOb == nil {
+					logrus.Info("no workflow found for run: ", runID)
+					continue
+				}
+				workflows = append(workflows, *wfOb)
+			}
+		}
+
+		for _, wf := range workflows {
+			if wf.Status.Fulfilled() {
+				continue
+			}
+			err = StopWorkflow(wf.Name, wf.Namespace)
+			if err != nil {
+				logrus.Info("failed to stop workflow: ", wf.Name, " namespace: ", wf.Namespace, " error: ", err)
+			}
+			uid := string(wf.UID)
+			err = events.StopChaosEngineState(wf.Namespace, &uid)
+			if err != nil {
+				logrus.Info("failed to stop chaosEngine for : ", wf.Name, " namespace: ", wf.Namespace, " error: ", err)
+			}
+			logrus.Info("events stop name: ", wf.Name, " namespace: ", wf.Namespace)
+		}
 	}
 
 	return nil
 }
 
+// StopWorkflow shuts down a running workflow, letting its exit handlers run so that injected chaos is reverted
+func StopWorkflow(wfName string, namespace string) error {
+	ctx := context.TODO()
+	conf, err := k8s.GetKubeConfig()
+	if err != nil {
+		return err
+	}
+
+	wfClient := wfclientset.NewForConfigOrDie(conf).ArgoprojV1alpha1().Workflows(namespace)
+	patch := []byte(`{"spec":{"shutdown":"` + string(v1alpha1.ShutdownStrategyStop) + `"}}`)
+	_, err = wfClient.Patch(ctx, wfName, mergeType.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
 func DeleteWorkflow(wfname string, agentData map[string]string) error {
 	ctx := context.TODO()
 	conf, err := k8s.GetKubeConfig()
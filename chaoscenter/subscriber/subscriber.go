@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -11,11 +12,15 @@ import (
 	"os/signal"
 	"runtime"
 	"strings"
+	// the image has no zoneinfo, the timezones of the blackout windows are loaded from the embedded database
+	_ "time/tzdata"
 
 	"github.com/gorilla/websocket"
 
 	"subscriber/pkg/events"
 	"subscriber/pkg/requests"
+	"subscriber/pkg/tracing"
+	"subscriber/pkg/utils"
 
 	"github.com/kelseyhightower/envconfig"
 
@@ -38,6 +43,8 @@ var (
 		"START_TIME":         os.Getenv("START_TIME"),
 		"SKIP_SSL_VERIFY":    os.Getenv("SKIP_SSL_VERIFY"),
 		"CUSTOM_TLS_CERT":    os.Getenv("CUSTOM_TLS_CERT"),
+		// spans are exported to the OTLP collector only when the endpoint is set
+		"OTEL_EXPORTER_OTLP_ENDPOINT": os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
 	}
 
 	err error
@@ -128,6 +135,11 @@ func init() {
 }
 
 func main() {
+	shutdownTracer, err := tracing.InitTracer(context.Background(), infraData["OTEL_EXPORTER_OTLP_ENDPOINT"])
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to initialize the tracer")
+	}
+
 	stopCh := make(chan struct{})
 	sigCh := make(chan os.Signal)
 	stream := make(chan types.WorkflowEvent, 10)
@@ -140,6 +152,12 @@ func main() {
 	//streams the event data to graphql server
 	go events.WorkflowUpdates(infraData, stream)
 
+	// suspends cron experiments during blackout windows
+	go utils.BlackoutWindowWatcher(stopCh, infraData)
+
+	// report to the server that the infra is alive
+	go requests.Heartbeat(stopCh, infraData)
+
 	// listen for agent actions
 	go requests.AgentConnect(infraData)
 
@@ -147,4 +165,9 @@ func main() {
 	<-sigCh
 	close(stopCh)
 	close(stream)
+
+	// flush the spans which weren't exported yet
+	if err := shutdownTracer(context.Background()); err != nil {
+		logrus.WithError(err).Error("Failed to shutdown the tracer")
+	}
 }